@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"reflect"
 
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/openapi"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -12,16 +14,43 @@ import (
 	"gopkg.in/go-playground/validator.v8"
 )
 
+const (
+	metricsPath    = "/metrics"
+	openApiDocPath = "/openapi.json"
+)
+
 type validatorInput struct {
 	Name      string
 	Validator validator.Func
 }
 
-// CreateServer creates a HTTP server
-func CreateServer(versionsRegistry data.VersionsRegistryHandler, port int) (*http.Server, error) {
+// CreateServer creates a HTTP server. The provided middlewares are applied on all the routes, except the health ones,
+// which are served if a readiness handler is provided. If a metrics handler is provided, it serves the /metrics route
+func CreateServer(
+	versionsRegistry data.VersionsRegistryHandler,
+	port int,
+	readinessHandler ReadinessHandler,
+	metricsHandler http.Handler,
+	middlewares ...gin.HandlerFunc,
+) (*http.Server, error) {
 	ws := gin.Default()
+	// the forwarded headers can be set by any client, so they are read only by the ClientIPResolver middleware, for
+	// the requests coming from the trusted proxies
+	ws.ForwardedByClientIP = false
 	ws.Use(cors.Default())
 
+	// the health routes are registered before applying the middlewares, so the orchestrators' probes are neither
+	// authenticated nor rate limited
+	if !check.IfNil(readinessHandler) {
+		registerHealthRoutes(ws, readinessHandler)
+	}
+
+	ws.Use(middlewares...)
+
+	if metricsHandler != nil {
+		ws.GET(metricsPath, gin.WrapH(metricsHandler))
+	}
+
 	err := registerValidators()
 	if err != nil {
 		return nil, err
@@ -68,6 +97,12 @@ func registerRoutes(ws *gin.Engine, versionsRegistry data.VersionsRegistryHandle
 			subGroup := versionGroup.Group(path)
 			group.RegisterRoutes(subGroup)
 		}
+
+		// the document is created after registering the routes, as the endpoints of a version don't change anymore
+		openApiDoc := openapi.NewDocument(version, versionData.ApiHandler)
+		versionGroup.GET(openApiDocPath, func(c *gin.Context) {
+			c.JSON(http.StatusOK, openApiDoc)
+		})
 	}
 
 	return nil
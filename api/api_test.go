@@ -0,0 +1,41 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/openapi"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/versions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateServer_ShouldServeTheOpenApiDocumentOfEachVersion(t *testing.T) {
+	facade := &mock.Facade{}
+	apiHandler, err := api.NewApiHandler(facade)
+	require.Nil(t, err)
+
+	versionsRegistry := versions.NewVersionsRegistry()
+	err = versionsRegistry.AddVersion("v1.0", &data.VersionData{Facade: facade, ApiHandler: apiHandler})
+	require.Nil(t, err)
+
+	server, err := api.CreateServer(versionsRegistry, 8080, nil, nil)
+	require.Nil(t, err)
+
+	req, _ := http.NewRequest(http.MethodGet, "/v1.0/openapi.json", nil)
+	resp := httptest.NewRecorder()
+	server.Handler.ServeHTTP(resp, req)
+
+	doc := openapi.Document{}
+	err = json.NewDecoder(resp.Body).Decode(&doc)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "v1.0", doc.Info.Version)
+	assert.NotNil(t, doc.Paths["/v1.0/address/{address}"]["get"])
+	assert.NotNil(t, doc.Components.Schemas["Account"])
+}
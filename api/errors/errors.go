@@ -74,6 +74,24 @@ var ErrInvalidBlockHashParam = errors.New("invalid block hash parameter")
 // ErrInvalidShardIDParam signals that an invalid shard ID parameter has been provided
 var ErrInvalidShardIDParam = errors.New("invalid shard ID parameter")
 
+// ErrTooManyRequests signals that the client has exceeded the number of requests it is allowed to send
+var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrMissingApiKey signals that the request does not hold an API key, while the API requires one
+var ErrMissingApiKey = errors.New("missing api key")
+
+// ErrInvalidApiKey signals that the request holds an unknown API key
+var ErrInvalidApiKey = errors.New("invalid api key")
+
+// ErrEndpointNotAllowed signals that the tier of the API key does not allow the requested endpoint
+var ErrEndpointNotAllowed = errors.New("endpoint not allowed for the api key's tier")
+
+// ErrDailyQuotaExceeded signals that the API key has reached its daily quota of requests
+var ErrDailyQuotaExceeded = errors.New("daily quota exceeded")
+
+// ErrProxyNotReady signals that at least one shard has no reachable observer
+var ErrProxyNotReady = errors.New("proxy is not ready: not all the shards have reachable observers")
+
 // ErrInvalidTxFields signals that one or more field of a transaction are invalid
 type ErrInvalidTxFields struct {
 	Message string
@@ -28,15 +28,58 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 	}
 
 	baseRoutesHandlers := map[string]*data.EndpointHandlerData{
-		"/:address":                       {Handler: ag.getAccount, Method: http.MethodGet},
-		"/:address/balance":               {Handler: ag.getBalance, Method: http.MethodGet},
-		"/:address/username":              {Handler: ag.getUsername, Method: http.MethodGet},
-		"/:address/nonce":                 {Handler: ag.getNonce, Method: http.MethodGet},
-		"/:address/shard":                 {Handler: ag.getShard, Method: http.MethodGet},
-		"/:address/transactions":          {Handler: ag.getTransactions, Method: http.MethodGet},
-		"/:address/key/:key":              {Handler: ag.getValueForKey, Method: http.MethodGet},
-		"/:address/esdt":                  {Handler: ag.getESDTTokens, Method: http.MethodGet},
-		"/:address/esdt/:tokenIdentifier": {Handler: ag.getESDTTokenData, Method: http.MethodGet},
+		"/:address": {
+			Handler:  ag.getAccount,
+			Method:   http.MethodGet,
+			Summary:  "returns the account's data",
+			Response: responseWithData(gin.H{"account": data.Account{}}),
+		},
+		"/:address/balance": {
+			Handler:  ag.getBalance,
+			Method:   http.MethodGet,
+			Summary:  "returns the balance of the account",
+			Response: responseWithData(gin.H{"balance": ""}),
+		},
+		"/:address/username": {
+			Handler:  ag.getUsername,
+			Method:   http.MethodGet,
+			Summary:  "returns the username of the account",
+			Response: responseWithData(gin.H{"username": ""}),
+		},
+		"/:address/nonce": {
+			Handler:  ag.getNonce,
+			Method:   http.MethodGet,
+			Summary:  "returns the nonce of the account",
+			Response: responseWithData(gin.H{"nonce": uint64(0)}),
+		},
+		"/:address/shard": {
+			Handler:  ag.getShard,
+			Method:   http.MethodGet,
+			Summary:  "returns the shard of the address, based on the proxy's configuration",
+			Response: responseWithData(gin.H{"shardID": uint32(0)}),
+		},
+		"/:address/transactions": {
+			Handler:  ag.getTransactions,
+			Method:   http.MethodGet,
+			Summary:  "returns the transactions of the address stored in the indexer",
+			Response: responseWithData(gin.H{"transactions": []data.DatabaseTransaction{}}),
+		},
+		"/:address/key/:key": {
+			Handler:  ag.getValueForKey,
+			Method:   http.MethodGet,
+			Summary:  "returns the value stored under the given key in the account's storage",
+			Response: responseWithData(gin.H{"value": ""}),
+		},
+		"/:address/esdt": {
+			Handler: ag.getESDTTokens,
+			Method:  http.MethodGet,
+			Summary: "returns the ESDT tokens of the account",
+		},
+		"/:address/esdt/:tokenIdentifier": {
+			Handler: ag.getESDTTokenData,
+			Method:  http.MethodGet,
+			Summary: "returns the data of the given ESDT token of the account, such as the balance and the properties",
+		},
 	}
 	ag.baseGroup.endpoints = baseRoutesHandlers
 
@@ -45,9 +88,9 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 
 func (group *accountsGroup) getAccountFromFacade(c *gin.Context) (*data.Account, int, error) {
 	addr := c.Param("address")
-	acc, err := group.facade.GetAccount(addr)
+	acc, err := group.facade.GetAccount(c.Request.Context(), addr)
 	if err != nil {
-		return nil, http.StatusInternalServerError, err
+		return nil, shared.GetInternalErrorStatusCode(err), err
 	}
 
 	return acc, http.StatusOK, nil
@@ -145,7 +188,7 @@ func (group *accountsGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := group.facade.GetValueForKey(addr, key)
+	value, err := group.facade.GetValueForKey(c.Request.Context(), addr, key)
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -215,7 +258,7 @@ func (group *accountsGroup) getESDTTokenData(c *gin.Context) {
 		return
 	}
 
-	esdtTokenResponse, err := group.facade.GetESDTTokenData(addr, tokenIdentifier)
+	esdtTokenResponse, err := group.facade.GetESDTTokenData(c.Request.Context(), addr, tokenIdentifier)
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -244,7 +287,7 @@ func (group *accountsGroup) getESDTTokens(c *gin.Context) {
 		return
 	}
 
-	tokens, err := group.facade.GetAllESDTTokens(addr)
+	tokens, err := group.facade.GetAllESDTTokens(c.Request.Context(), addr)
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -27,7 +27,12 @@ func NewBlockAtlasGroup(facadeHandler data.FacadeHandler) (*blockAtlasGroup, err
 	}
 
 	baseRoutesHandlers := map[string]*data.EndpointHandlerData{
-		"/:shard/:nonce": {Handler: bag.getBlockByShardIDAndNonceFromElastic, Method: http.MethodGet},
+		"/:shard/:nonce": {
+			Handler:  bag.getBlockByShardIDAndNonceFromElastic,
+			Method:   http.MethodGet,
+			Summary:  "returns a block by nonce, as required by Block Atlas",
+			Response: responseWithData(gin.H{"block": data.AtlasBlock{}}),
+		},
 	}
 	bag.baseGroup.endpoints = baseRoutesHandlers
 
@@ -30,8 +30,20 @@ func NewBlockGroup(facadeHandler data.FacadeHandler) (*blockGroup, error) {
 	}
 
 	baseRoutesHandlers := map[string]*data.EndpointHandlerData{
-		"/:shard/by-nonce/:nonce": {Handler: bg.byNonceHandler, Method: http.MethodGet},
-		"/:shard/by-hash/:hash":   {Handler: bg.byHashHandler, Method: http.MethodGet},
+		"/:shard/by-nonce/:nonce": {
+			Handler:     bg.byNonceHandler,
+			Method:      http.MethodGet,
+			Summary:     "returns a block by nonce, optionally with its transactions",
+			QueryParams: []string{"withTxs"},
+			Response:    data.BlockApiResponse{},
+		},
+		"/:shard/by-hash/:hash": {
+			Handler:     bg.byHashHandler,
+			Method:      http.MethodGet,
+			Summary:     "returns a block by hash, optionally with its transactions",
+			QueryParams: []string{"withTxs"},
+			Response:    data.BlockApiResponse{},
+		},
 	}
 	bg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -77,9 +89,9 @@ func (group *blockGroup) byHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetBlockByHash(shardID, hash, withTxs)
+	blockByHashResponse, err := group.facade.GetBlockByHash(c.Request.Context(), shardID, hash, withTxs)
 	if err != nil {
-		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		shared.RespondWithInternalError(c, err)
 		return
 	}
 
@@ -124,9 +136,9 @@ func (group *blockGroup) byNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetBlockByNonce(shardID, nonce, withTxs)
+	blockByNonceResponse, err := group.facade.GetBlockByNonce(c.Request.Context(), shardID, nonce, withTxs)
 	if err != nil {
-		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		shared.RespondWithInternalError(c, err)
 		return
 	}
 
@@ -68,6 +68,19 @@ func (bg *baseGroup) RegisterRoutes(ws *gin.RouterGroup) {
 	}
 }
 
+// GetAllEndpoints returns a copy of the handler data of all the endpoints, by path
+func (bg *baseGroup) GetAllEndpoints() map[string]data.EndpointHandlerData {
+	bg.RLock()
+	defer bg.RUnlock()
+
+	endpoints := make(map[string]data.EndpointHandlerData, len(bg.endpoints))
+	for path, handlerData := range bg.endpoints {
+		endpoints[path] = *handlerData
+	}
+
+	return endpoints
+}
+
 func (bg *baseGroup) isEndpointRegistered(endpoint string) bool {
 	bg.RLock()
 	defer bg.RUnlock()
@@ -76,6 +89,15 @@ func (bg *baseGroup) isEndpointRegistered(endpoint string) bool {
 	return exists
 }
 
+// responseWithData returns an instance of the generic API response holding the given data field, for describing the
+// response of an endpoint in the OpenAPI document
+func responseWithData(dataField interface{}) data.GenericAPIResponse {
+	return data.GenericAPIResponse{
+		Data: dataField,
+		Code: data.ReturnCodeSuccess,
+	}
+}
+
 // IsInterfaceNil returns true if the value under the interface is nil
 func (bg *baseGroup) IsInterfaceNil() bool {
 	return bg == nil
@@ -28,8 +28,18 @@ func NewHyperBlockGroup(facadeHandler data.FacadeHandler) (*hyperBlockGroup, err
 	}
 
 	baseRoutesHandlers := map[string]*data.EndpointHandlerData{
-		"/by-hash/:hash":   {Handler: hbg.hyperBlockByHashHandler, Method: http.MethodGet},
-		"/by-nonce/:nonce": {Handler: hbg.hyperBlockByNonceHandler, Method: http.MethodGet},
+		"/by-hash/:hash": {
+			Handler:  hbg.hyperBlockByHashHandler,
+			Method:   http.MethodGet,
+			Summary:  "returns a hyperblock by hash, with its transactions",
+			Response: data.HyperblockApiResponse{},
+		},
+		"/by-nonce/:nonce": {
+			Handler:  hbg.hyperBlockByNonceHandler,
+			Method:   http.MethodGet,
+			Summary:  "returns a hyperblock by nonce, with its transactions",
+			Response: data.HyperblockApiResponse{},
+		},
 	}
 	hbg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -45,9 +55,9 @@ func (group *hyperBlockGroup) hyperBlockByHashHandler(c *gin.Context) {
 		return
 	}
 
-	blockByHashResponse, err := group.facade.GetHyperBlockByHash(hash)
+	blockByHashResponse, err := group.facade.GetHyperBlockByHash(c.Request.Context(), hash)
 	if err != nil {
-		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		shared.RespondWithInternalError(c, err)
 		return
 	}
 
@@ -62,9 +72,9 @@ func (group *hyperBlockGroup) hyperBlockByNonceHandler(c *gin.Context) {
 		return
 	}
 
-	blockByNonceResponse, err := group.facade.GetHyperBlockByNonce(nonce)
+	blockByNonceResponse, err := group.facade.GetHyperBlockByNonce(c.Request.Context(), nonce)
 	if err != nil {
-		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		shared.RespondWithInternalError(c, err)
 		return
 	}
 
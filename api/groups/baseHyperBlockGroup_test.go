@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
 	"github.com/stretchr/testify/require"
 )
 
@@ -66,6 +67,10 @@ func TestGetHyperblockByNonce(t *testing.T) {
 				}), nil
 			}
 
+			if nonce == 44 {
+				return nil, process.ErrObserversOverloaded
+			}
+
 			return nil, fmt.Errorf("fooError")
 		},
 	}
@@ -85,6 +90,13 @@ func TestGetHyperblockByNonce(t *testing.T) {
 	require.Equal(t, "internal_issue", string(response.Code))
 	require.Equal(t, "fooError", response.Error)
 
+	// Observers overloaded
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/44", &response)
+	require.Equal(t, http.StatusServiceUnavailable, statusCode)
+	require.Equal(t, "internal_issue", string(response.Code))
+	require.Equal(t, process.ErrObserversOverloaded.Error(), response.Error)
+
 	// Bad nonce
 	response = data.HyperblockApiResponse{}
 	statusCode = doGet(t, facade, "/hyperblock/by-hash/badnonce", &response)
@@ -27,9 +27,21 @@ func NewNetworkGroup(facadeHandler data.FacadeHandler) (*networkGroup, error) {
 	}
 
 	baseRoutesHandlers := map[string]*data.EndpointHandlerData{
-		"/status/:shard": {Handler: ng.getNetworkStatusData, Method: http.MethodGet},
-		"/config":        {Handler: ng.getNetworkConfigData, Method: http.MethodGet},
-		"/economics":     {Handler: ng.getEconomicsData, Method: http.MethodGet},
+		"/status/:shard": {
+			Handler: ng.getNetworkStatusData,
+			Method:  http.MethodGet,
+			Summary: "returns the status metrics from an observer of the given shard",
+		},
+		"/config": {
+			Handler: ng.getNetworkConfigData,
+			Method:  http.MethodGet,
+			Summary: "returns the configuration of the network",
+		},
+		"/economics": {
+			Handler: ng.getEconomicsData,
+			Method:  http.MethodGet,
+			Summary: "returns the economics data of the last epoch",
+		},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -44,7 +56,7 @@ func (group *networkGroup) getNetworkStatusData(c *gin.Context) {
 		return
 	}
 
-	networkStatusResults, err := group.facade.GetNetworkStatusMetrics(shardIDUint)
+	networkStatusResults, err := group.facade.GetNetworkStatusMetrics(c.Request.Context(), shardIDUint)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -55,7 +67,7 @@ func (group *networkGroup) getNetworkStatusData(c *gin.Context) {
 
 // getNetworkConfigData will expose the node network metrics for the given shard
 func (group *networkGroup) getNetworkConfigData(c *gin.Context) {
-	networkConfigResults, err := group.facade.GetNetworkConfigMetrics()
+	networkConfigResults, err := group.facade.GetNetworkConfigMetrics(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -66,7 +78,7 @@ func (group *networkGroup) getNetworkConfigData(c *gin.Context) {
 
 // getEconomicsData will expose the economics data metrics from an observer (if any available) in json format
 func (group *networkGroup) getEconomicsData(c *gin.Context) {
-	economicsData, err := group.facade.GetEconomicsDataMetrics()
+	economicsData, err := group.facade.GetEconomicsDataMetrics(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
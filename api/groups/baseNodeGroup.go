@@ -26,7 +26,30 @@ func NewNodeGroup(facadeHandler data.FacadeHandler) (*nodeGroup, error) {
 	}
 
 	baseRoutesHandlers := map[string]*data.EndpointHandlerData{
-		"/heartbeatstatus": {Handler: ng.getHeartbeatData, Method: http.MethodGet},
+		"/heartbeatstatus": {
+			Handler:  ng.getHeartbeatData,
+			Method:   http.MethodGet,
+			Summary:  "returns the heartbeats of the nodes",
+			Response: responseWithData(data.HeartbeatResponse{}),
+		},
+		"/nodes-health": {
+			Handler:  ng.getNodesHealth,
+			Method:   http.MethodGet,
+			Summary:  "returns the health state of the observers and full history nodes, as seen by the proxy",
+			Response: responseWithData(gin.H{"nodesHealth": data.NodesHealthResponse{}}),
+		},
+		"/responses-cache": {
+			Handler:  ng.getResponsesCacheStats,
+			Method:   http.MethodGet,
+			Summary:  "returns the usage of the cache holding the blocks and hyperblocks which cannot change anymore",
+			Response: responseWithData(gin.H{"responsesCache": data.ResponsesCacheStats{}}),
+		},
+		"/api-keys-usage": {
+			Handler:  ng.getApiKeysUsage,
+			Method:   http.MethodGet,
+			Summary:  "returns the usage of the API keys, only to the admin API keys",
+			Response: responseWithData(gin.H{"apiKeysUsage": data.ApiKeysUsageResponse{}}),
+		},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -35,11 +58,35 @@ func NewNodeGroup(facadeHandler data.FacadeHandler) (*nodeGroup, error) {
 
 // getHeartbeatData will expose heartbeat status from an observer (if any available) in json format
 func (group *nodeGroup) getHeartbeatData(c *gin.Context) {
-	heartbeatResults, err := group.facade.GetHeartbeatData()
+	heartbeatResults, err := group.facade.GetHeartbeatData(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"heartbeats": heartbeatResults.Heartbeats}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, heartbeatResults, "", data.ReturnCodeSuccess)
+}
+
+// getNodesHealth will expose the health state of the observers and full history nodes, as seen by the proxy
+func (group *nodeGroup) getNodesHealth(c *gin.Context) {
+	nodesHealth, err := group.facade.GetNodesHealth()
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"nodesHealth": nodesHealth}, "", data.ReturnCodeSuccess)
+}
+
+// getResponsesCacheStats will expose the hits and misses of the cache holding the blocks and the hyperblocks
+func (group *nodeGroup) getResponsesCacheStats(c *gin.Context) {
+	cacheStats := group.facade.GetResponsesCacheStats()
+	shared.RespondWith(c, http.StatusOK, gin.H{"responsesCache": cacheStats}, "", data.ReturnCodeSuccess)
+}
+
+// getApiKeysUsage will expose the usage counters of the API keys. The access to this endpoint is restricted to the
+// admin API keys
+func (group *nodeGroup) getApiKeysUsage(c *gin.Context) {
+	apiKeysUsage := group.facade.GetApiKeysUsage()
+	shared.RespondWith(c, http.StatusOK, gin.H{"apiKeysUsage": apiKeysUsage}, "", data.ReturnCodeSuccess)
 }
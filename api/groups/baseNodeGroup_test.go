@@ -99,3 +99,117 @@ func TestHeartbeat_GetHeartbeatBadRequestShouldErr(t *testing.T) {
 
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 }
+
+func TestGetNodesHealth_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetNodesHealthHandler: func() (*data.NodesHealthResponse, error) {
+			return &data.NodesHealthResponse{
+				Observers: []*data.NodeHealthStatus{
+					{Address: "observer0", ShardId: 0, IsHealthy: false, ConsecutiveFailures: 3},
+				},
+			}, nil
+		},
+	}
+	nodeGroup, err := groups.NewNodeGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(nodeGroup, nodePath)
+
+	req, _ := http.NewRequest("GET", "/node/nodes-health", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	type nodesHealthResponse struct {
+		Data struct {
+			NodesHealth data.NodesHealthResponse `json:"nodesHealth"`
+		} `json:"data"`
+	}
+	var result nodesHealthResponse
+	loadResponse(resp.Body, &result)
+	require.Equal(t, 1, len(result.Data.NodesHealth.Observers))
+	assert.Equal(t, "observer0", result.Data.NodesHealth.Observers[0].Address)
+	assert.False(t, result.Data.NodesHealth.Observers[0].IsHealthy)
+	assert.Equal(t, uint32(3), result.Data.NodesHealth.Observers[0].ConsecutiveFailures)
+}
+
+func TestGetNodesHealth_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetNodesHealthHandler: func() (*data.NodesHealthResponse, error) {
+			return nil, errors.New("nodes health checks are disabled")
+		},
+	}
+	nodeGroup, err := groups.NewNodeGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(nodeGroup, nodePath)
+
+	req, _ := http.NewRequest("GET", "/node/nodes-health", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
+
+func TestGetResponsesCacheStats_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedStats := data.ResponsesCacheStats{Hits: 7, Misses: 3, NumEntries: 3, Capacity: 1000}
+	facade := &mock.Facade{
+		GetResponsesCacheStatsHandler: func() data.ResponsesCacheStats {
+			return expectedStats
+		},
+	}
+	nodeGroup, err := groups.NewNodeGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(nodeGroup, nodePath)
+
+	req, _ := http.NewRequest("GET", "/node/responses-cache", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	type responsesCacheResponse struct {
+		Data struct {
+			ResponsesCache data.ResponsesCacheStats `json:"responsesCache"`
+		} `json:"data"`
+	}
+	var result responsesCacheResponse
+	loadResponse(resp.Body, &result)
+	assert.Equal(t, expectedStats, result.Data.ResponsesCache)
+}
+
+func TestGetApiKeysUsage_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedUsage := data.ApiKeysUsageResponse{
+		Day: "2021-03-04",
+		Keys: []*data.ApiKeyUsage{
+			{Key: "abcd****", Owner: "owner", Tier: "basic", RequestsToday: 5, DailyQuota: 100, TotalRequests: 20},
+		},
+	}
+	facade := &mock.Facade{
+		GetApiKeysUsageHandler: func() *data.ApiKeysUsageResponse {
+			return &expectedUsage
+		},
+	}
+	nodeGroup, err := groups.NewNodeGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(nodeGroup, nodePath)
+
+	req, _ := http.NewRequest("GET", "/node/api-keys-usage", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	type apiKeysUsageResponse struct {
+		Data struct {
+			ApiKeysUsage data.ApiKeysUsageResponse `json:"apiKeysUsage"`
+		} `json:"data"`
+	}
+	var result apiKeysUsageResponse
+	loadResponse(resp.Body, &result)
+	assert.Equal(t, expectedUsage, result.Data.ApiKeysUsage)
+}
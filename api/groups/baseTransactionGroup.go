@@ -29,13 +29,54 @@ func NewTransactionGroup(facadeHandler data.FacadeHandler) (*transactionGroup, e
 	}
 
 	baseRoutesHandlers := map[string]*data.EndpointHandlerData{
-		"/send":            {Handler: tg.sendTransaction, Method: http.MethodPost},
-		"/simulate":        {Handler: tg.simulateTransaction, Method: http.MethodPost},
-		"/send-multiple":   {Handler: tg.sendMultipleTransactions, Method: http.MethodPost},
-		"/send-user-funds": {Handler: tg.sendUserFunds, Method: http.MethodPost},
-		"/cost":            {Handler: tg.requestTransactionCost, Method: http.MethodPost},
-		"/:txhash/status":  {Handler: tg.getTransactionStatus, Method: http.MethodGet},
-		"/:txhash":         {Handler: tg.getTransaction, Method: http.MethodGet},
+		"/send": {
+			Handler:  tg.sendTransaction,
+			Method:   http.MethodPost,
+			Summary:  "sends a transaction to an observer of the sender's shard",
+			Request:  data.Transaction{},
+			Response: responseWithData(gin.H{"txHash": ""}),
+		},
+		"/simulate": {
+			Handler: tg.simulateTransaction,
+			Method:  http.MethodPost,
+			Summary: "simulates the execution of a transaction, without executing it",
+			Request: data.Transaction{},
+		},
+		"/send-multiple": {
+			Handler:  tg.sendMultipleTransactions,
+			Method:   http.MethodPost,
+			Summary:  "sends multiple transactions to the observers of their senders' shards",
+			Request:  []*data.Transaction{},
+			Response: responseWithData(gin.H{"numOfSentTxs": uint64(0), "txsHashes": map[int]string{}}),
+		},
+		"/send-user-funds": {
+			Handler:  tg.sendUserFunds,
+			Method:   http.MethodPost,
+			Summary:  "sends funds to the receiver from an account of the faucet",
+			Request:  data.FundsRequest{},
+			Response: responseWithData(gin.H{"message": ""}),
+		},
+		"/cost": {
+			Handler:  tg.requestTransactionCost,
+			Method:   http.MethodPost,
+			Summary:  "returns the gas units a transaction would consume",
+			Request:  data.Transaction{},
+			Response: responseWithData(gin.H{"txGasUnits": ""}),
+		},
+		"/:txhash/status": {
+			Handler:     tg.getTransactionStatus,
+			Method:      http.MethodGet,
+			Summary:     "returns the status of a transaction",
+			QueryParams: []string{"sender"},
+			Response:    responseWithData(gin.H{"status": ""}),
+		},
+		"/:txhash": {
+			Handler:     tg.getTransaction,
+			Method:      http.MethodGet,
+			Summary:     "returns a transaction, optionally with its results",
+			QueryParams: []string{"sender", "withResults"},
+			Response:    responseWithData(gin.H{"transaction": data.FullTransaction{}}),
+		},
 	}
 	tg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -57,7 +98,7 @@ func (group *transactionGroup) sendTransaction(c *gin.Context) {
 		return
 	}
 
-	statusCode, txHash, err := group.facade.SendTransaction(&tx)
+	statusCode, txHash, err := group.facade.SendTransaction(c.Request.Context(), &tx)
 	if err != nil {
 		shared.RespondWith(c, statusCode, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -92,7 +133,7 @@ func (group *transactionGroup) sendUserFunds(c *gin.Context) {
 		return
 	}
 
-	err = group.facade.SendUserFunds(gtx.Receiver, gtx.Value)
+	err = group.facade.SendUserFunds(c.Request.Context(), gtx.Receiver, gtx.Value)
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -122,7 +163,7 @@ func (group *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		return
 	}
 
-	response, err := group.facade.SendMultipleTransactions(txs)
+	response, err := group.facade.SendMultipleTransactions(c.Request.Context(), txs)
 	if err != nil {
 		shared.RespondWith(
 			c,
@@ -161,7 +202,7 @@ func (group *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	simulationResponse, err := group.facade.SimulateTransaction(&tx)
+	simulationResponse, err := group.facade.SimulateTransaction(c.Request.Context(), &tx)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -188,7 +229,7 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 		return
 	}
 
-	cost, err := group.facade.TransactionCostRequest(&tx)
+	cost, err := group.facade.TransactionCostRequest(c.Request.Context(), &tx)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -201,7 +242,7 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 func (group *transactionGroup) getTransactionStatus(c *gin.Context) {
 	txHash := c.Param("txhash")
 	sender := c.Request.URL.Query().Get("sender")
-	txStatus, err := group.facade.GetTransactionStatus(txHash, sender)
+	txStatus, err := group.facade.GetTransactionStatus(c.Request.Context(), txHash, sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -230,7 +271,7 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
-	tx, err := group.facade.GetTransaction(txHash, withResults)
+	tx, err := group.facade.GetTransaction(c.Request.Context(), txHash, withResults)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
@@ -240,7 +281,7 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 }
 
 func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, withEvents bool) {
-	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(c.Request.Context(), txHash, sndAddr, withEvents)
 	if err != nil {
 		internalCode := data.ReturnCodeInternalError
 		if statusCode == http.StatusBadRequest {
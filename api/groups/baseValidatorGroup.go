@@ -26,7 +26,12 @@ func NewValidatorGroup(facadeHandler data.FacadeHandler) (*validatorGroup, error
 	}
 
 	baseRoutesHandlers := map[string]*data.EndpointHandlerData{
-		"/statistics": {Handler: vg.statistics, Method: http.MethodGet},
+		"/statistics": {
+			Handler:  vg.statistics,
+			Method:   http.MethodGet,
+			Summary:  "returns the statistics of the validators",
+			Response: responseWithData(data.ValidatorStatisticsResponse{}),
+		},
 	}
 	vg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -35,11 +40,11 @@ func NewValidatorGroup(facadeHandler data.FacadeHandler) (*validatorGroup, error
 
 // statistics returns the validator statistics
 func (group *validatorGroup) statistics(c *gin.Context) {
-	validatorStatistics, err := group.facade.ValidatorStatistics()
+	validatorStatistics, err := group.facade.ValidatorStatistics(c.Request.Context())
 	if err != nil {
 		shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"statistics": validatorStatistics}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, validatorStatistics, "", data.ReturnCodeSuccess)
 }
@@ -17,7 +17,8 @@ import (
 const validatorPath = "/validator"
 
 type valStatsResponseData struct {
-	Statistics map[string]*data.ValidatorApiResponse `json:"statistics"`
+	Statistics     map[string]*data.ValidatorApiResponse `json:"statistics"`
+	SnapshotAgeSec uint64                                `json:"snapshotAgeSec"`
 }
 
 // ValStatsResponse structure
@@ -38,7 +39,7 @@ func TestValidatorStatistics_ShouldErrWhenFacadeFails(t *testing.T) {
 
 	errStr := "expected err"
 	facade := &mock.Facade{
-		ValidatorStatisticsHandler: func() (map[string]*data.ValidatorApiResponse, error) {
+		ValidatorStatisticsHandler: func() (*data.ValidatorStatisticsResponse, error) {
 			return nil, errors.New(errStr)
 		},
 	}
@@ -80,8 +81,8 @@ func TestValidatorStatistics_ShouldWork(t *testing.T) {
 		RatingModifier:                     1.5,
 	}
 	facade := &mock.Facade{
-		ValidatorStatisticsHandler: func() (map[string]*data.ValidatorApiResponse, error) {
-			return valStatsMap, nil
+		ValidatorStatisticsHandler: func() (*data.ValidatorStatisticsResponse, error) {
+			return &data.ValidatorStatisticsResponse{Statistics: valStatsMap, SnapshotAgeSec: 30}, nil
 		},
 	}
 	validatorGroup, err := groups.NewValidatorGroup(facade)
@@ -98,4 +99,5 @@ func TestValidatorStatistics_ShouldWork(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, resp.Code)
 	assert.Equal(t, response.Data.Statistics["statistics"], valStatsMap["statistics"])
+	assert.Equal(t, uint64(30), response.Data.SnapshotAgeSec)
 }
@@ -40,10 +40,34 @@ func NewVmValuesGroup(facadeHandler data.FacadeHandler) (*vmValuesGroup, error)
 	}
 
 	baseRoutesHandlers := map[string]*data.EndpointHandlerData{
-		"/hex":    {Handler: vvg.getHex, Method: http.MethodPost},
-		"/string": {Handler: vvg.getString, Method: http.MethodPost},
-		"/int":    {Handler: vvg.getInt, Method: http.MethodPost},
-		"/query":  {Handler: vvg.executeQuery, Method: http.MethodPost},
+		"/hex": {
+			Handler:  vvg.getHex,
+			Method:   http.MethodPost,
+			Summary:  "executes a VM query and returns its first result, hex encoded",
+			Request:  VMValueRequest{},
+			Response: responseWithData(gin.H{"data": ""}),
+		},
+		"/string": {
+			Handler:  vvg.getString,
+			Method:   http.MethodPost,
+			Summary:  "executes a VM query and returns its first result as a string",
+			Request:  VMValueRequest{},
+			Response: responseWithData(gin.H{"data": ""}),
+		},
+		"/int": {
+			Handler:  vvg.getInt,
+			Method:   http.MethodPost,
+			Summary:  "executes a VM query and returns its first result as an integer, in a string",
+			Request:  VMValueRequest{},
+			Response: responseWithData(gin.H{"data": ""}),
+		},
+		"/query": {
+			Handler:  vvg.executeQuery,
+			Method:   http.MethodPost,
+			Summary:  "executes a VM query and returns its output",
+			Request:  VMValueRequest{},
+			Response: responseWithData(gin.H{"data": vm.VMOutputApi{}}),
+		},
 	}
 	vvg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -69,7 +93,7 @@ func (group *vmValuesGroup) doGetVMValue(context *gin.Context, asType vmcommon.R
 	vmOutput, err := group.doExecuteQuery(context)
 
 	if err != nil {
-		returnBadRequest(context, "doGetVMValue", err)
+		returnQueryError(context, "doGetVMValue", err)
 		return
 	}
 
@@ -86,7 +110,7 @@ func (group *vmValuesGroup) doGetVMValue(context *gin.Context, asType vmcommon.R
 func (group *vmValuesGroup) executeQuery(context *gin.Context) {
 	vmOutput, err := group.doExecuteQuery(context)
 	if err != nil {
-		returnBadRequest(context, "executeQuery", err)
+		returnQueryError(context, "executeQuery", err)
 		return
 	}
 
@@ -105,7 +129,7 @@ func (group *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputAp
 		return nil, err
 	}
 
-	vmOutput, err := group.facade.ExecuteSCQuery(command)
+	vmOutput, err := group.facade.ExecuteSCQuery(context.Request.Context(), command)
 	if err != nil {
 		return nil, err
 	}
@@ -133,6 +157,18 @@ func createSCQuery(request *VMValueRequest) (*data.SCQuery, error) {
 	}, nil
 }
 
+// returnQueryError responds with http.StatusServiceUnavailable if the query was rejected because the observers were
+// overloaded, or with http.StatusBadRequest otherwise
+func returnQueryError(context *gin.Context, errScope string, err error) {
+	if shared.GetInternalErrorStatusCode(err) == http.StatusServiceUnavailable {
+		message := fmt.Sprintf("%s: %s", errScope, err)
+		shared.RespondWith(context, http.StatusServiceUnavailable, nil, message, data.ReturnCodeInternalError)
+		return
+	}
+
+	returnBadRequest(context, errScope, err)
+}
+
 func returnBadRequest(context *gin.Context, errScope string, err error) {
 	message := fmt.Sprintf("%s: %s", errScope, err)
 	shared.RespondWith(context, http.StatusBadRequest, nil, message, data.ReturnCodeRequestError)
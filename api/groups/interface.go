@@ -1,6 +1,7 @@
 package groups
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/data/vm"
@@ -9,18 +10,18 @@ import (
 
 // AccountsFacadeHandler interface defines methods that can be used from facade context variable
 type AccountsFacadeHandler interface {
-	GetAccount(address string) (*data.Account, error)
+	GetAccount(ctx context.Context, address string) (*data.Account, error)
 	GetTransactions(address string) ([]data.DatabaseTransaction, error)
 	GetShardIDForAddress(address string) (uint32, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAllESDTTokens(address string) (*data.GenericAPIResponse, error)
-	GetESDTTokenData(address string, key string) (*data.GenericAPIResponse, error)
+	GetValueForKey(ctx context.Context, address string, key string) (string, error)
+	GetAllESDTTokens(ctx context.Context, address string) (*data.GenericAPIResponse, error)
+	GetESDTTokenData(ctx context.Context, address string, key string) (*data.GenericAPIResponse, error)
 }
 
 // BlocksFacadeHandler interface defines methods that can be used from facade context variable
 type BlocksFacadeHandler interface {
-	GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
-	GetBlockByHash(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
+	GetBlockByHash(ctx context.Context, shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error)
 }
 
 // BlockAtlasFacadeHandler interface defines methods that can be used from facade context variable
@@ -30,41 +31,44 @@ type BlockAtlasFacadeHandler interface {
 
 // HyperBlockFacadeHandler defines the actions needed for fetching the hyperblocks from the nodes
 type HyperBlockFacadeHandler interface {
-	GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error)
-	GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByHash(ctx context.Context, hash string) (*data.HyperblockApiResponse, error)
 }
 
 // NetworkFacadeHandler interface defines methods that can be used from facade context variable
 type NetworkFacadeHandler interface {
-	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
-	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
-	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
+	GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error)
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetEconomicsDataMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
 }
 
 // NodeFacadeHandler interface defines methods that can be used from facade context variable
 type NodeFacadeHandler interface {
-	GetHeartbeatData() (*data.HeartbeatResponse, error)
+	GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error)
+	GetNodesHealth() (*data.NodesHealthResponse, error)
+	GetResponsesCacheStats() data.ResponsesCacheStats
+	GetApiKeysUsage() *data.ApiKeysUsageResponse
 }
 
 // TransactionFacadeHandler interface defines methods that can be used from facade context variable
 type TransactionFacadeHandler interface {
-	SendTransaction(tx *data.Transaction) (int, string, error)
-	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
-	SimulateTransaction(tx *data.Transaction) (*data.GenericAPIResponse, error)
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error)
+	SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	SimulateTransaction(ctx context.Context, tx *data.Transaction) (*data.GenericAPIResponse, error)
 	IsFaucetEnabled() bool
-	SendUserFunds(receiver string, value *big.Int) error
-	TransactionCostRequest(tx *data.Transaction) (string, error)
-	GetTransactionStatus(txHash string, sender string) (string, error)
-	GetTransaction(txHash string, withResults bool) (*data.FullTransaction, error)
-	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error)
+	SendUserFunds(ctx context.Context, receiver string, value *big.Int) error
+	TransactionCostRequest(ctx context.Context, tx *data.Transaction) (string, error)
+	GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error)
+	GetTransaction(ctx context.Context, txHash string, withResults bool) (*data.FullTransaction, error)
+	GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error)
 }
 
 // ValidatorFacadeHandler interface defines methods that can be used from facade context variable
 type ValidatorFacadeHandler interface {
-	ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error)
+	ValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error)
 }
 
 // VmValuesFacadeHandler interface defines methods that can be used from `elrondFacade` context variable
 type VmValuesFacadeHandler interface {
-	ExecuteSCQuery(*data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, error)
 }
@@ -36,6 +36,11 @@ func NewAccountsGroupV_next(baseAccountsGroup data.GroupHandler, facadeHandler d
 	err := ag.baseAccountsGroup.UpdateEndpoint("/:address/shard", data.EndpointHandlerData{
 		Handler: ag.GetShardForAccountV_next,
 		Method:  http.MethodGet,
+		Summary: "returns the shard of the address",
+		Response: data.GenericAPIResponse{
+			Data: gin.H{"shardID": uint32(0)},
+			Code: data.ReturnCodeSuccess,
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -49,6 +54,11 @@ func NewAccountsGroupV_next(baseAccountsGroup data.GroupHandler, facadeHandler d
 	err = ag.baseAccountsGroup.AddEndpoint("/:address/new-endpoint", data.EndpointHandlerData{
 		Handler: ag.NewEndpoint,
 		Method:  http.MethodGet,
+		Summary: "an example of an endpoint added in this version",
+		Response: data.GenericAPIResponse{
+			Data: "",
+			Code: data.ReturnCodeSuccess,
+		},
 	})
 
 	return ag, nil
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/shared"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	healthLivePath  = "/health/live"
+	healthReadyPath = "/health/ready"
+)
+
+func registerHealthRoutes(ws gin.IRoutes, readinessHandler ReadinessHandler) {
+	ws.GET(healthLivePath, getLiveness)
+	ws.GET(healthReadyPath, func(c *gin.Context) {
+		getReadiness(c, readinessHandler)
+	})
+}
+
+// getLiveness answers as long as the proxy serves HTTP requests, regardless of the observers' state
+func getLiveness(c *gin.Context) {
+	shared.RespondWith(c, http.StatusOK, gin.H{"status": "alive"}, "", data.ReturnCodeSuccess)
+}
+
+// getReadiness returns the readiness of the proxy, answering with http.StatusServiceUnavailable if a shard has no
+// reachable observer
+func getReadiness(c *gin.Context, readinessHandler ReadinessHandler) {
+	readiness := readinessHandler.GetReadiness()
+	if !readiness.IsReady {
+		shared.RespondWith(
+			c,
+			http.StatusServiceUnavailable,
+			gin.H{"readiness": readiness},
+			errors.ErrProxyNotReady.Error(),
+			data.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"readiness": readiness}, "", data.ReturnCodeSuccess)
+}
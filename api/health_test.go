@@ -0,0 +1,120 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api"
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type readinessResponse struct {
+	Data struct {
+		Readiness data.ReadinessResponse `json:"readiness"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type emptyVersionsRegistry struct{}
+
+func (evr *emptyVersionsRegistry) AddVersion(_ string, _ *data.VersionData) error {
+	return nil
+}
+
+func (evr *emptyVersionsRegistry) GetAllVersions() (map[string]*data.VersionData, error) {
+	return make(map[string]*data.VersionData), nil
+}
+
+func (evr *emptyVersionsRegistry) IsInterfaceNil() bool {
+	return evr == nil
+}
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// the tests are not run in parallel, as creating a server registers the validators in the shared binding engine
+func createServerWithReadiness(t *testing.T, readinessHandler api.ReadinessHandler) http.Handler {
+	rejectAll := func(c *gin.Context) {
+		c.AbortWithStatus(http.StatusUnauthorized)
+	}
+
+	server, err := api.CreateServer(&emptyVersionsRegistry{}, 8080, readinessHandler, nil, rejectAll)
+	require.Nil(t, err)
+
+	return server.Handler
+}
+
+func TestHealthLive_ShouldBypassTheMiddlewares(t *testing.T) {
+	ws := createServerWithReadiness(t, &mock.ReadinessHandlerStub{})
+
+	req, _ := http.NewRequest(http.MethodGet, "/health/live", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestHealthReady_ShouldReturnTheReadiness(t *testing.T) {
+	ws := createServerWithReadiness(t, &mock.ReadinessHandlerStub{
+		GetReadinessCalled: func() *data.ReadinessResponse {
+			return &data.ReadinessResponse{
+				IsReady:              true,
+				IsMetachainReachable: true,
+				Shards:               []*data.ShardReadiness{{ShardId: 0, NumConfiguredObservers: 1, NumReachableObservers: 1, IsReachable: true}},
+			}
+		},
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/health/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := readinessResponse{}
+	err := json.NewDecoder(resp.Body).Decode(&response)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, response.Data.Readiness.IsReady)
+	assert.Equal(t, 1, len(response.Data.Readiness.Shards))
+	assert.Empty(t, response.Error)
+}
+
+func TestHealthReady_NotReadyShouldReturnServiceUnavailable(t *testing.T) {
+	ws := createServerWithReadiness(t, &mock.ReadinessHandlerStub{
+		GetReadinessCalled: func() *data.ReadinessResponse {
+			return &data.ReadinessResponse{
+				IsReady: false,
+				Shards:  []*data.ShardReadiness{{ShardId: 0, NumConfiguredObservers: 1}},
+			}
+		},
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/health/ready", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := readinessResponse{}
+	err := json.NewDecoder(resp.Body).Decode(&response)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.False(t, response.Data.Readiness.IsReady)
+	assert.Equal(t, apiErrors.ErrProxyNotReady.Error(), response.Error)
+}
+
+func TestCreateServer_WithoutReadinessHandlerShouldNotServeTheHealthRoutes(t *testing.T) {
+	ws := createServerWithReadiness(t, nil)
+
+	req, _ := http.NewRequest(http.MethodGet, "/health/live", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+}
@@ -1,5 +1,13 @@
 package api
 
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
 // ElrondProxyHandler interface defines methods that can be used from facade context variable
 type ElrondProxyHandler interface {
 }
+
+// ReadinessHandler defines what a component which tells whether the proxy can serve requests should be able to do
+type ReadinessHandler interface {
+	GetReadiness() *data.ReadinessResponse
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/shared"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+)
+
+// authenticatedApiKeyContextKey is the key under which the API key checked by the ApiKeysAuthenticator is stored in the
+// gin context, so the next middlewares can identify the client by it
+const authenticatedApiKeyContextKey = "authenticatedApiKey"
+
+// adminPaths holds the routes, without the version, which can only be accessed with the API keys of an admin tier
+var adminPaths = []string{"/node/api-keys-usage", "/node/nodes-health", "/metrics"}
+
+// ArgsApiKeysAuthenticator holds the arguments needed for creating a new ApiKeysAuthenticator
+type ArgsApiKeysAuthenticator struct {
+	HeaderName     string
+	QueryParamName string
+	AnonymousTier  string
+	Versions       []string
+	Registry       ApiKeysRegistryHandler
+}
+
+// ApiKeysAuthenticator authenticates the API clients by the API key sent in the configured header or query parameter.
+// Each key has a tier, which restricts the endpoints the key can access, limits the rate of its requests and sets its
+// daily quota. The requests without a key are rejected, unless an anonymous tier is configured, in which case they
+// are rate limited by IP, without a daily quota
+type ApiKeysAuthenticator struct {
+	headerName      string
+	queryParamName  string
+	anonymousTier   *data.ApiKeyTier
+	versionPrefixes []string
+	registry        ApiKeysRegistryHandler
+	getTimeHandler  func() time.Time
+	buckets         *tokenBuckets
+}
+
+// NewApiKeysAuthenticator creates a new instance of ApiKeysAuthenticator
+func NewApiKeysAuthenticator(args ArgsApiKeysAuthenticator) (*ApiKeysAuthenticator, error) {
+	if check.IfNil(args.Registry) {
+		return nil, ErrNilApiKeysRegistry
+	}
+	if len(args.HeaderName) == 0 && len(args.QueryParamName) == 0 {
+		return nil, ErrNoApiKeySource
+	}
+
+	var anonymousTier *data.ApiKeyTier
+	if len(args.AnonymousTier) > 0 {
+		tier, found := args.Registry.GetTier(args.AnonymousTier)
+		if !found {
+			return nil, ErrUnknownAnonymousTier
+		}
+		if tier.IsAdmin {
+			return nil, ErrAdminAnonymousTier
+		}
+		anonymousTier = tier
+	}
+
+	return &ApiKeysAuthenticator{
+		headerName:      args.HeaderName,
+		queryParamName:  args.QueryParamName,
+		anonymousTier:   anonymousTier,
+		versionPrefixes: createVersionPrefixes(args.Versions),
+		registry:        args.Registry,
+		getTimeHandler:  time.Now,
+		buckets:         newTokenBuckets(),
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the middleware which rejects the requests with a missing or unknown API key
+// (http.StatusUnauthorized), the requests for endpoints not allowed by the key's tier (http.StatusForbidden) and the
+// requests over the tier's rate limit or daily quota (http.StatusTooManyRequests)
+func (aka *ApiKeysAuthenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKey := aka.getApiKey(c)
+
+		tier, clientKey, err := aka.getTier(apiKey, getClientIP(c))
+		if err != nil {
+			abortWith(c, http.StatusUnauthorized, err)
+			return
+		}
+		if len(apiKey) > 0 {
+			c.Set(authenticatedApiKeyContextKey, apiKey)
+		}
+
+		routePath := trimVersion(aka.versionPrefixes, c.FullPath())
+		if !isRouteAllowed(tier, routePath) {
+			abortWith(c, http.StatusForbidden, errors.ErrEndpointNotAllowed)
+			return
+		}
+
+		now := aka.getTimeHandler()
+		if tier.RequestsPerSecond > 0 {
+			bucketKey := tier.Name + " " + clientKey
+			retryAfter, isAllowed := aka.buckets.takeToken(bucketKey, tier.Burst, tier.RequestsPerSecond, now)
+			if !isAllowed {
+				setRetryAfter(c, retryAfter)
+				abortWith(c, http.StatusTooManyRequests, errors.ErrTooManyRequests)
+				return
+			}
+		}
+
+		if len(apiKey) > 0 && !aka.registry.TryConsumeQuota(apiKey) {
+			setRetryAfter(c, durationUntilNextDay(now))
+			abortWith(c, http.StatusTooManyRequests, errors.ErrDailyQuotaExceeded)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func (aka *ApiKeysAuthenticator) getApiKey(c *gin.Context) string {
+	if len(aka.headerName) > 0 {
+		apiKey := c.GetHeader(aka.headerName)
+		if len(apiKey) > 0 {
+			return apiKey
+		}
+	}
+	if len(aka.queryParamName) > 0 {
+		return c.Query(aka.queryParamName)
+	}
+
+	return ""
+}
+
+// getTier returns the tier of the request and the key identifying the client in the rate limiter
+func (aka *ApiKeysAuthenticator) getTier(apiKey string, clientIP string) (*data.ApiKeyTier, string, error) {
+	if len(apiKey) == 0 {
+		if aka.anonymousTier == nil {
+			return nil, "", errors.ErrMissingApiKey
+		}
+
+		return aka.anonymousTier, "ip:" + clientIP, nil
+	}
+
+	tier, found := aka.registry.GetApiKeyTier(apiKey)
+	if !found {
+		return nil, "", errors.ErrInvalidApiKey
+	}
+
+	return tier, "key:" + apiKey, nil
+}
+
+// isRouteAllowed returns true if the tier allows the given route. The admin routes are only allowed to the admin
+// tiers, while the other routes are allowed if the tier has no allowed paths or if one of them matches the route
+func isRouteAllowed(tier *data.ApiKeyTier, routePath string) bool {
+	for _, adminPath := range adminPaths {
+		if isPathMatching(routePath, adminPath) {
+			return tier.IsAdmin
+		}
+	}
+
+	if len(tier.AllowedPaths) == 0 {
+		return true
+	}
+	for _, allowedPath := range tier.AllowedPaths {
+		if isPathMatching(routePath, allowedPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func durationUntilNextDay(now time.Time) time.Duration {
+	nowUTC := now.UTC()
+	nextDay := time.Date(nowUTC.Year(), nowUTC.Month(), nowUTC.Day()+1, 0, 0, 0, 0, time.UTC)
+
+	return nextDay.Sub(nowUTC)
+}
+
+func setRetryAfter(c *gin.Context, retryAfter time.Duration) {
+	c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+}
+
+func abortWith(c *gin.Context, status int, err error) {
+	shared.RespondWith(c, status, nil, err.Error(), data.ReturnCodeRequestError)
+	c.Abort()
+}
@@ -0,0 +1,197 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testTiers = map[string]*data.ApiKeyTier{
+	"free":      {Name: "free", AllowedPaths: []string{"/address"}, RequestsPerSecond: 1, Burst: 2},
+	"admin":     {Name: "admin", IsAdmin: true},
+	"anonymous": {Name: "anonymous", AllowedPaths: []string{"/address"}, RequestsPerSecond: 1, Burst: 1},
+}
+
+var testKeysTiers = map[string]string{
+	"free-key":  "free",
+	"admin-key": "admin",
+}
+
+func createArgsApiKeysAuthenticator() ArgsApiKeysAuthenticator {
+	return ArgsApiKeysAuthenticator{
+		HeaderName:     "X-Api-Key",
+		QueryParamName: "apikey",
+		Versions:       []string{"", "v1.0"},
+		Registry: &mock.ApiKeysRegistryStub{
+			GetApiKeyTierCalled: func(key string) (*data.ApiKeyTier, bool) {
+				tier, found := testTiers[testKeysTiers[key]]
+				return tier, found
+			},
+			GetTierCalled: func(name string) (*data.ApiKeyTier, bool) {
+				tier, found := testTiers[name]
+				return tier, found
+			},
+		},
+	}
+}
+
+func createApiKeysTestServer(t *testing.T, args ArgsApiKeysAuthenticator, now time.Time) *gin.Engine {
+	aka, err := NewApiKeysAuthenticator(args)
+	require.Nil(t, err)
+	aka.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	ws := gin.New()
+	ws.Use(aka.MiddlewareHandlerFunc())
+	for _, path := range []string{"/address/:address", "/transaction/send", "/node/api-keys-usage", "/node/nodes-health"} {
+		ws.GET("/v1.0"+path, func(c *gin.Context) {
+			c.Header("X-Authenticated-Key", c.GetString(authenticatedApiKeyContextKey))
+			c.Status(http.StatusOK)
+		})
+	}
+
+	return ws
+}
+
+func sendRequest(ws *gin.Engine, path string, apiKey string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	if len(apiKey) > 0 {
+		req.Header.Set("X-Api-Key", apiKey)
+	}
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func requireResponseError(t *testing.T, resp *httptest.ResponseRecorder, expectedStatus int, expectedErr error) {
+	require.Equal(t, expectedStatus, resp.Code)
+
+	response := data.GenericAPIResponse{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	require.Nil(t, err)
+	assert.Equal(t, expectedErr.Error(), response.Error)
+}
+
+func TestNewApiKeysAuthenticator_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsApiKeysAuthenticator()
+	args.Registry = nil
+	aka, err := NewApiKeysAuthenticator(args)
+	assert.Nil(t, aka)
+	assert.Equal(t, ErrNilApiKeysRegistry, err)
+
+	args = createArgsApiKeysAuthenticator()
+	args.HeaderName = ""
+	args.QueryParamName = ""
+	aka, err = NewApiKeysAuthenticator(args)
+	assert.Nil(t, aka)
+	assert.Equal(t, ErrNoApiKeySource, err)
+
+	args = createArgsApiKeysAuthenticator()
+	args.AnonymousTier = "missing"
+	aka, err = NewApiKeysAuthenticator(args)
+	assert.Nil(t, aka)
+	assert.Equal(t, ErrUnknownAnonymousTier, err)
+
+	args = createArgsApiKeysAuthenticator()
+	args.AnonymousTier = "admin"
+	aka, err = NewApiKeysAuthenticator(args)
+	assert.Nil(t, aka)
+	assert.Equal(t, ErrAdminAnonymousTier, err)
+}
+
+func TestApiKeysAuthenticator_MissingOrInvalidKeyShouldBeUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	ws := createApiKeysTestServer(t, createArgsApiKeysAuthenticator(), time.Now())
+
+	requireResponseError(t, sendRequest(ws, "/v1.0/address/erd1", ""), http.StatusUnauthorized, errors.ErrMissingApiKey)
+	requireResponseError(t, sendRequest(ws, "/v1.0/address/erd1", "unknown-key"), http.StatusUnauthorized, errors.ErrInvalidApiKey)
+
+	assert.Equal(t, http.StatusOK, sendRequest(ws, "/v1.0/address/erd1", "free-key").Code)
+	assert.Equal(t, http.StatusOK, sendRequest(ws, "/v1.0/address/erd1?apikey=free-key", "").Code)
+}
+
+func TestApiKeysAuthenticator_AuthenticatedKeyShouldBeStoredInContext(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsApiKeysAuthenticator()
+	args.AnonymousTier = "anonymous"
+	ws := createApiKeysTestServer(t, args, time.Now())
+
+	resp := sendRequest(ws, "/v1.0/address/erd1", "free-key")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "free-key", resp.Header().Get("X-Authenticated-Key"))
+
+	resp = sendRequest(ws, "/v1.0/address/erd1", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, resp.Header().Get("X-Authenticated-Key"))
+}
+
+func TestApiKeysAuthenticator_TierShouldRestrictTheEndpoints(t *testing.T) {
+	t.Parallel()
+
+	ws := createApiKeysTestServer(t, createArgsApiKeysAuthenticator(), time.Now())
+
+	requireResponseError(t, sendRequest(ws, "/v1.0/transaction/send", "free-key"), http.StatusForbidden, errors.ErrEndpointNotAllowed)
+	requireResponseError(t, sendRequest(ws, "/v1.0/node/api-keys-usage", "free-key"), http.StatusForbidden, errors.ErrEndpointNotAllowed)
+	requireResponseError(t, sendRequest(ws, "/v1.0/node/nodes-health", "free-key"), http.StatusForbidden, errors.ErrEndpointNotAllowed)
+
+	assert.Equal(t, http.StatusOK, sendRequest(ws, "/v1.0/transaction/send", "admin-key").Code)
+	assert.Equal(t, http.StatusOK, sendRequest(ws, "/v1.0/node/api-keys-usage", "admin-key").Code)
+	assert.Equal(t, http.StatusOK, sendRequest(ws, "/v1.0/node/nodes-health", "admin-key").Code)
+}
+
+func TestApiKeysAuthenticator_TierShouldLimitTheRate(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsApiKeysAuthenticator()
+	args.AnonymousTier = "anonymous"
+	ws := createApiKeysTestServer(t, args, time.Now())
+
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, http.StatusOK, sendRequest(ws, "/v1.0/address/erd1", "free-key").Code)
+	}
+	resp := sendRequest(ws, "/v1.0/address/erd1", "free-key")
+	requireResponseError(t, resp, http.StatusTooManyRequests, errors.ErrTooManyRequests)
+	assert.Equal(t, "1", resp.Header().Get("Retry-After"))
+
+	// the requests without a key are limited by IP, with the anonymous tier's limits
+	assert.Equal(t, http.StatusOK, sendRequest(ws, "/v1.0/address/erd1", "").Code)
+	requireResponseError(t, sendRequest(ws, "/v1.0/address/erd1", ""), http.StatusTooManyRequests, errors.ErrTooManyRequests)
+
+	// the admin tier has no rate limit
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, http.StatusOK, sendRequest(ws, "/v1.0/address/erd1", "admin-key").Code)
+	}
+}
+
+func TestApiKeysAuthenticator_ExhaustedQuotaShouldRetryTheNextDay(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsApiKeysAuthenticator()
+	args.Registry.(*mock.ApiKeysRegistryStub).TryConsumeQuotaCalled = func(key string) bool {
+		return key != "admin-key"
+	}
+	ws := createApiKeysTestServer(t, args, time.Date(2021, 3, 4, 23, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, http.StatusOK, sendRequest(ws, "/v1.0/address/erd1", "free-key").Code)
+
+	resp := sendRequest(ws, "/v1.0/address/erd1", "admin-key")
+	requireResponseError(t, resp, http.StatusTooManyRequests, errors.ErrDailyQuotaExceeded)
+	assert.Equal(t, "3600", resp.Header().Get("Retry-After"))
+}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// clientIPContextKey is the key under which the IP resolved by the ClientIPResolver is stored in the gin context
+const clientIPContextKey = "clientIP"
+
+const (
+	forwardedForHeader = "X-Forwarded-For"
+	realIPHeader       = "X-Real-Ip"
+)
+
+// ClientIPResolver finds the IP of the clients sending requests through the trusted reverse proxies. The forwarded
+// headers are read only if the request comes from a trusted proxy, as any client can set them
+type ClientIPResolver struct {
+	trustedProxies []*net.IPNet
+}
+
+// NewClientIPResolver creates a new instance of ClientIPResolver. Each trusted proxy is either an IP or a CIDR range
+func NewClientIPResolver(trustedProxies []string) (*ClientIPResolver, error) {
+	networks := make([]*net.IPNet, 0, len(trustedProxies))
+	for _, proxy := range trustedProxies {
+		network, err := parseTrustedProxy(proxy)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, network)
+	}
+
+	return &ClientIPResolver{
+		trustedProxies: networks,
+	}, nil
+}
+
+func parseTrustedProxy(proxy string) (*net.IPNet, error) {
+	if strings.Contains(proxy, "/") {
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s", ErrInvalidTrustedProxy, proxy)
+		}
+
+		return network, nil
+	}
+
+	ip := net.ParseIP(proxy)
+	if ip == nil {
+		return nil, fmt.Errorf("%w %s", ErrInvalidTrustedProxy, proxy)
+	}
+	bits := 8 * net.IPv4len
+	if ip.To4() == nil {
+		bits = 8 * net.IPv6len
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// MiddlewareHandlerFunc returns the middleware which stores the client's IP in the gin context, so the next middlewares
+// identify the client by it
+func (cir *ClientIPResolver) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(clientIPContextKey, cir.resolveClientIP(c))
+		c.Next()
+	}
+}
+
+// resolveClientIP walks the X-Forwarded-For chain from the nearest hop and returns the first address which is not a
+// trusted proxy, so the entries added by the client itself are never used
+func (cir *ClientIPResolver) resolveClientIP(c *gin.Context) string {
+	remoteIP := getRemoteIP(c)
+	if !cir.isTrustedProxy(remoteIP) {
+		return remoteIP
+	}
+
+	hops := getForwardedForHops(c)
+	if len(hops) == 0 {
+		realIP := net.ParseIP(strings.TrimSpace(c.GetHeader(realIPHeader)))
+		if realIP == nil {
+			return remoteIP
+		}
+
+		return realIP.String()
+	}
+
+	clientIP := remoteIP
+	for i := len(hops) - 1; i >= 0; i-- {
+		hopIP := net.ParseIP(hops[i])
+		if hopIP == nil {
+			break
+		}
+
+		clientIP = hopIP.String()
+		if !cir.isTrustedProxy(clientIP) {
+			break
+		}
+	}
+
+	return clientIP
+}
+
+func (cir *ClientIPResolver) isTrustedProxy(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range cir.trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getForwardedForHops(c *gin.Context) []string {
+	hops := make([]string, 0)
+	for _, header := range c.Request.Header[forwardedForHeader] {
+		for _, hop := range strings.Split(header, ",") {
+			hop = strings.TrimSpace(hop)
+			if len(hop) > 0 {
+				hops = append(hops, hop)
+			}
+		}
+	}
+
+	return hops
+}
+
+// getClientIP returns the IP resolved by the ClientIPResolver or, if there is none, the IP of the connection's peer.
+// The forwarded headers are never read here, as they can be set by any client
+func getClientIP(c *gin.Context) string {
+	clientIP := c.GetString(clientIPContextKey)
+	if len(clientIP) > 0 {
+		return clientIP
+	}
+
+	return getRemoteIP(c)
+}
+
+func getRemoteIP(c *gin.Context) string {
+	host, _, err := net.SplitHostPort(strings.TrimSpace(c.Request.RemoteAddr))
+	if err != nil {
+		return strings.TrimSpace(c.Request.RemoteAddr)
+	}
+
+	return host
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resolveClientIP(t *testing.T, trustedProxies []string, remoteAddr string, headers map[string]string) string {
+	cir, err := NewClientIPResolver(trustedProxies)
+	require.Nil(t, err)
+
+	clientIPs := make(chan string, 1)
+	ws := gin.New()
+	ws.Use(cir.MiddlewareHandlerFunc())
+	ws.GET("/test", func(c *gin.Context) {
+		clientIPs <- getClientIP(c)
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+	req.RemoteAddr = remoteAddr
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	ws.ServeHTTP(httptest.NewRecorder(), req)
+
+	return <-clientIPs
+}
+
+func TestNewClientIPResolver_InvalidTrustedProxyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cir, err := NewClientIPResolver([]string{"10.0.0.1", "not-an-ip"})
+	assert.Nil(t, cir)
+	assert.True(t, errors.Is(err, ErrInvalidTrustedProxy))
+
+	cir, err = NewClientIPResolver([]string{"10.0.0.0/33"})
+	assert.Nil(t, cir)
+	assert.True(t, errors.Is(err, ErrInvalidTrustedProxy))
+}
+
+func TestClientIPResolver_ForwardedHeadersFromUntrustedPeerShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	headers := map[string]string{"X-Forwarded-For": "1.1.1.1", "X-Real-Ip": "2.2.2.2"}
+
+	assert.Equal(t, "10.0.0.1", resolveClientIP(t, nil, "10.0.0.1:1234", headers))
+	assert.Equal(t, "10.0.0.1", resolveClientIP(t, []string{"192.168.0.0/16"}, "10.0.0.1:1234", headers))
+}
+
+func TestClientIPResolver_ForwardedHeadersFromTrustedProxyShouldBeRead(t *testing.T) {
+	t.Parallel()
+
+	trustedProxies := []string{"10.0.0.0/8", "192.168.1.1"}
+
+	// the entries added by the client itself, before the first untrusted address, are ignored
+	headers := map[string]string{"X-Forwarded-For": "6.6.6.6, 1.1.1.1, 192.168.1.1"}
+	assert.Equal(t, "1.1.1.1", resolveClientIP(t, trustedProxies, "10.0.0.1:1234", headers))
+
+	headers = map[string]string{"X-Real-Ip": "2.2.2.2"}
+	assert.Equal(t, "2.2.2.2", resolveClientIP(t, trustedProxies, "10.0.0.1:1234", headers))
+
+	headers = map[string]string{"X-Forwarded-For": "garbage"}
+	assert.Equal(t, "10.0.0.1", resolveClientIP(t, trustedProxies, "10.0.0.1:1234", headers))
+
+	assert.Equal(t, "10.0.0.1", resolveClientIP(t, trustedProxies, "10.0.0.1:1234", nil))
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import "errors"
+
+// ErrInvalidRequestsPerSecond signals that an invalid number of requests per second has been provided
+var ErrInvalidRequestsPerSecond = errors.New("invalid requests per second")
+
+// ErrInvalidBurst signals that an invalid burst has been provided
+var ErrInvalidBurst = errors.New("invalid burst")
+
+// ErrDuplicateRateLimitRule signals that more than one rate limiting rule has been provided for the same path
+var ErrDuplicateRateLimitRule = errors.New("duplicate rate limiting rule")
+
+// ErrInvalidRateLimitRulePath signals that a rate limiting rule has been provided with a path not starting with a slash
+var ErrInvalidRateLimitRulePath = errors.New("invalid rate limiting rule path")
+
+// ErrInvalidTrustedProxy signals that a trusted proxy has been provided which is neither an IP nor a CIDR range
+var ErrInvalidTrustedProxy = errors.New("invalid trusted proxy")
+
+// ErrNilApiKeysRegistry signals that a nil API keys registry has been provided
+var ErrNilApiKeysRegistry = errors.New("nil api keys registry")
+
+// ErrNoApiKeySource signals that neither a header nor a query parameter has been provided for reading the API keys
+var ErrNoApiKeySource = errors.New("no header or query parameter provided for the api keys")
+
+// ErrUnknownAnonymousTier signals that the tier of the requests without an API key does not exist
+var ErrUnknownAnonymousTier = errors.New("unknown anonymous tier")
+
+// ErrAdminAnonymousTier signals that an admin tier has been provided for the requests without an API key
+var ErrAdminAnonymousTier = errors.New("the anonymous tier cannot be an admin tier")
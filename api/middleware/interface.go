@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ApiKeysRegistryHandler defines what a component which holds the API keys and counts their requests should be able
+// to do
+type ApiKeysRegistryHandler interface {
+	GetApiKeyTier(key string) (*data.ApiKeyTier, bool)
+	GetTier(name string) (*data.ApiKeyTier, bool)
+	TryConsumeQuota(key string) bool
+	IsInterfaceNil() bool
+}
+
+// ApiMetricsHandler defines what a component which collects the metrics of the REST API requests should be able to do
+type ApiMetricsHandler interface {
+	RecordApiRequest(route string, method string, statusCode int, duration time.Duration)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import "strings"
+
+func createVersionPrefixes(versions []string) []string {
+	versionPrefixes := make([]string, 0, len(versions))
+	for _, version := range versions {
+		if len(version) > 0 {
+			versionPrefixes = append(versionPrefixes, "/"+version)
+		}
+	}
+
+	return versionPrefixes
+}
+
+// trimVersion removes the version prefix of the given route path, so the same rules apply to all the versions
+func trimVersion(versionPrefixes []string, routePath string) string {
+	for _, prefix := range versionPrefixes {
+		if strings.HasPrefix(routePath, prefix+"/") {
+			return routePath[len(prefix):]
+		}
+	}
+
+	return routePath
+}
+
+// isPathMatching returns true if the route path is the given path or starts with the given path followed by a slash
+func isPathMatching(routePath string, path string) bool {
+	return routePath == path || strings.HasPrefix(routePath, path+"/")
+}
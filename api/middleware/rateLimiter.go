@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitRule holds the token bucket settings of the routes whose path, without the version, is Path or starts with
+// Path followed by a slash. This way a rule can cover a whole route group (/address) or a single endpoint
+// (/transaction/send)
+type RateLimitRule struct {
+	Path              string
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// ArgsRateLimiter holds the arguments needed for creating a new RateLimiter
+type ArgsRateLimiter struct {
+	Versions    []string
+	DefaultRule RateLimitRule
+	Rules       []RateLimitRule
+}
+
+// RateLimiter limits the rate of the requests of each client with token buckets. A client is identified by its API
+// key, if the key was checked by the ApiKeysAuthenticator, or by its IP otherwise, so sending made-up keys doesn't
+// escape the limits. Each client has a bucket for each rule, holding at most
+// Burst tokens, refilled with RequestsPerSecond tokens per second. A request takes a token from the bucket of the
+// most specific rule matching its route and is rejected if the bucket is empty
+type RateLimiter struct {
+	versionPrefixes []string
+	defaultRule     RateLimitRule
+	rules           []RateLimitRule
+	getTimeHandler  func() time.Time
+	buckets         *tokenBuckets
+}
+
+// NewRateLimiter creates a new instance of RateLimiter
+func NewRateLimiter(args ArgsRateLimiter) (*RateLimiter, error) {
+	err := checkRateLimitRule(args.DefaultRule)
+	if err != nil {
+		return nil, err
+	}
+
+	rulesPaths := make(map[string]struct{})
+	for _, rule := range args.Rules {
+		if !strings.HasPrefix(rule.Path, "/") {
+			return nil, ErrInvalidRateLimitRulePath
+		}
+		err = checkRateLimitRule(rule)
+		if err != nil {
+			return nil, err
+		}
+
+		_, isDuplicate := rulesPaths[rule.Path]
+		if isDuplicate {
+			return nil, ErrDuplicateRateLimitRule
+		}
+		rulesPaths[rule.Path] = struct{}{}
+	}
+
+	return &RateLimiter{
+		versionPrefixes: createVersionPrefixes(args.Versions),
+		defaultRule:     args.DefaultRule,
+		rules:           args.Rules,
+		getTimeHandler:  time.Now,
+		buckets:         newTokenBuckets(),
+	}, nil
+}
+
+func checkRateLimitRule(rule RateLimitRule) error {
+	if rule.RequestsPerSecond <= 0 {
+		return ErrInvalidRequestsPerSecond
+	}
+	if rule.Burst < 1 {
+		return ErrInvalidBurst
+	}
+
+	return nil
+}
+
+// MiddlewareHandlerFunc returns the middleware which answers with http.StatusTooManyRequests the requests of the
+// clients exceeding their limits, setting the Retry-After header to the number of seconds until the next token
+func (rl *RateLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rule := rl.getRule(c.FullPath())
+		retryAfter, isAllowed := rl.takeToken(rule, rl.getClientKey(c))
+		if !isAllowed {
+			setRetryAfter(c, retryAfter)
+			abortWith(c, http.StatusTooManyRequests, errors.ErrTooManyRequests)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+func (rl *RateLimiter) getClientKey(c *gin.Context) string {
+	apiKey := c.GetString(authenticatedApiKeyContextKey)
+	if len(apiKey) > 0 {
+		return "key:" + apiKey
+	}
+
+	return "ip:" + getClientIP(c)
+}
+
+// getRule returns the rule with the longest path matching the given route, or the default rule if none matches
+func (rl *RateLimiter) getRule(routePath string) RateLimitRule {
+	routePath = trimVersion(rl.versionPrefixes, routePath)
+
+	bestRule := rl.defaultRule
+	bestRule.Path = ""
+	for _, rule := range rl.rules {
+		if isPathMatching(routePath, rule.Path) && len(rule.Path) > len(bestRule.Path) {
+			bestRule = rule
+		}
+	}
+
+	return bestRule
+}
+
+// takeToken takes a token from the client's bucket of the given rule. If the bucket is empty, it returns the duration
+// until a token will be available
+func (rl *RateLimiter) takeToken(rule RateLimitRule, clientKey string) (time.Duration, bool) {
+	bucketKey := rule.Path + " " + clientKey
+	return rl.buckets.takeToken(bucketKey, rule.Burst, rule.RequestsPerSecond, rl.getTimeHandler())
+}
@@ -0,0 +1,227 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsRateLimiter() ArgsRateLimiter {
+	return ArgsRateLimiter{
+		Versions:    []string{"", "v1.0"},
+		DefaultRule: RateLimitRule{RequestsPerSecond: 10, Burst: 3},
+		Rules: []RateLimitRule{
+			{Path: "/transaction", RequestsPerSecond: 10, Burst: 2},
+			{Path: "/transaction/send", RequestsPerSecond: 0.5, Burst: 1},
+		},
+	}
+}
+
+var testAuthenticatedKeys = map[string]bool{
+	"key1": true,
+	"key2": true,
+}
+
+// fakeAuthenticator stands for the ApiKeysAuthenticator, marking only the known keys as authenticated
+func fakeAuthenticator(c *gin.Context) {
+	apiKey := c.GetHeader("X-Api-Key")
+	if testAuthenticatedKeys[apiKey] {
+		c.Set(authenticatedApiKeyContextKey, apiKey)
+	}
+	c.Next()
+}
+
+type rateLimiterTestServer struct {
+	ws  *gin.Engine
+	now time.Time
+}
+
+func createRateLimiterTestServer(t *testing.T, args ArgsRateLimiter) *rateLimiterTestServer {
+	rl, err := NewRateLimiter(args)
+	require.Nil(t, err)
+
+	server := &rateLimiterTestServer{
+		ws:  gin.New(),
+		now: time.Now(),
+	}
+	rl.getTimeHandler = func() time.Time {
+		return server.now
+	}
+
+	server.ws.Use(fakeAuthenticator, rl.MiddlewareHandlerFunc())
+	for _, path := range []string{"/transaction/send", "/transaction/hash/:txhash", "/address/:address"} {
+		server.ws.GET(path, func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		server.ws.GET("/v1.0"+path, func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+	}
+
+	return server
+}
+
+func (server *rateLimiterTestServer) get(path string, apiKey string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	if len(apiKey) > 0 {
+		req.Header.Set("X-Api-Key", apiKey)
+	}
+
+	resp := httptest.NewRecorder()
+	server.ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewRateLimiter_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsRateLimiter()
+	args.DefaultRule.RequestsPerSecond = 0
+	rl, err := NewRateLimiter(args)
+	assert.Nil(t, rl)
+	assert.Equal(t, ErrInvalidRequestsPerSecond, err)
+
+	args = createArgsRateLimiter()
+	args.Rules[0].Burst = 0
+	rl, err = NewRateLimiter(args)
+	assert.Nil(t, rl)
+	assert.Equal(t, ErrInvalidBurst, err)
+
+	args = createArgsRateLimiter()
+	args.Rules[0].Path = "transaction"
+	rl, err = NewRateLimiter(args)
+	assert.Nil(t, rl)
+	assert.Equal(t, ErrInvalidRateLimitRulePath, err)
+
+	args = createArgsRateLimiter()
+	args.Rules[1].Path = args.Rules[0].Path
+	rl, err = NewRateLimiter(args)
+	assert.Nil(t, rl)
+	assert.Equal(t, ErrDuplicateRateLimitRule, err)
+}
+
+func TestRateLimiter_OverLimitRequestsShouldGetTooManyRequests(t *testing.T) {
+	t.Parallel()
+
+	server := createRateLimiterTestServer(t, createArgsRateLimiter())
+
+	assert.Equal(t, http.StatusOK, server.get("/v1.0/transaction/send", "").Code)
+	resp := server.get("/v1.0/transaction/send", "")
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	assert.Equal(t, "2", resp.Header().Get("Retry-After"))
+
+	response := data.GenericAPIResponse{}
+	err := json.Unmarshal(resp.Body.Bytes(), &response)
+	require.Nil(t, err)
+	assert.Equal(t, errors.ErrTooManyRequests.Error(), response.Error)
+	assert.Equal(t, data.ReturnCodeRequestError, response.Code)
+
+	// the unversioned route shares the bucket of the versioned one
+	assert.Equal(t, http.StatusTooManyRequests, server.get("/transaction/send", "").Code)
+
+	server.now = server.now.Add(2 * time.Second)
+	assert.Equal(t, http.StatusOK, server.get("/transaction/send", "").Code)
+}
+
+func TestRateLimiter_EachRuleShouldHaveItsOwnBucket(t *testing.T) {
+	t.Parallel()
+
+	server := createRateLimiterTestServer(t, createArgsRateLimiter())
+
+	assert.Equal(t, http.StatusOK, server.get("/v1.0/transaction/send", "").Code)
+	assert.Equal(t, http.StatusTooManyRequests, server.get("/v1.0/transaction/send", "").Code)
+
+	// the endpoint has a stricter rule than its group
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, http.StatusOK, server.get("/v1.0/transaction/hash/aabb", "").Code)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, server.get("/v1.0/transaction/hash/aabb", "").Code)
+
+	// the routes not matched by any rule use the default rule
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, http.StatusOK, server.get("/v1.0/address/erd1", "").Code)
+	}
+	assert.Equal(t, http.StatusTooManyRequests, server.get("/v1.0/address/erd1", "").Code)
+}
+
+func TestRateLimiter_ClientsShouldBeIdentifiedByApiKeyOrByIP(t *testing.T) {
+	t.Parallel()
+
+	server := createRateLimiterTestServer(t, createArgsRateLimiter())
+
+	assert.Equal(t, http.StatusOK, server.get("/transaction/send", "").Code)
+	assert.Equal(t, http.StatusTooManyRequests, server.get("/transaction/send", "").Code)
+
+	assert.Equal(t, http.StatusOK, server.get("/transaction/send", "key1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, server.get("/transaction/send", "key1").Code)
+
+	assert.Equal(t, http.StatusOK, server.get("/transaction/send", "key2").Code)
+}
+
+func TestRateLimiter_UnauthenticatedKeysShouldBeLimitedByIP(t *testing.T) {
+	t.Parallel()
+
+	server := createRateLimiterTestServer(t, createArgsRateLimiter())
+
+	assert.Equal(t, http.StatusOK, server.get("/transaction/send", "random-key-1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, server.get("/transaction/send", "random-key-2").Code)
+	assert.Equal(t, http.StatusTooManyRequests, server.get("/transaction/send", "random-key-3").Code)
+	assert.Equal(t, http.StatusTooManyRequests, server.get("/transaction/send", "").Code)
+
+	// an authenticated key has its own bucket
+	assert.Equal(t, http.StatusOK, server.get("/transaction/send", "key1").Code)
+}
+
+func TestRateLimiter_SpoofedForwardedForShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	server := createRateLimiterTestServer(t, createArgsRateLimiter())
+
+	for i, spoofedIP := range []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"} {
+		req, _ := http.NewRequest(http.MethodGet, "/transaction/send", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+		req.Header.Set("X-Forwarded-For", spoofedIP)
+		req.Header.Set("X-Real-Ip", spoofedIP)
+		resp := httptest.NewRecorder()
+		server.ws.ServeHTTP(resp, req)
+
+		expectedStatus := http.StatusTooManyRequests
+		if i == 0 {
+			expectedStatus = http.StatusOK
+		}
+		assert.Equal(t, expectedStatus, resp.Code)
+	}
+}
+
+func TestRateLimiter_FullBucketsShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	rl, _ := NewRateLimiter(createArgsRateLimiter())
+	now := time.Now()
+	rl.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	_, isAllowed := rl.takeToken(rl.getRule("/address/:address"), "ip:10.0.0.1")
+	assert.True(t, isAllowed)
+	_, isAllowed = rl.takeToken(rl.getRule("/transaction/send"), "ip:10.0.0.1")
+	assert.True(t, isAllowed)
+	assert.Equal(t, 2, rl.buckets.len())
+
+	// after the cleanup interval both buckets are full again, so they are removed, and only the bucket used by the
+	// new request is created again
+	now = now.Add(bucketsCleanupInterval)
+	_, isAllowed = rl.takeToken(rl.getRule("/transaction/send"), "ip:10.0.0.1")
+	assert.True(t, isAllowed)
+	assert.Equal(t, 1, rl.buckets.len())
+}
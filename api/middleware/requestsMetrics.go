@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestsMetrics returns a middleware which records the route, the method, the status code and the duration of each
+// request, including the ones rejected by the middlewares which follow it. The route is also added as endpoint name to
+// the request's context, so the requests sent to the nodes while serving it are labeled by it
+func RequestsMetrics(metricsHandler ApiMetricsHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		startTime := time.Now()
+		route := c.FullPath()
+		if len(route) > 0 {
+			c.Request = c.Request.WithContext(data.WithEndpointName(c.Request.Context(), route))
+		}
+
+		c.Next()
+
+		metricsHandler.RecordApiRequest(c.FullPath(), c.Request.Method, c.Writer.Status(), time.Since(startTime))
+	}
+}
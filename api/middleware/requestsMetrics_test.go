@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestsMetrics(t *testing.T) {
+	t.Parallel()
+
+	recordedRequests := make([]string, 0)
+	metricsHandler := &mock.ApiMetricsHandlerStub{
+		RecordApiRequestCalled: func(route string, method string, statusCode int, duration time.Duration) {
+			recordedRequests = append(recordedRequests, method+" "+route+" "+http.StatusText(statusCode))
+		},
+	}
+
+	ws := gin.New()
+	ws.Use(RequestsMetrics(metricsHandler))
+	endpointNames := make([]string, 0)
+	ws.GET("/v1.0/address/:address", func(c *gin.Context) {
+		endpointName, _ := data.GetEndpointName(c.Request.Context())
+		endpointNames = append(endpointNames, endpointName)
+		c.Status(http.StatusOK)
+	})
+
+	for _, path := range []string{"/v1.0/address/erd1", "/v1.0/address/erd2", "/v1.0/missing"} {
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		ws.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	expectedRequests := []string{
+		"GET /v1.0/address/:address OK",
+		"GET /v1.0/address/:address OK",
+		"GET  Not Found",
+	}
+	assert.Equal(t, expectedRequests, recordedRequests)
+	assert.Equal(t, []string{"/v1.0/address/:address", "/v1.0/address/:address"}, endpointNames)
+}
@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+)
+
+// RoutingKeyFromHeader returns a middleware which adds the value of the given header, if present, as routing key to
+// the request's context, so all the reads of the same session are sent to the same observers
+func RoutingKeyFromHeader(headerName string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		routingKey := c.GetHeader(headerName)
+		if len(routingKey) > 0 {
+			c.Request = c.Request.WithContext(data.WithRoutingKey(c.Request.Context(), routingKey))
+		}
+
+		c.Next()
+	}
+}
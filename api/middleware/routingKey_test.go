@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoutingKeyFromHeader(t *testing.T) {
+	t.Parallel()
+
+	routingKeys := make(chan string, 1)
+	ws := gin.New()
+	ws.Use(RoutingKeyFromHeader("X-Session-Id"))
+	ws.GET("/test", func(c *gin.Context) {
+		routingKey, _ := data.GetRoutingKey(c.Request.Context())
+		routingKeys <- routingKey
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set("X-Session-Id", "session")
+	ws.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "session", <-routingKeys)
+
+	req, _ = http.NewRequest(http.MethodGet, "/test", nil)
+	ws.ServeHTTP(httptest.NewRecorder(), req)
+	assert.Equal(t, "", <-routingKeys)
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const bucketsCleanupInterval = time.Minute
+
+type tokenBucket struct {
+	tokens            float64
+	lastRefill        time.Time
+	capacity          float64
+	requestsPerSecond float64
+}
+
+// refill adds the tokens accumulated since the last refill, up to the bucket's capacity
+func (tb *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(tb.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	tb.tokens = math.Min(tb.capacity, tb.tokens+elapsed*tb.requestsPerSecond)
+	tb.lastRefill = now
+}
+
+// tokenBuckets holds the token buckets of the clients, indexed by a key chosen by the caller
+type tokenBuckets struct {
+	mutBuckets  sync.Mutex
+	buckets     map[string]*tokenBucket
+	lastCleanup time.Time
+}
+
+func newTokenBuckets() *tokenBuckets {
+	return &tokenBuckets{
+		buckets:     make(map[string]*tokenBucket),
+		lastCleanup: time.Now(),
+	}
+}
+
+// takeToken takes a token from the bucket with the given key, creating it full if it does not exist. If the bucket is
+// empty, it returns the duration until a token will be available
+func (tbs *tokenBuckets) takeToken(bucketKey string, burst int, requestsPerSecond float64, now time.Time) (time.Duration, bool) {
+	tbs.mutBuckets.Lock()
+	defer tbs.mutBuckets.Unlock()
+
+	tbs.cleanupFullBuckets(now)
+
+	bucket, found := tbs.buckets[bucketKey]
+	if !found {
+		bucket = &tokenBucket{
+			tokens:            float64(burst),
+			lastRefill:        now,
+			capacity:          float64(burst),
+			requestsPerSecond: requestsPerSecond,
+		}
+		tbs.buckets[bucketKey] = bucket
+	}
+
+	bucket.refill(now)
+
+	if bucket.tokens < 1 {
+		missingTokens := 1 - bucket.tokens
+		return time.Duration(missingTokens / bucket.requestsPerSecond * float64(time.Second)), false
+	}
+
+	bucket.tokens--
+	return 0, true
+}
+
+// cleanupFullBuckets removes, from time to time, the buckets which have not been used for long enough to be full
+// again, as they are identical to new buckets
+func (tbs *tokenBuckets) cleanupFullBuckets(now time.Time) {
+	if now.Sub(tbs.lastCleanup) < bucketsCleanupInterval {
+		return
+	}
+	tbs.lastCleanup = now
+
+	for bucketKey, bucket := range tbs.buckets {
+		bucket.refill(now)
+		if bucket.tokens >= bucket.capacity {
+			delete(tbs.buckets, bucketKey)
+		}
+	}
+}
+
+func (tbs *tokenBuckets) len() int {
+	tbs.mutBuckets.Lock()
+	defer tbs.mutBuckets.Unlock()
+
+	return len(tbs.buckets)
+}
@@ -0,0 +1,42 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// ApiKeysRegistryStub -
+type ApiKeysRegistryStub struct {
+	GetApiKeyTierCalled   func(key string) (*data.ApiKeyTier, bool)
+	GetTierCalled         func(name string) (*data.ApiKeyTier, bool)
+	TryConsumeQuotaCalled func(key string) bool
+}
+
+// GetApiKeyTier -
+func (akrs *ApiKeysRegistryStub) GetApiKeyTier(key string) (*data.ApiKeyTier, bool) {
+	if akrs.GetApiKeyTierCalled != nil {
+		return akrs.GetApiKeyTierCalled(key)
+	}
+
+	return nil, false
+}
+
+// GetTier -
+func (akrs *ApiKeysRegistryStub) GetTier(name string) (*data.ApiKeyTier, bool) {
+	if akrs.GetTierCalled != nil {
+		return akrs.GetTierCalled(name)
+	}
+
+	return nil, false
+}
+
+// TryConsumeQuota -
+func (akrs *ApiKeysRegistryStub) TryConsumeQuota(key string) bool {
+	if akrs.TryConsumeQuotaCalled != nil {
+		return akrs.TryConsumeQuotaCalled(key)
+	}
+
+	return true
+}
+
+// IsInterfaceNil -
+func (akrs *ApiKeysRegistryStub) IsInterfaceNil() bool {
+	return akrs == nil
+}
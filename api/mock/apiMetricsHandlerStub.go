@@ -0,0 +1,20 @@
+package mock
+
+import "time"
+
+// ApiMetricsHandlerStub -
+type ApiMetricsHandlerStub struct {
+	RecordApiRequestCalled func(route string, method string, statusCode int, duration time.Duration)
+}
+
+// RecordApiRequest -
+func (amhs *ApiMetricsHandlerStub) RecordApiRequest(route string, method string, statusCode int, duration time.Duration) {
+	if amhs.RecordApiRequestCalled != nil {
+		amhs.RecordApiRequestCalled(route, method, statusCode, duration)
+	}
+}
+
+// IsInterfaceNil -
+func (amhs *ApiMetricsHandlerStub) IsInterfaceNil() bool {
+	return amhs == nil
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -24,7 +25,10 @@ type Facade struct {
 	SendUserFundsCalled                         func(receiver string, value *big.Int) error
 	ExecuteSCQueryHandler                       func(query *data.SCQuery) (*vm.VMOutputApi, error)
 	GetHeartbeatDataHandler                     func() (*data.HeartbeatResponse, error)
-	ValidatorStatisticsHandler                  func() (map[string]*data.ValidatorApiResponse, error)
+	GetNodesHealthHandler                       func() (*data.NodesHealthResponse, error)
+	GetApiKeysUsageHandler                      func() *data.ApiKeysUsageResponse
+	GetResponsesCacheStatsHandler               func() data.ResponsesCacheStats
+	ValidatorStatisticsHandler                  func() (*data.ValidatorStatisticsResponse, error)
 	TransactionCostRequestHandler               func(tx *data.Transaction) (string, error)
 	GetTransactionStatusHandler                 func(txHash string, sender string) (string, error)
 	GetConfigMetricsHandler                     func() (*data.GenericAPIResponse, error)
@@ -48,7 +52,7 @@ func (f *Facade) IsFaucetEnabled() bool {
 }
 
 // GetNetworkStatusMetrics -
-func (f *Facade) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetNetworkStatusMetrics(_ context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	if f.GetNetworkMetricsHandler != nil {
 		return f.GetNetworkMetricsHandler(shardID)
 	}
@@ -57,7 +61,7 @@ func (f *Facade) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIRespon
 }
 
 // GetNetworkConfigMetrics -
-func (f *Facade) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (f *Facade) GetNetworkConfigMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetConfigMetricsHandler != nil {
 		return f.GetConfigMetricsHandler()
 	}
@@ -66,7 +70,7 @@ func (f *Facade) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
 }
 
 // GetEconomicsDataMetrics -
-func (f *Facade) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
+func (f *Facade) GetEconomicsDataMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if f.GetEconomicsDataMetricsHandler != nil {
 		return f.GetEconomicsDataMetricsHandler()
 	}
@@ -75,17 +79,17 @@ func (f *Facade) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
 }
 
 // ValidatorStatistics -
-func (f *Facade) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
+func (f *Facade) ValidatorStatistics(_ context.Context) (*data.ValidatorStatisticsResponse, error) {
 	return f.ValidatorStatisticsHandler()
 }
 
 // GetAccount -
-func (f *Facade) GetAccount(address string) (*data.Account, error) {
+func (f *Facade) GetAccount(_ context.Context, address string) (*data.Account, error) {
 	return f.GetAccountHandler(address)
 }
 
 // GetValueForKey -
-func (f *Facade) GetValueForKey(address string, key string) (string, error) {
+func (f *Facade) GetValueForKey(_ context.Context, address string, key string) (string, error) {
 	return f.GetValueForKeyHandler(address, key)
 }
 
@@ -95,7 +99,7 @@ func (f *Facade) GetShardIDForAddress(address string) (uint32, error) {
 }
 
 // GetESDTTokenData -
-func (f *Facade) GetESDTTokenData(address string, key string) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetESDTTokenData(_ context.Context, address string, key string) (*data.GenericAPIResponse, error) {
 	if f.GetESDTTokenDataCalled != nil {
 		return f.GetESDTTokenDataCalled(address, key)
 	}
@@ -104,7 +108,7 @@ func (f *Facade) GetESDTTokenData(address string, key string) (*data.GenericAPIR
 }
 
 // GetAllESDTTokens -
-func (f *Facade) GetAllESDTTokens(address string) (*data.GenericAPIResponse, error) {
+func (f *Facade) GetAllESDTTokens(_ context.Context, address string) (*data.GenericAPIResponse, error) {
 	if f.GetAllESDTTokensCalled != nil {
 		return f.GetAllESDTTokensCalled(address)
 	}
@@ -118,22 +122,22 @@ func (f *Facade) GetTransactions(address string) ([]data.DatabaseTransaction, er
 }
 
 // GetTransactionByHashAndSenderAddress -
-func (f *Facade) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error) {
+func (f *Facade) GetTransactionByHashAndSenderAddress(_ context.Context, txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error) {
 	return f.GetTransactionByHashAndSenderAddressHandler(txHash, sndAddr, withEvents)
 }
 
 // GetTransaction -
-func (f *Facade) GetTransaction(txHash string, withResults bool) (*data.FullTransaction, error) {
+func (f *Facade) GetTransaction(_ context.Context, txHash string, withResults bool) (*data.FullTransaction, error) {
 	return f.GetTransactionHandler(txHash, withResults)
 }
 
 // SendTransaction -
-func (f *Facade) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (f *Facade) SendTransaction(_ context.Context, tx *data.Transaction) (int, string, error) {
 	return f.SendTransactionHandler(tx)
 }
 
 // SimulateTransaction -
-func (f *Facade) SimulateTransaction(tx *data.Transaction) (*data.GenericAPIResponse, error) {
+func (f *Facade) SimulateTransaction(_ context.Context, tx *data.Transaction) (*data.GenericAPIResponse, error) {
 	return f.SimulateTransactionHandler(tx)
 }
 
@@ -143,57 +147,72 @@ func (f *Facade) GetAddressConverter() (core.PubkeyConverter, error) {
 }
 
 // SendMultipleTransactions -
-func (f *Facade) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+func (f *Facade) SendMultipleTransactions(_ context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	return f.SendMultipleTransactionsHandler(txs)
 }
 
 // TransactionCostRequest -
-func (f *Facade) TransactionCostRequest(tx *data.Transaction) (string, error) {
+func (f *Facade) TransactionCostRequest(_ context.Context, tx *data.Transaction) (string, error) {
 	return f.TransactionCostRequestHandler(tx)
 }
 
 // GetTransactionStatus -
-func (f *Facade) GetTransactionStatus(txHash string, sender string) (string, error) {
+func (f *Facade) GetTransactionStatus(_ context.Context, txHash string, sender string) (string, error) {
 	return f.GetTransactionStatusHandler(txHash, sender)
 }
 
 // SendUserFunds -
-func (f *Facade) SendUserFunds(receiver string, value *big.Int) error {
+func (f *Facade) SendUserFunds(_ context.Context, receiver string, value *big.Int) error {
 	return f.SendUserFundsCalled(receiver, value)
 }
 
 // ExecuteSCQuery -
-func (f *Facade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
+func (f *Facade) ExecuteSCQuery(_ context.Context, query *data.SCQuery) (*vm.VMOutputApi, error) {
 	return f.ExecuteSCQueryHandler(query)
 }
 
 // GetHeartbeatData -
-func (f *Facade) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+func (f *Facade) GetHeartbeatData(_ context.Context) (*data.HeartbeatResponse, error) {
 	return f.GetHeartbeatDataHandler()
 }
 
+// GetNodesHealth -
+func (f *Facade) GetNodesHealth() (*data.NodesHealthResponse, error) {
+	return f.GetNodesHealthHandler()
+}
+
+// GetApiKeysUsage -
+func (f *Facade) GetApiKeysUsage() *data.ApiKeysUsageResponse {
+	return f.GetApiKeysUsageHandler()
+}
+
+// GetResponsesCacheStats -
+func (f *Facade) GetResponsesCacheStats() data.ResponsesCacheStats {
+	return f.GetResponsesCacheStatsHandler()
+}
+
 // GetAtlasBlockByShardIDAndNonce -
 func (f *Facade) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
 	return f.GetBlockByShardIDAndNonceHandler(shardID, nonce)
 }
 
 // GetBlockByHash -
-func (f *Facade) GetBlockByHash(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error) {
+func (f *Facade) GetBlockByHash(_ context.Context, shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error) {
 	return f.GetBlockByHashCalled(shardID, hash, withTxs)
 }
 
 // GetBlockByNonce -
-func (f *Facade) GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
+func (f *Facade) GetBlockByNonce(_ context.Context, shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
 	return f.GetBlockByNonceCalled(shardID, nonce, withTxs)
 }
 
 // GetHyperBlockByHash -
-func (f *Facade) GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error) {
+func (f *Facade) GetHyperBlockByHash(_ context.Context, hash string) (*data.HyperblockApiResponse, error) {
 	return f.GetHyperBlockByHashCalled(hash)
 }
 
 // GetHyperBlockByNonce -
-func (f *Facade) GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error) {
+func (f *Facade) GetHyperBlockByNonce(_ context.Context, nonce uint64) (*data.HyperblockApiResponse, error) {
 	return f.GetHyperBlockByNonceCalled(nonce)
 }
 
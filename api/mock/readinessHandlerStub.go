@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// ReadinessHandlerStub -
+type ReadinessHandlerStub struct {
+	GetReadinessCalled func() *data.ReadinessResponse
+}
+
+// GetReadiness -
+func (rhs *ReadinessHandlerStub) GetReadiness() *data.ReadinessResponse {
+	if rhs.GetReadinessCalled != nil {
+		return rhs.GetReadinessCalled()
+	}
+
+	return &data.ReadinessResponse{IsReady: true}
+}
+
+// IsInterfaceNil -
+func (rhs *ReadinessHandlerStub) IsInterfaceNil() bool {
+	return rhs == nil
+}
@@ -0,0 +1,261 @@
+package openapi
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+const (
+	openApiVersion  = "3.0.3"
+	documentTitle   = "Elrond Proxy REST API"
+	jsonContentType = "application/json"
+)
+
+// integerPathParams holds the path parameters which are parsed as unsigned integers by the handlers
+var integerPathParams = map[string]struct{}{
+	"shard": {},
+	"nonce": {},
+}
+
+// Document is an OpenAPI 3 document
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info holds the title and the version of the API
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem holds the operations of a path, by lowercase HTTP method
+type PathItem map[string]*Operation
+
+// Operation describes an endpoint
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter describes a path or a query parameter
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody describes the body of a request
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response describes a response
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a body
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the schemas referenced in the document
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// NewDocument creates the OpenAPI document describing all the endpoints the groups of the given API handler hold. The
+// request and response schemas are derived from the instances set in the endpoints' handler data. The endpoints
+// without a response instance are described as returning the generic API response
+func NewDocument(version string, apiHandler data.ApiHandler) *Document {
+	sg := newSchemaGenerator()
+	errorSchema := sg.schemaOfType(reflect.TypeOf(data.GenericAPIResponse{}))
+	versionPrefix := "/" + strings.Trim(version, "/")
+
+	doc := &Document{
+		OpenAPI: openApiVersion,
+		Info: Info{
+			Title:   documentTitle,
+			Version: strings.Trim(version, "/"),
+		},
+		Paths: make(map[string]PathItem),
+	}
+
+	groups := apiHandler.GetAllGroups()
+	for _, groupPath := range sortedKeys(groups) {
+		tag := strings.Trim(groupPath, "/")
+		endpoints := groups[groupPath].GetAllEndpoints()
+		endpointPaths := make([]string, 0, len(endpoints))
+		for endpointPath := range endpoints {
+			endpointPaths = append(endpointPaths, endpointPath)
+		}
+		// the paths are sorted, so the names given to the components are the same on each run
+		sort.Strings(endpointPaths)
+
+		for _, endpointPath := range endpointPaths {
+			handlerData := endpoints[endpointPath]
+			ginPath := versionPrefix + groupPath + endpointPath
+			openApiPath, pathParams := convertPath(ginPath)
+
+			pathItem, found := doc.Paths[openApiPath]
+			if !found {
+				pathItem = make(PathItem)
+				doc.Paths[openApiPath] = pathItem
+			}
+
+			method := strings.ToLower(handlerData.Method)
+			pathItem[method] = createOperation(sg, method, tag, ginPath, pathParams, handlerData, errorSchema)
+		}
+	}
+
+	doc.Components = Components{Schemas: sg.schemas}
+
+	return doc
+}
+
+func createOperation(
+	sg *schemaGenerator,
+	method string,
+	tag string,
+	ginPath string,
+	pathParams []string,
+	handlerData data.EndpointHandlerData,
+	errorSchema *Schema,
+) *Operation {
+	operation := &Operation{
+		OperationID: createOperationID(method, ginPath),
+		Summary:     handlerData.Summary,
+		Tags:        []string{tag},
+		Parameters:  make([]*Parameter, 0, len(pathParams)+len(handlerData.QueryParams)),
+		Responses: map[string]*Response{
+			"default": {
+				Description: "error",
+				Content:     jsonContent(errorSchema),
+			},
+		},
+	}
+
+	for _, pathParam := range pathParams {
+		operation.Parameters = append(operation.Parameters, &Parameter{
+			Name:     pathParam,
+			In:       "path",
+			Required: true,
+			Schema:   pathParamSchema(pathParam),
+		})
+	}
+	for _, queryParam := range handlerData.QueryParams {
+		operation.Parameters = append(operation.Parameters, &Parameter{
+			Name:   queryParam,
+			In:     "query",
+			Schema: queryParamSchema(queryParam),
+		})
+	}
+
+	if handlerData.Request != nil {
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content:  jsonContent(sg.schemaOfValue(reflect.ValueOf(handlerData.Request))),
+		}
+	}
+
+	responseSchema := errorSchema
+	if handlerData.Response != nil {
+		responseSchema = sg.schemaOfValue(reflect.ValueOf(handlerData.Response))
+	}
+	operation.Responses["200"] = &Response{
+		Description: "successful operation",
+		Content:     jsonContent(responseSchema),
+	}
+
+	return operation
+}
+
+// convertPath converts the gin path parameters (:name) to the OpenAPI ones ({name}) and returns their names
+func convertPath(ginPath string) (string, []string) {
+	segments := strings.Split(ginPath, "/")
+	pathParams := make([]string, 0)
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, ":") {
+			continue
+		}
+
+		name := segment[1:]
+		pathParams = append(pathParams, name)
+		segments[i] = "{" + name + "}"
+	}
+
+	return strings.Join(segments, "/"), pathParams
+}
+
+// createOperationID creates an identifier such as getV10AddressByAddressBalance out of the method and the gin path
+func createOperationID(method string, ginPath string) string {
+	builder := strings.Builder{}
+	builder.WriteString(method)
+	for _, segment := range strings.Split(ginPath, "/") {
+		if strings.HasPrefix(segment, ":") {
+			builder.WriteString("By")
+		}
+		capitalizeNext := true
+		for _, r := range segment {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				capitalizeNext = true
+				continue
+			}
+			if capitalizeNext {
+				r = unicode.ToUpper(r)
+				capitalizeNext = false
+			}
+			builder.WriteRune(r)
+		}
+	}
+
+	return builder.String()
+}
+
+func pathParamSchema(name string) *Schema {
+	_, isInteger := integerPathParams[name]
+	if isInteger {
+		return &Schema{Type: "integer", Format: "int64"}
+	}
+
+	return &Schema{Type: "string"}
+}
+
+// queryParamSchema returns the schema of a query parameter. The flags, such as withTxs, are booleans
+func queryParamSchema(name string) *Schema {
+	if strings.HasPrefix(name, "with") {
+		return &Schema{Type: "boolean"}
+	}
+
+	return &Schema{Type: "string"}
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{
+		jsonContentType: {Schema: schema},
+	}
+}
+
+func sortedKeys(groups map[string]data.GroupHandler) []string {
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
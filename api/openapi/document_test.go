@@ -0,0 +1,111 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/openapi"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createApiHandler(t *testing.T) data.ApiHandler {
+	apiHandler, err := api.NewApiHandler(&mock.Facade{})
+	require.Nil(t, err)
+
+	return apiHandler
+}
+
+func TestNewDocument_ShouldDescribeAllTheEndpoints(t *testing.T) {
+	t.Parallel()
+
+	apiHandler := createApiHandler(t)
+	doc := openapi.NewDocument("v1.0", apiHandler)
+
+	assert.Equal(t, "3.0.3", doc.OpenAPI)
+	assert.Equal(t, "v1.0", doc.Info.Version)
+
+	numEndpoints := 0
+	for _, group := range apiHandler.GetAllGroups() {
+		numEndpoints += len(group.GetAllEndpoints())
+	}
+	numOperations := 0
+	for _, pathItem := range doc.Paths {
+		numOperations += len(pathItem)
+	}
+	assert.Equal(t, numEndpoints, numOperations)
+
+	operation := doc.Paths["/v1.0/block/{shard}/by-nonce/{nonce}"]["get"]
+	require.NotNil(t, operation)
+	assert.Equal(t, "getV10BlockByShardByNonceByNonce", operation.OperationID)
+	assert.Equal(t, []string{"block"}, operation.Tags)
+	assert.Equal(t, []*openapi.Parameter{
+		{Name: "shard", In: "path", Required: true, Schema: &openapi.Schema{Type: "integer", Format: "int64"}},
+		{Name: "nonce", In: "path", Required: true, Schema: &openapi.Schema{Type: "integer", Format: "int64"}},
+		{Name: "withTxs", In: "query", Schema: &openapi.Schema{Type: "boolean"}},
+	}, operation.Parameters)
+	assert.Equal(t, "#/components/schemas/BlockApiResponse", operation.Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/GenericAPIResponse", operation.Responses["default"].Content["application/json"].Schema.Ref)
+}
+
+func TestNewDocument_ShouldDeriveTheSchemasFromTheDataTypes(t *testing.T) {
+	t.Parallel()
+
+	doc := openapi.NewDocument("v1.0", createApiHandler(t))
+
+	operation := doc.Paths["/v1.0/transaction/send"]["post"]
+	require.NotNil(t, operation)
+	require.NotNil(t, operation.RequestBody)
+	assert.Equal(t, "#/components/schemas/Transaction", operation.RequestBody.Content["application/json"].Schema.Ref)
+
+	transactionSchema := doc.Components.Schemas["Transaction"]
+	require.NotNil(t, transactionSchema)
+	assert.Equal(t, &openapi.Schema{Type: "integer", Format: "int64"}, transactionSchema.Properties["nonce"])
+	assert.Equal(t, &openapi.Schema{Type: "string", Format: "byte"}, transactionSchema.Properties["data"])
+
+	// the data field of the generic response is described by the instance set in the endpoint's handler data
+	responseSchema := operation.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, &openapi.Schema{Type: "string"}, responseSchema.Properties["error"])
+	assert.Equal(t, &openapi.Schema{Type: "string"}, responseSchema.Properties["data"].Properties["txHash"])
+
+	operation = doc.Paths["/v1.0/transaction/send-multiple"]["post"]
+	require.NotNil(t, operation)
+	requestSchema := operation.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, "array", requestSchema.Type)
+	assert.Equal(t, "#/components/schemas/Transaction", requestSchema.Items.Ref)
+}
+
+func TestNewDocument_ShouldReflectTheEndpointsChangedByAVersion(t *testing.T) {
+	t.Parallel()
+
+	apiHandler := createApiHandler(t)
+	accountsGroup, err := apiHandler.GetGroup("/address")
+	require.Nil(t, err)
+
+	err = accountsGroup.RemoveEndpoint("/:address/nonce")
+	require.Nil(t, err)
+	err = accountsGroup.AddEndpoint("/:address/new-endpoint", data.EndpointHandlerData{
+		Handler: func(_ *gin.Context) {},
+		Method:  http.MethodGet,
+		Summary: "new endpoint",
+	})
+	require.Nil(t, err)
+
+	doc := openapi.NewDocument("/v_next", apiHandler)
+
+	_, found := doc.Paths["/v_next/address/{address}/nonce"]
+	assert.False(t, found)
+	operation := doc.Paths["/v_next/address/{address}/new-endpoint"]["get"]
+	require.NotNil(t, operation)
+	assert.Equal(t, "new endpoint", operation.Summary)
+	// without a response instance, the endpoint is described as returning the generic API response
+	assert.Equal(t, "#/components/schemas/GenericAPIResponse", operation.Responses["200"].Content["application/json"].Schema.Ref)
+
+	_, err = json.Marshal(doc)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,227 @@
+package openapi
+
+import (
+	"encoding/json"
+	"math/big"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const componentsSchemasPrefix = "#/components/schemas/"
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	bigIntType        = reflect.TypeOf(big.Int{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// Schema is an OpenAPI schema object, describing a JSON value
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// schemaGenerator derives the schemas from the Go types, following their JSON tags. The named struct types are added
+// to the components and referenced, so the recursive and the shared types are described once
+type schemaGenerator struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+// schemaOfValue returns the schema of the given instance. Unlike the types, the instances also describe the values held
+// by the interface fields (such as the data field of the generic API response) and the keys of the maps
+func (sg *schemaGenerator) schemaOfValue(value reflect.Value) *Schema {
+	if !value.IsValid() {
+		return &Schema{}
+	}
+
+	switch value.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if value.IsNil() {
+			return sg.schemaOfType(value.Type())
+		}
+		return sg.schemaOfValue(value.Elem())
+	case reflect.Map:
+		if value.Len() == 0 || value.Type().Key().Kind() != reflect.String {
+			return sg.schemaOfType(value.Type())
+		}
+
+		schema := &Schema{
+			Type:       "object",
+			Properties: make(map[string]*Schema),
+		}
+		iter := value.MapRange()
+		for iter.Next() {
+			schema.Properties[iter.Key().String()] = sg.schemaOfValue(iter.Value())
+		}
+		return schema
+	case reflect.Struct:
+		if holdsInterfaceValues(value) {
+			return sg.structSchema(value.Type(), value)
+		}
+		return sg.schemaOfType(value.Type())
+	default:
+		return sg.schemaOfType(value.Type())
+	}
+}
+
+func (sg *schemaGenerator) schemaOfType(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case bigIntType:
+		return &Schema{Type: "integer"}
+	}
+	// the types with a custom marshaller can have any format
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return sg.schemaOfType(t.Elem())
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: sg.schemaOfType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: sg.schemaOfType(t.Elem())}
+	case reflect.Struct:
+		if len(t.Name()) == 0 {
+			return sg.structSchema(t, reflect.Value{})
+		}
+		return &Schema{Ref: componentsSchemasPrefix + sg.componentName(t)}
+	default:
+		return &Schema{}
+	}
+}
+
+// componentName returns the name of the component describing the given struct type, adding the component if needed
+func (sg *schemaGenerator) componentName(t reflect.Type) string {
+	name, found := sg.names[t]
+	if found {
+		return name
+	}
+
+	name = t.Name()
+	_, isNameTaken := sg.schemas[name]
+	if isNameTaken {
+		name = path.Base(t.PkgPath()) + "." + t.Name()
+	}
+
+	// the name is reserved before describing the fields, so the recursive types reference themselves
+	sg.names[t] = name
+	sg.schemas[name] = &Schema{}
+	*sg.schemas[name] = *sg.structSchema(t, reflect.Value{})
+
+	return name
+}
+
+// structSchema describes the fields of the given struct type. If an instance is provided, the values of its interface
+// fields are described as well
+func (sg *schemaGenerator) structSchema(t reflect.Type, value reflect.Value) *Schema {
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		isExported := len(field.PkgPath) == 0
+		if !isExported && !field.Anonymous {
+			continue
+		}
+
+		name, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		var fieldValue reflect.Value
+		if value.IsValid() {
+			fieldValue = value.Field(i)
+		}
+
+		if field.Anonymous && len(name) == 0 && isStructOrStructPtr(field.Type) {
+			embeddedType := field.Type
+			if embeddedType.Kind() == reflect.Ptr {
+				embeddedType = embeddedType.Elem()
+				fieldValue = reflect.Value{}
+			}
+			for embeddedName, embeddedSchema := range sg.structSchema(embeddedType, fieldValue).Properties {
+				schema.Properties[embeddedName] = embeddedSchema
+			}
+			continue
+		}
+		if !isExported {
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		if fieldValue.IsValid() && fieldValue.Kind() == reflect.Interface {
+			schema.Properties[name] = sg.schemaOfValue(fieldValue)
+			continue
+		}
+		schema.Properties[name] = sg.schemaOfType(field.Type)
+	}
+
+	return schema
+}
+
+// jsonFieldName returns the name given by the JSON tag of the field, which is empty if not set, and whether the
+// field is skipped when marshaled
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+
+	return strings.Split(tag, ",")[0], false
+}
+
+func isStructOrStructPtr(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct
+}
+
+func holdsInterfaceValues(value reflect.Value) bool {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if field.Kind() == reflect.Interface && !field.IsNil() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+package openapi
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type embeddedFields struct {
+	Embedded string `json:"embedded"`
+}
+
+type treeNode struct {
+	embeddedFields
+	Value      uint32      `json:"value"`
+	Children   []*treeNode `json:"children,omitempty"`
+	Timestamp  time.Time   `json:"timestamp"`
+	Ignored    string      `json:"-"`
+	Untagged   bool
+	unexported string
+}
+
+func TestSchemaGenerator_RecursiveTypeShouldReferenceItself(t *testing.T) {
+	t.Parallel()
+
+	sg := newSchemaGenerator()
+	schema := sg.schemaOfType(reflect.TypeOf(treeNode{}))
+	assert.Equal(t, &Schema{Ref: "#/components/schemas/treeNode"}, schema)
+
+	component := sg.schemas["treeNode"]
+	require.NotNil(t, component)
+	assert.Equal(t, &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"embedded":  {Type: "string"},
+			"value":     {Type: "integer", Format: "int64"},
+			"children":  {Type: "array", Items: &Schema{Ref: "#/components/schemas/treeNode"}},
+			"timestamp": {Type: "string", Format: "date-time"},
+			"Untagged":  {Type: "boolean"},
+		},
+	}, component)
+}
+
+func TestSchemaGenerator_SchemaOfValueShouldDescribeTheInterfaceValues(t *testing.T) {
+	t.Parallel()
+
+	type response struct {
+		Data interface{} `json:"data"`
+	}
+
+	sg := newSchemaGenerator()
+	schema := sg.schemaOfValue(reflect.ValueOf(response{Data: map[string]interface{}{"count": 0}}))
+	assert.Equal(t, &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"data": {
+				Type:       "object",
+				Properties: map[string]*Schema{"count": {Type: "integer", Format: "int64"}},
+			},
+		},
+	}, schema)
+
+	schema = sg.schemaOfValue(reflect.ValueOf(response{}))
+	assert.Equal(t, &Schema{Ref: "#/components/schemas/response"}, schema)
+	assert.Equal(t, &Schema{}, sg.schemas["response"].Properties["data"])
+}
+
+func TestSchemaGenerator_SameNameInAnotherPackageShouldBeQualified(t *testing.T) {
+	t.Parallel()
+
+	type Time struct{}
+
+	sg := newSchemaGenerator()
+	sg.schemas["Time"] = &Schema{}
+	schema := sg.schemaOfType(reflect.TypeOf(Time{}))
+	assert.Equal(t, &Schema{Ref: "#/components/schemas/openapi.Time"}, schema)
+}
@@ -1,12 +1,14 @@
 package shared
 
 import (
+	stdErrors "errors"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/api/errors"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
 	"github.com/gin-gonic/gin"
 )
 
@@ -27,6 +29,22 @@ func RespondWithInvalidAppContext(c *gin.Context) {
 	RespondWith(c, http.StatusInternalServerError, nil, errors.ErrInvalidAppContext.Error(), data.ReturnCodeInternalError)
 }
 
+// RespondWithInternalError will respond with http.StatusServiceUnavailable if the request was rejected because the
+// observers were overloaded, or with http.StatusInternalServerError otherwise
+func RespondWithInternalError(c *gin.Context, err error) {
+	RespondWith(c, GetInternalErrorStatusCode(err), nil, err.Error(), data.ReturnCodeInternalError)
+}
+
+// GetInternalErrorStatusCode returns http.StatusServiceUnavailable if the request was rejected because the observers
+// were overloaded, or http.StatusInternalServerError otherwise
+func GetInternalErrorStatusCode(err error) int {
+	if stdErrors.Is(err, process.ErrObserversOverloaded) {
+		return http.StatusServiceUnavailable
+	}
+
+	return http.StatusInternalServerError
+}
+
 // FetchNonceFromRequest will try to fetch the nonce from the request
 func FetchNonceFromRequest(c *gin.Context) (uint64, error) {
 	nonceStr := c.Param("nonce")
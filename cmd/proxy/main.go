@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"syscall"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
@@ -20,25 +22,31 @@ import (
 	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-proxy-go/api"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/faucet"
+	"github.com/ElrondNetwork/elrond-proxy-go/metrics"
 	"github.com/ElrondNetwork/elrond-proxy-go/observer"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/database"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/disabled"
 	processFactory "github.com/ElrondNetwork/elrond-proxy-go/process/factory"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta"
 	"github.com/ElrondNetwork/elrond-proxy-go/testing"
 	versionsFactory "github.com/ElrondNetwork/elrond-proxy-go/versions/factory"
+	"github.com/gin-gonic/gin"
 	"github.com/pkg/profile"
 	"github.com/urfave/cli"
 )
 
 const (
-	defaultLogsPath      = "logs"
-	logFilePrefix        = "elrond-proxy"
-	logFileLifeSpanInSec = 86400
+	defaultLogsPath                 = "logs"
+	logFilePrefix                   = "elrond-proxy"
+	logFileLifeSpanInSec            = 86400
+	heartbeatsSnapshotFile          = "heartbeats.json"
+	validatorStatisticsSnapshotFile = "validatorStatistics.json"
 )
 
 var (
@@ -240,18 +248,40 @@ func startProxy(ctx *cli.Context) error {
 		return err
 	}
 
-	versionsRegistry, err := createVersionsRegistryTestOrProduction(ctx, generalConfig, economicsConfig, externalConfig)
+	apiKeysRegistry, err := createApiKeysRegistry(generalConfig)
 	if err != nil {
 		return err
 	}
 
-	httpServer, err := startWebServer(versionsRegistry, ctx, generalConfig)
+	// the proxy metrics are nil if disabled, in which case the processing components get a disabled metrics handler
+	var proxyMetrics *metrics.ProxyMetrics
+	metricsHandler := process.MetricsHandler(&disabled.Metrics{})
+	if generalConfig.Metrics.Enabled {
+		proxyMetrics = metrics.NewProxyMetrics()
+		metricsHandler = proxyMetrics
+	}
+
+	versionsRegistry, readinessChecker, err := createVersionsRegistryTestOrProduction(
+		ctx,
+		generalConfig,
+		economicsConfig,
+		externalConfig,
+		apiKeysRegistry,
+		metricsHandler,
+	)
+	if err != nil {
+		return err
+	}
+
+	httpServer, err := startWebServer(versionsRegistry, ctx, generalConfig, apiKeysRegistry, readinessChecker, proxyMetrics)
 	if err != nil {
 		return err
 	}
 
 	waitForServerShutdown(httpServer)
 
+	log.LogIfError(apiKeysRegistry.SaveUsage())
+
 	log.Debug("closing proxy")
 	if !check.IfNil(fileLogging) {
 		err = fileLogging.Close()
@@ -262,12 +292,7 @@ func startProxy(ctx *cli.Context) error {
 }
 
 func loadMainConfig(filepath string) (*config.Config, error) {
-	cfg := &config.Config{}
-	err := core.LoadTomlFile(cfg, filepath)
-	if err != nil {
-		return nil, err
-	}
-	return cfg, nil
+	return config.LoadConfig(filepath)
 }
 
 func loadEconomicsConfig(filepath string) (*erdConfig.EconomicsConfig, error) {
@@ -294,7 +319,9 @@ func createVersionsRegistryTestOrProduction(
 	cfg *config.Config,
 	ecCfg *erdConfig.EconomicsConfig,
 	exCfg *erdConfig.ExternalConfig,
-) (data.VersionsRegistryHandler, error) {
+	apiKeysRegistry process.ApiKeysRegistryHandler,
+	metricsHandler process.MetricsHandler,
+) (data.VersionsRegistryHandler, *process.ReadinessChecker, error) {
 
 	var testHTTPServerEnabled bool
 	if ctx.IsSet(testHttpServerEn.Name) {
@@ -341,16 +368,26 @@ func createVersionsRegistryTestOrProduction(
 					Address: testServer.URL(),
 				},
 			},
+			Readiness:              cfg.Readiness,
 			AddressPubkeyConverter: cfg.AddressPubkeyConverter,
 			Marshalizer:            erdConfig.TypeConfig{Type: "json"},
 			Hasher:                 erdConfig.TypeConfig{Type: "sha256"},
 		}
 
-		return createVersionsRegistry(testCfg, ecCfg, exCfg, ctx.GlobalString(walletKeyPemFile.Name), false)
+		return createVersionsRegistry(testCfg, ecCfg, exCfg, ctx.GlobalString(walletKeyPemFile.Name), "", false, apiKeysRegistry, metricsHandler)
 	}
 
 	isRosettaModeEnabled := ctx.GlobalBool(startAsRosetta.Name)
-	return createVersionsRegistry(cfg, ecCfg, exCfg, ctx.GlobalString(walletKeyPemFile.Name), isRosettaModeEnabled)
+	return createVersionsRegistry(
+		cfg,
+		ecCfg,
+		exCfg,
+		ctx.GlobalString(walletKeyPemFile.Name),
+		ctx.GlobalString(configurationFile.Name),
+		isRosettaModeEnabled,
+		apiKeysRegistry,
+		metricsHandler,
+	)
 }
 
 func createVersionsRegistry(
@@ -358,117 +395,226 @@ func createVersionsRegistry(
 	ecConf *erdConfig.EconomicsConfig,
 	exCfg *erdConfig.ExternalConfig,
 	pemFileLocation string,
+	configFilePath string,
 	isRosettaModeEnabled bool,
-) (data.VersionsRegistryHandler, error) {
+	apiKeysRegistry process.ApiKeysRegistryHandler,
+	metricsHandler process.MetricsHandler,
+) (data.VersionsRegistryHandler, *process.ReadinessChecker, error) {
 	pubKeyConverter, err := factory.NewPubkeyConverter(cfg.AddressPubkeyConverter)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	marshalizer, err := marshalFactory.NewMarshalizer(cfg.Marshalizer.Type)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	hasher, err := hasherFactory.NewHasher(cfg.Hasher.Type)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	shardCoord, err := getShardCoordinator(cfg)
+	httpClient, err := createHttpClient(cfg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	nodesProviderFactory, err := observer.NewNodesProviderFactory(*cfg)
+	nodes := make([]*data.NodeData, 0, len(cfg.Observers)+len(cfg.FullHistoryNodes))
+	nodes = append(nodes, cfg.Observers...)
+	nodes = append(nodes, cfg.FullHistoryNodes...)
+	nodesHttpClients, err := process.NewNodesHttpClients(httpClient, nodes)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	shardDiscoverer, err := createShardDiscoverer(cfg, nodesHttpClients)
+	if err != nil {
+		return nil, nil, err
+	}
+	discoveredNumShards := discoverShards(cfg, shardDiscoverer)
+
+	shardCoord, err := getShardCoordinator(cfg, discoveredNumShards)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodesStatistics := observer.NewNodesStatistics()
+	nodesProviderFactory, err := observer.NewNodesProviderFactory(*cfg, nodesStatistics)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	observersProvider, err := nodesProviderFactory.CreateObservers()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	fullHistoryNodesProvider, err := nodesProviderFactory.CreateFullHistoryNodes()
 	if err != nil {
 		if err != observer.ErrEmptyObserversList {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	bp, err := process.NewBaseProcessor(
-		cfg.GeneralSettings.RequestTimeoutSec,
-		shardCoord,
-		observersProvider,
-		fullHistoryNodesProvider,
-		pubKeyConverter,
-	)
+	err = startNodesReloader(cfg, configFilePath, observersProvider, fullHistoryNodesProvider, nodesHttpClients, shardDiscoverer)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	circuitBreaker, err := createCircuitBreaker(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	concurrencyLimiter, err := createConcurrencyLimiter(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	retryPolicy, err := createRetryPolicy(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hedgingPolicy, err := createHedgingPolicy(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quorumPolicy, err := createQuorumPolicy(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	routingTable, err := createRoutingTable(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	requestsCoalescer := createRequestsCoalescer(cfg)
+
+	argsBaseProcessor := process.ArgsBaseProcessor{
+		HttpClients:              nodesHttpClients,
+		ShardCoordinator:         shardCoord,
+		ObserversProvider:        observersProvider,
+		FullHistoryNodesProvider: fullHistoryNodesProvider,
+		NodesStatistics:          nodesStatistics,
+		CircuitBreaker:           circuitBreaker,
+		ConcurrencyLimiter:       concurrencyLimiter,
+		RetryPolicy:              retryPolicy,
+		HedgingPolicy:            hedgingPolicy,
+		QuorumPolicy:             quorumPolicy,
+		RoutingTable:             routingTable,
+		RequestsCoalescer:        requestsCoalescer,
+		Metrics:                  metricsHandler,
+		PubKeyConverter:          pubKeyConverter,
+	}
+	bp, err := process.NewBaseProcessor(argsBaseProcessor)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	connector, err := createElasticSearchConnector(exCfg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	accntProc, err := process.NewAccountProcessor(bp, pubKeyConverter, connector)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	privKeysLoader, err := faucet.NewPrivateKeysLoader(shardCoord, pemFileLocation, pubKeyConverter)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	faucetValue := big.NewInt(0)
 	faucetValue.SetString(cfg.GeneralSettings.FaucetValue, 10)
 	faucetProc, err := processFactory.CreateFaucetProcessor(ecConf, bp, privKeysLoader, faucetValue, pubKeyConverter)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	txProc, err := process.NewTransactionProcessor(bp, pubKeyConverter, hasher, marshalizer)
+	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter)
+	sharedStore, err := createSharedStore(cfg)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	leaderElection, err := createLeaderElection(cfg, sharedStore, isRosettaModeEnabled)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	htbCacher := cache.NewHeartbeatMemoryCacher()
+	htbCacher, err := createHeartbeatCacher(cfg, sharedStore)
+	if err != nil {
+		return nil, nil, err
+	}
+	htbCacher, err = metrics.NewMeteredHeartbeatCacher(htbCacher, metricsHandler)
+	if err != nil {
+		return nil, nil, err
+	}
 	cacheValidity := time.Duration(cfg.GeneralSettings.HeartbeatCacheValidityDurationSec) * time.Second
 
-	htbProc, err := process.NewHeartbeatProcessor(bp, htbCacher, cacheValidity)
+	htbProc, err := process.NewHeartbeatProcessor(bp, htbCacher, leaderElection, cacheValidity)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if !isRosettaModeEnabled {
 		htbProc.StartCacheUpdate()
 	}
 
-	valStatsCacher := cache.NewValidatorsStatsMemoryCacher()
+	valStatsCacher, err := createValidatorStatisticsCacher(cfg, sharedStore)
+	if err != nil {
+		return nil, nil, err
+	}
+	valStatsCacher, err = metrics.NewMeteredValidatorStatsCacher(valStatsCacher, metricsHandler)
+	if err != nil {
+		return nil, nil, err
+	}
 	cacheValidity = time.Duration(cfg.GeneralSettings.ValStatsCacheValidityDurationSec) * time.Second
 
-	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, valStatsCacher, cacheValidity)
+	valStatsProc, err := process.NewValidatorStatisticsProcessor(bp, valStatsCacher, leaderElection, cacheValidity)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if !isRosettaModeEnabled {
 		valStatsProc.StartCacheUpdate()
 	}
 
-	nodeStatusProc, err := process.NewNodeStatusProcessor(bp)
+	nodesHealthChecker, err := createNodesHealthChecker(cfg, bp, nodesHttpClients, circuitBreaker, concurrencyLimiter, metricsHandler)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	blockProc, err := process.NewBlockProcessor(connector, bp)
+	readinessChecker, err := createReadinessChecker(cfg, exCfg, bp, connector)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	nodeStatusProc, err := process.NewNodeStatusProcessor(bp, nodesHealthChecker, apiKeysRegistry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responsesCache, err := createResponsesCache(cfg, sharedStore)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockProc, err := process.NewBlockProcessor(connector, bp, responsesCache, nodesHealthChecker)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txProc, err := process.NewTransactionProcessor(bp, pubKeyConverter, hasher, marshalizer, responsesCache, nodesHealthChecker)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	facadeArgs := versionsFactory.FacadeArgs{
@@ -483,7 +629,12 @@ func createVersionsRegistry(
 		PubKeyConverter:              pubKeyConverter,
 	}
 
-	return versionsFactory.CreateVersionsRegistry(facadeArgs)
+	versionsRegistry, err := versionsFactory.CreateVersionsRegistry(facadeArgs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return versionsRegistry, readinessChecker, nil
 }
 
 func createElasticSearchConnector(exCfg *erdConfig.ExternalConfig) (process.ExternalStorageConnector, error) {
@@ -498,7 +649,371 @@ func createElasticSearchConnector(exCfg *erdConfig.ExternalConfig) (process.Exte
 	)
 }
 
-func getShardCoordinator(cfg *config.Config) (sharding.Coordinator, error) {
+func createReadinessChecker(
+	cfg *config.Config,
+	exCfg *erdConfig.ExternalConfig,
+	proc process.Processor,
+	connector process.ExternalStorageConnector,
+) (*process.ReadinessChecker, error) {
+	argsReadinessChecker := process.ArgsReadinessChecker{
+		Processor:            proc,
+		ExternalStorage:      connector,
+		CheckExternalStorage: exCfg.ElasticSearchConnector.Enabled,
+		ProbeTimeout:         time.Duration(cfg.Readiness.ProbeTimeoutSec) * time.Second,
+		ResultValidity:       time.Duration(cfg.Readiness.ResultValiditySec) * time.Second,
+	}
+
+	return process.NewReadinessChecker(argsReadinessChecker)
+}
+
+func createHttpClient(cfg *config.Config) (*http.Client, error) {
+	argsHttpClient := process.ArgsHttpClient{
+		RequestTimeout:        time.Duration(cfg.GeneralSettings.RequestTimeoutSec) * time.Second,
+		DialTimeout:           time.Duration(cfg.HttpClient.DialTimeoutSec) * time.Second,
+		KeepAlive:             time.Duration(cfg.HttpClient.KeepAliveSec) * time.Second,
+		TLSHandshakeTimeout:   time.Duration(cfg.HttpClient.TLSHandshakeTimeoutSec) * time.Second,
+		ResponseHeaderTimeout: time.Duration(cfg.HttpClient.ResponseHeaderTimeoutSec) * time.Second,
+		IdleConnTimeout:       time.Duration(cfg.HttpClient.IdleConnTimeoutSec) * time.Second,
+		MaxIdleConns:          cfg.HttpClient.MaxIdleConns,
+		MaxIdleConnsPerHost:   cfg.HttpClient.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       cfg.HttpClient.MaxConnsPerHost,
+		DisableKeepAlives:     cfg.HttpClient.DisableKeepAlives,
+		EnableHTTP2:           cfg.HttpClient.EnableHTTP2,
+		EnableGzip:            cfg.HttpClient.EnableGzip,
+	}
+
+	return process.NewHttpClient(argsHttpClient)
+}
+
+func createRetryPolicy(cfg *config.Config) (process.RetryPolicyHandler, error) {
+	if !cfg.ReadRetries.Enabled {
+		return &disabled.RetryPolicy{}, nil
+	}
+
+	argsRetryPolicy := process.ArgsRetryPolicy{
+		MaxRetries:     cfg.ReadRetries.MaxRetries,
+		InitialBackoff: time.Duration(cfg.ReadRetries.InitialBackoffMs) * time.Millisecond,
+		MaxBackoff:     time.Duration(cfg.ReadRetries.MaxBackoffMs) * time.Millisecond,
+	}
+
+	return process.NewRetryPolicy(argsRetryPolicy)
+}
+
+func createHedgingPolicy(cfg *config.Config) (process.HedgingPolicyHandler, error) {
+	if !cfg.HedgedReads.Enabled {
+		return &disabled.HedgingPolicy{}, nil
+	}
+
+	argsHedgingPolicy := process.ArgsHedgingPolicy{
+		Percentile: cfg.HedgedReads.LatencyPercentile,
+		NumSamples: cfg.HedgedReads.NumLatencySamples,
+		MinDelay:   time.Duration(cfg.HedgedReads.MinDelayMs) * time.Millisecond,
+		MaxDelay:   time.Duration(cfg.HedgedReads.MaxDelayMs) * time.Millisecond,
+	}
+
+	return process.NewHedgingPolicy(argsHedgingPolicy)
+}
+
+func createQuorumPolicy(cfg *config.Config) (process.QuorumPolicyHandler, error) {
+	if !cfg.QuorumReads.Enabled {
+		return &disabled.QuorumPolicy{}, nil
+	}
+
+	argsQuorumPolicy := process.ArgsQuorumPolicy{
+		NumObservers: cfg.QuorumReads.NumObservers,
+		MinAgreeing:  cfg.QuorumReads.MinAgreeing,
+		Endpoints:    cfg.QuorumReads.Endpoints,
+	}
+
+	return process.NewQuorumPolicy(argsQuorumPolicy)
+}
+
+func createRoutingTable(cfg *config.Config) (process.RoutingTableHandler, error) {
+	routes := make(map[string]process.Route, len(cfg.Routing.Routes))
+	for _, routeConfig := range cfg.Routing.Routes {
+		if _, found := routes[routeConfig.Category]; found {
+			return nil, fmt.Errorf("%w: duplicate route for request category %s", process.ErrInvalidRoute, routeConfig.Category)
+		}
+
+		routes[routeConfig.Category] = process.Route{
+			Pools:        routeConfig.Pools,
+			Fallback:     routeConfig.Fallback,
+			RequiredTags: routeConfig.RequiredTags,
+		}
+	}
+
+	customPools := make(map[string][]string, len(cfg.Routing.Pools))
+	for _, poolConfig := range cfg.Routing.Pools {
+		if _, found := customPools[poolConfig.Name]; found {
+			return nil, fmt.Errorf("%w: duplicate custom pool %s", process.ErrInvalidRoute, poolConfig.Name)
+		}
+
+		customPools[poolConfig.Name] = poolConfig.Addresses
+	}
+
+	argsRoutingTable := process.ArgsRoutingTable{
+		Routes:      routes,
+		CustomPools: customPools,
+	}
+
+	return process.NewRoutingTable(argsRoutingTable)
+}
+
+func createCircuitBreaker(cfg *config.Config) (process.CircuitBreakerHandler, error) {
+	if !cfg.CircuitBreaker.Enabled {
+		return &disabled.CircuitBreaker{}, nil
+	}
+
+	argsNodesCircuitBreaker := process.ArgsNodesCircuitBreaker{
+		FailureThreshold: cfg.CircuitBreaker.FailureThreshold,
+		CoolDown:         time.Duration(cfg.CircuitBreaker.CoolDownSec) * time.Second,
+	}
+
+	return process.NewNodesCircuitBreaker(argsNodesCircuitBreaker)
+}
+
+func createRequestsCoalescer(cfg *config.Config) process.RequestsCoalescerHandler {
+	if !cfg.RequestCoalescing.Enabled {
+		return &disabled.RequestsCoalescer{}
+	}
+
+	return process.NewRequestsCoalescer()
+}
+
+func createSharedStore(cfg *config.Config) (cache.SharedStoreHandler, error) {
+	if !cfg.SharedCache.Enabled {
+		return nil, nil
+	}
+
+	argsRedisClient := cache.ArgsRedisClient{
+		Address:            cfg.SharedCache.Address,
+		Password:           cfg.SharedCache.Password,
+		Database:           cfg.SharedCache.Database,
+		OperationTimeout:   time.Duration(cfg.SharedCache.OperationTimeoutMs) * time.Millisecond,
+		MaxIdleConnections: cfg.SharedCache.MaxIdleConnections,
+	}
+
+	return cache.NewRedisClient(argsRedisClient)
+}
+
+func sharedCacheKey(cfg *config.Config, name string) string {
+	return cfg.SharedCache.KeyPrefix + ":" + name
+}
+
+func createLeaderElection(
+	cfg *config.Config,
+	sharedStore cache.SharedStoreHandler,
+	isRosettaModeEnabled bool,
+) (process.LeaderElectionHandler, error) {
+	// in rosetta mode the caches are not updated in the background, so this proxy should not take the leadership
+	if !cfg.SharedCache.Enabled || isRosettaModeEnabled {
+		return &disabled.LeaderElection{}, nil
+	}
+
+	argsLeaderLock := cache.ArgsLeaderLock{
+		Store:        sharedStore,
+		Key:          sharedCacheKey(cfg, "cacheUpdateLeader"),
+		LockDuration: time.Duration(cfg.SharedCache.LeaderLockDurationSec) * time.Second,
+	}
+	leaderLock, err := cache.NewLeaderLock(argsLeaderLock)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderLock.StartElection()
+
+	return leaderLock, nil
+}
+
+func createHeartbeatCacher(cfg *config.Config, sharedStore cache.SharedStoreHandler) (process.HeartbeatCacheHandler, error) {
+	if cfg.SharedCache.Enabled {
+		return cache.NewHeartbeatSharedCacher(sharedStore, sharedCacheKey(cfg, "heartbeats"))
+	}
+	if !cfg.CacheSnapshots.Enabled {
+		return cache.NewHeartbeatMemoryCacher(), nil
+	}
+
+	return cache.NewHeartbeatDiskCacher(filepath.Join(cfg.CacheSnapshots.Directory, heartbeatsSnapshotFile))
+}
+
+func createValidatorStatisticsCacher(
+	cfg *config.Config,
+	sharedStore cache.SharedStoreHandler,
+) (process.ValidatorStatisticsCacheHandler, error) {
+	if cfg.SharedCache.Enabled {
+		return cache.NewValidatorsStatsSharedCacher(sharedStore, sharedCacheKey(cfg, "validatorStatistics"))
+	}
+	if !cfg.CacheSnapshots.Enabled {
+		return cache.NewValidatorsStatsMemoryCacher(), nil
+	}
+
+	return cache.NewValidatorsStatsDiskCacher(filepath.Join(cfg.CacheSnapshots.Directory, validatorStatisticsSnapshotFile))
+}
+
+func createResponsesCache(cfg *config.Config, sharedStore cache.SharedStoreHandler) (process.ResponsesCacheHandler, error) {
+	if !cfg.ResponsesCache.Enabled {
+		return &disabled.ResponsesCache{}, nil
+	}
+	if cfg.SharedCache.Enabled {
+		ttl := time.Duration(cfg.SharedCache.ResponsesTTLSec) * time.Second
+		return cache.NewSharedResponsesCache(sharedStore, sharedCacheKey(cfg, "responses:"), ttl)
+	}
+
+	return process.NewImmutableResponsesCache(cfg.ResponsesCache.Capacity)
+}
+
+func createConcurrencyLimiter(cfg *config.Config) (process.ConcurrencyLimiterHandler, error) {
+	if !cfg.ConcurrencyLimits.Enabled {
+		return &disabled.ConcurrencyLimiter{}, nil
+	}
+
+	argsNodesConcurrencyLimiter := process.ArgsNodesConcurrencyLimiter{
+		MaxInFlightRequests: cfg.ConcurrencyLimits.MaxInFlightRequests,
+		MaxQueuedRequests:   cfg.ConcurrencyLimits.MaxQueuedRequests,
+		QueueTimeout:        time.Duration(cfg.ConcurrencyLimits.QueueTimeoutMs) * time.Millisecond,
+	}
+
+	return process.NewNodesConcurrencyLimiter(argsNodesConcurrencyLimiter)
+}
+
+func createNodesHealthChecker(
+	cfg *config.Config,
+	bp process.Processor,
+	nodesHttpClients process.NodesHttpClientsHandler,
+	circuitBreaker process.CircuitBreakerHandler,
+	concurrencyLimiter process.ConcurrencyLimiterHandler,
+	metricsHandler process.MetricsHandler,
+) (process.NodesHealthHandler, error) {
+	if !cfg.NodesHealthCheck.Enabled {
+		if cfg.NetworkConsistency.Enabled {
+			log.Warn("the network consistency checks require the nodes health checks to be enabled")
+		}
+		return &disabled.NodesHealthChecker{}, nil
+	}
+
+	networkConfigGuard, err := createNetworkConfigGuard(cfg, bp)
+	if err != nil {
+		return nil, err
+	}
+
+	argsNodesHealthChecker := process.ArgsNodesHealthChecker{
+		Processor:              bp,
+		HttpClients:            nodesHttpClients,
+		CircuitBreaker:         circuitBreaker,
+		ConcurrencyLimiter:     concurrencyLimiter,
+		NetworkConfigGuard:     networkConfigGuard,
+		Metrics:                metricsHandler,
+		CheckInterval:          time.Duration(cfg.NodesHealthCheck.CheckIntervalSec) * time.Second,
+		MaxConsecutiveFailures: cfg.NodesHealthCheck.MaxConsecutiveFailures,
+		SyncAwareRouting:       cfg.NodesHealthCheck.SyncAwareRouting,
+		MaxNoncesBehind:        cfg.NodesHealthCheck.MaxNoncesBehind,
+		CheckShards:            cfg.ShardDiscovery.Enabled,
+		RejectMismatchingNodes: cfg.ShardDiscovery.RejectMismatchingNodes,
+	}
+	nodesHealthChecker, err := process.NewNodesHealthChecker(argsNodesHealthChecker)
+	if err != nil {
+		return nil, err
+	}
+
+	// the health checks run for as long as the proxy does
+	nodesHealthChecker.StartHealthChecks(context.Background())
+
+	return nodesHealthChecker, nil
+}
+
+func createNetworkConfigGuard(cfg *config.Config, bp process.Processor) (process.NetworkConfigGuardHandler, error) {
+	if !cfg.NetworkConsistency.Enabled {
+		return &disabled.NetworkConfigGuard{}, nil
+	}
+
+	argsNetworkConfigGuard := process.ArgsNetworkConfigGuard{
+		Processor:       bp,
+		ExpectedChainID: cfg.NetworkConsistency.ExpectedChainID,
+	}
+
+	return process.NewNetworkConfigGuard(argsNetworkConfigGuard)
+}
+
+func startNodesReloader(
+	cfg *config.Config,
+	configFilePath string,
+	observersProvider observer.NodesProviderHandler,
+	fullHistoryNodesProvider observer.NodesProviderHandler,
+	nodesCredentials observer.NodesCredentialsHandler,
+	shardDiscoverer observer.ShardDiscovererHandler,
+) error {
+	if !cfg.NodesReload.Enabled || len(configFilePath) == 0 {
+		return nil
+	}
+
+	argsNodesReloader := observer.ArgsNodesReloader{
+		ConfigFilePath:           configFilePath,
+		ObserversProvider:        observersProvider,
+		FullHistoryNodesProvider: fullHistoryNodesProvider,
+		NodesCredentials:         nodesCredentials,
+		ShardDiscoverer:          shardDiscoverer,
+	}
+	nodesReloader, err := observer.NewNodesReloader(argsNodesReloader)
+	if err != nil {
+		return err
+	}
+
+	if cfg.NodesReload.WatchConfigFile {
+		err = nodesReloader.StartWatchingConfigFile(time.Duration(cfg.NodesReload.FileCheckIntervalSec) * time.Second)
+		if err != nil {
+			return err
+		}
+	}
+
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	go func() {
+		for range reload {
+			log.Info("received SIGHUP, reloading nodes", "file", configFilePath)
+			errReload := nodesReloader.ReloadNodes()
+			if errReload != nil {
+				log.Warn("cannot reload nodes", "error", errReload.Error())
+			}
+		}
+	}()
+
+	return nil
+}
+
+func createShardDiscoverer(
+	cfg *config.Config,
+	nodesHttpClients process.NodesHttpClientsHandler,
+) (observer.ShardDiscovererHandler, error) {
+	if !cfg.ShardDiscovery.Enabled {
+		return &disabled.ShardDiscoverer{}, nil
+	}
+
+	argsShardDiscoverer := process.ArgsShardDiscoverer{
+		HttpClients:            nodesHttpClients,
+		RejectMismatchingNodes: cfg.ShardDiscovery.RejectMismatchingNodes,
+	}
+
+	return process.NewShardDiscoverer(argsShardDiscoverer)
+}
+
+// discoverShards checks the configured nodes against the shards they report and removes the rejected ones from the
+// config. It returns the number of shards reported by most of the nodes, or 0 if the discovery is disabled or no
+// node answered
+func discoverShards(cfg *config.Config, shardDiscoverer observer.ShardDiscovererHandler) uint32 {
+	if !cfg.ShardDiscovery.Enabled {
+		return 0
+	}
+
+	var numShards uint32
+	cfg.Observers, cfg.FullHistoryNodes, numShards = shardDiscoverer.DiscoverNodesShards(cfg.Observers, cfg.FullHistoryNodes)
+
+	log.Info("shard discovery", "number of shards", numShards,
+		"observers", len(cfg.Observers), "full history nodes", len(cfg.FullHistoryNodes))
+
+	return numShards
+}
+
+func getShardCoordinator(cfg *config.Config, discoveredNumShards uint32) (sharding.Coordinator, error) {
 	maxShardID := uint32(0)
 	for _, obs := range cfg.Observers {
 		shardID := obs.ShardId
@@ -508,7 +1023,16 @@ func getShardCoordinator(cfg *config.Config) (sharding.Coordinator, error) {
 		}
 	}
 
-	shardCoordinator, err := sharding.NewMultiShardCoordinator(maxShardID+1, 0)
+	numShards := maxShardID + 1
+	if discoveredNumShards > 0 {
+		if discoveredNumShards != numShards {
+			log.Warn("the configured observers do not match the discovered number of shards",
+				"number of shards from config", numShards, "discovered number of shards", discoveredNumShards)
+		}
+		numShards = discoveredNumShards
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(numShards, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -516,7 +1040,168 @@ func getShardCoordinator(cfg *config.Config) (sharding.Coordinator, error) {
 	return shardCoordinator, nil
 }
 
-func startWebServer(versionsRegistry data.VersionsRegistryHandler, cliContext *cli.Context, generalConfig *config.Config) (*http.Server, error) {
+func createApiMiddlewares(
+	cfg *config.Config,
+	versionsRegistry data.VersionsRegistryHandler,
+	apiKeysRegistry process.ApiKeysRegistryHandler,
+	proxyMetrics *metrics.ProxyMetrics,
+) ([]gin.HandlerFunc, error) {
+	middlewares := make([]gin.HandlerFunc, 0)
+	if len(cfg.GeneralSettings.TrustedProxies) > 0 {
+		// the client's IP is resolved first, so all the next middlewares identify the client the same way
+		clientIPResolver, err := middleware.NewClientIPResolver(cfg.GeneralSettings.TrustedProxies)
+		if err != nil {
+			return nil, err
+		}
+		middlewares = append(middlewares, clientIPResolver.MiddlewareHandlerFunc())
+	}
+	if proxyMetrics != nil {
+		// the requests metrics come first, so the requests rejected by the next middlewares are recorded as well
+		middlewares = append(middlewares, middleware.RequestsMetrics(proxyMetrics))
+	}
+	if cfg.ApiKeys.Enabled {
+		apiKeysAuthenticator, err := createApiKeysAuthenticator(cfg, versionsRegistry, apiKeysRegistry)
+		if err != nil {
+			return nil, err
+		}
+		middlewares = append(middlewares, apiKeysAuthenticator.MiddlewareHandlerFunc())
+	}
+	if cfg.RateLimiting.Enabled {
+		rateLimiter, err := createRateLimiter(cfg, versionsRegistry)
+		if err != nil {
+			return nil, err
+		}
+		middlewares = append(middlewares, rateLimiter.MiddlewareHandlerFunc())
+	}
+	if len(cfg.GeneralSettings.RoutingSessionHeader) > 0 {
+		middlewares = append(middlewares, middleware.RoutingKeyFromHeader(cfg.GeneralSettings.RoutingSessionHeader))
+	}
+
+	return middlewares, nil
+}
+
+func getApiVersions(versionsRegistry data.VersionsRegistryHandler) ([]string, error) {
+	versionsMap, err := versionsRegistry.GetAllVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(versionsMap))
+	for version := range versionsMap {
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+func createApiKeysRegistry(cfg *config.Config) (process.ApiKeysRegistryHandler, error) {
+	if !cfg.ApiKeys.Enabled {
+		return &disabled.ApiKeysRegistry{}, nil
+	}
+
+	keysConfigs := cfg.ApiKeys.Keys
+	if len(cfg.ApiKeys.KeysFile) > 0 {
+		keysFileConfig := &config.ApiKeysFileConfig{}
+		err := core.LoadTomlFile(keysFileConfig, cfg.ApiKeys.KeysFile)
+		if err != nil {
+			return nil, err
+		}
+		keysConfigs = append(keysConfigs, keysFileConfig.Keys...)
+	}
+
+	tiers := make([]data.ApiKeyTier, 0, len(cfg.ApiKeys.Tiers))
+	for _, tierConfig := range cfg.ApiKeys.Tiers {
+		tiers = append(tiers, data.ApiKeyTier{
+			Name:              tierConfig.Name,
+			AllowedPaths:      tierConfig.AllowedPaths,
+			RequestsPerSecond: tierConfig.RequestsPerSecond,
+			Burst:             tierConfig.Burst,
+			DailyQuota:        tierConfig.DailyQuota,
+			IsAdmin:           tierConfig.IsAdmin,
+		})
+	}
+
+	keys := make([]data.ApiKey, 0, len(keysConfigs))
+	for _, keyConfig := range keysConfigs {
+		keys = append(keys, data.ApiKey{
+			Key:   keyConfig.Key,
+			Owner: keyConfig.Owner,
+			Tier:  keyConfig.Tier,
+		})
+	}
+
+	argsApiKeysRegistry := process.ArgsApiKeysRegistry{
+		Tiers:             tiers,
+		Keys:              keys,
+		UsageFilePath:     cfg.ApiKeys.UsageFile,
+		UsageSaveInterval: time.Duration(cfg.ApiKeys.UsageSaveIntervalSec) * time.Second,
+	}
+	apiKeysRegistry, err := process.NewApiKeysRegistry(argsApiKeysRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeysRegistry.StartUsagePersistence()
+
+	return apiKeysRegistry, nil
+}
+
+func createApiKeysAuthenticator(
+	cfg *config.Config,
+	versionsRegistry data.VersionsRegistryHandler,
+	apiKeysRegistry process.ApiKeysRegistryHandler,
+) (*middleware.ApiKeysAuthenticator, error) {
+	versions, err := getApiVersions(versionsRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	argsApiKeysAuthenticator := middleware.ArgsApiKeysAuthenticator{
+		HeaderName:     cfg.ApiKeys.HeaderName,
+		QueryParamName: cfg.ApiKeys.QueryParamName,
+		AnonymousTier:  cfg.ApiKeys.AnonymousTier,
+		Versions:       versions,
+		Registry:       apiKeysRegistry,
+	}
+
+	return middleware.NewApiKeysAuthenticator(argsApiKeysAuthenticator)
+}
+
+func createRateLimiter(cfg *config.Config, versionsRegistry data.VersionsRegistryHandler) (*middleware.RateLimiter, error) {
+	versions, err := getApiVersions(versionsRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]middleware.RateLimitRule, 0, len(cfg.RateLimiting.Rules))
+	for _, ruleConfig := range cfg.RateLimiting.Rules {
+		rules = append(rules, middleware.RateLimitRule{
+			Path:              ruleConfig.Path,
+			RequestsPerSecond: ruleConfig.RequestsPerSecond,
+			Burst:             ruleConfig.Burst,
+		})
+	}
+
+	argsRateLimiter := middleware.ArgsRateLimiter{
+		Versions: versions,
+		DefaultRule: middleware.RateLimitRule{
+			RequestsPerSecond: cfg.RateLimiting.RequestsPerSecond,
+			Burst:             cfg.RateLimiting.Burst,
+		},
+		Rules: rules,
+	}
+
+	return middleware.NewRateLimiter(argsRateLimiter)
+}
+
+func startWebServer(
+	versionsRegistry data.VersionsRegistryHandler,
+	cliContext *cli.Context,
+	generalConfig *config.Config,
+	apiKeysRegistry process.ApiKeysRegistryHandler,
+	readinessChecker *process.ReadinessChecker,
+	proxyMetrics *metrics.ProxyMetrics,
+) (*http.Server, error) {
 	var err error
 	var httpServer *http.Server
 
@@ -529,7 +1214,17 @@ func startWebServer(versionsRegistry data.VersionsRegistryHandler, cliContext *c
 		}
 		httpServer, err = rosetta.CreateServer(facades["v1.0"].Facade, generalConfig, port)
 	} else {
-		httpServer, err = api.CreateServer(versionsRegistry, port)
+		var middlewares []gin.HandlerFunc
+		middlewares, err = createApiMiddlewares(generalConfig, versionsRegistry, apiKeysRegistry, proxyMetrics)
+		if err != nil {
+			return nil, err
+		}
+
+		var metricsHandler http.Handler
+		if proxyMetrics != nil {
+			metricsHandler = proxyMetrics
+		}
+		httpServer, err = api.CreateServer(versionsRegistry, port, readinessChecker, metricsHandler, middlewares...)
 	}
 	if err != nil {
 		return nil, err
@@ -546,7 +1241,7 @@ func startWebServer(versionsRegistry data.VersionsRegistryHandler, cliContext *c
 }
 
 func waitForServerShutdown(httpServer *http.Server) {
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, os.Kill)
 	<-quit
 
@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
 
@@ -14,14 +15,256 @@ type GeneralSettingsConfig struct {
 	FaucetValue                       string
 	BalancedObservers                 bool
 	BalancedFullHistoryNodes          bool
+	ObserversBalancingStrategy        string
+	FullHistoryNodesBalancingStrategy string
+	RoutingSessionHeader              string
+	TrustedProxies                    []string
+}
+
+// NodesHealthCheckConfig will hold the settings for the periodic health checks of the observers and full history nodes
+type NodesHealthCheckConfig struct {
+	Enabled                bool
+	CheckIntervalSec       int
+	MaxConsecutiveFailures uint32
+	SyncAwareRouting       bool
+	MaxNoncesBehind        uint64
+}
+
+// NodesReloadConfig will hold the settings for reloading the observers and full history nodes without a restart
+type NodesReloadConfig struct {
+	Enabled              bool
+	WatchConfigFile      bool
+	FileCheckIntervalSec int
+}
+
+// CircuitBreakerConfig will hold the settings for the circuit breakers which protect the nodes while they are failing
+type CircuitBreakerConfig struct {
+	Enabled          bool
+	FailureThreshold uint32
+	CoolDownSec      int
+}
+
+// ConcurrencyLimitsConfig will hold the settings for limiting the number of in-flight requests towards each node
+type ConcurrencyLimitsConfig struct {
+	Enabled             bool
+	MaxInFlightRequests uint32
+	MaxQueuedRequests   uint32
+	QueueTimeoutMs      int
+}
+
+// RequestCoalescingConfig will hold the settings for sharing a single call between the identical concurrent requests
+type RequestCoalescingConfig struct {
+	Enabled bool
+}
+
+// HttpClientConfig will hold the settings for the http client used for the requests towards the nodes
+type HttpClientConfig struct {
+	MaxIdleConns             int
+	MaxIdleConnsPerHost      int
+	MaxConnsPerHost          int
+	IdleConnTimeoutSec       int
+	DisableKeepAlives        bool
+	KeepAliveSec             int
+	DialTimeoutSec           int
+	TLSHandshakeTimeoutSec   int
+	ResponseHeaderTimeoutSec int
+	EnableHTTP2              bool
+	EnableGzip               bool
+}
+
+// ReadRetriesConfig will hold the settings for retrying the idempotent reads which failed on all the observers
+type ReadRetriesConfig struct {
+	Enabled          bool
+	MaxRetries       uint32
+	InitialBackoffMs int
+	MaxBackoffMs     int
+}
+
+// HedgedReadsConfig will hold the settings for sending duplicate idempotent reads to other observers
+type HedgedReadsConfig struct {
+	Enabled           bool
+	LatencyPercentile float64
+	NumLatencySamples uint32
+	MinDelayMs        int
+	MaxDelayMs        int
+}
+
+// QuorumReadsConfig will hold the settings for the reads which are answered by a quorum of observers
+type QuorumReadsConfig struct {
+	Enabled      bool
+	NumObservers uint32
+	MinAgreeing  uint32
+	Endpoints    []string
+}
+
+// RouteConfig will hold the ordered pools of nodes which serve a request category, the rule for using the next pool
+// and the tags the nodes should have for serving the request category
+type RouteConfig struct {
+	Category     string
+	Pools        []string
+	Fallback     string
+	RequiredTags []string
+}
+
+// NodesPoolConfig will hold a custom pool made of the observers and full history nodes with the given addresses
+type NodesPoolConfig struct {
+	Name      string
+	Addresses []string
+}
+
+// RoutingConfig will hold the routing table which maps the request categories to the pools of nodes serving them
+type RoutingConfig struct {
+	Routes []RouteConfig
+	Pools  []NodesPoolConfig
+}
+
+// ShardDiscoveryConfig will hold the settings for discovering the shard of each node and the number of shards
+type ShardDiscoveryConfig struct {
+	Enabled                bool
+	RejectMismatchingNodes bool
+}
+
+// NetworkConsistencyConfig will hold the settings for quarantining the nodes with an inconsistent network config
+type NetworkConsistencyConfig struct {
+	Enabled         bool
+	ExpectedChainID string
+}
+
+// ResponsesCacheConfig will hold the settings for caching the responses which never change, such as the blocks read by hash
+type ResponsesCacheConfig struct {
+	Enabled  bool
+	Capacity int
+}
+
+// CacheSnapshotsConfig will hold the settings for persisting the heartbeats and the validator statistics on disk
+type CacheSnapshotsConfig struct {
+	Enabled   bool
+	Directory string
+}
+
+// SharedCacheConfig will hold the settings for the cache shared by several proxies, kept in a store speaking the
+// Redis protocol
+type SharedCacheConfig struct {
+	Enabled               bool
+	Address               string
+	Password              string
+	Database              int
+	OperationTimeoutMs    int
+	MaxIdleConnections    int
+	KeyPrefix             string
+	LeaderLockDurationSec int
+	ResponsesTTLSec       int
+}
+
+// RateLimitRuleConfig will hold the rate limits of a route group or of an endpoint
+type RateLimitRuleConfig struct {
+	Path              string
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// RateLimitingConfig will hold the settings for limiting the rate of the requests of each client
+type RateLimitingConfig struct {
+	Enabled           bool
+	RequestsPerSecond float64
+	Burst             int
+	Rules             []RateLimitRuleConfig
+}
+
+// ApiKeyTierConfig will hold the allowed paths and the limits of a tier of API keys
+type ApiKeyTierConfig struct {
+	Name              string
+	AllowedPaths      []string
+	RequestsPerSecond float64
+	Burst             int
+	DailyQuota        uint64
+	IsAdmin           bool
+}
+
+// ApiKeyConfig will hold an API key, along with its owner and the name of its tier
+type ApiKeyConfig struct {
+	Key   string
+	Owner string
+	Tier  string
+}
+
+// ApiKeysFileConfig will hold the API keys read from the keys file
+type ApiKeysFileConfig struct {
+	Keys []ApiKeyConfig
+}
+
+// ApiKeysConfig will hold the settings for authenticating the API clients by their API keys
+type ApiKeysConfig struct {
+	Enabled              bool
+	HeaderName           string
+	QueryParamName       string
+	AnonymousTier        string
+	KeysFile             string
+	UsageFile            string
+	UsageSaveIntervalSec int
+	Tiers                []ApiKeyTierConfig
+	Keys                 []ApiKeyConfig
+}
+
+// MetricsConfig will hold the settings for exposing the proxy's metrics
+type MetricsConfig struct {
+	Enabled bool
+}
+
+// ReadinessConfig will hold the settings for checking whether the proxy can serve requests
+type ReadinessConfig struct {
+	ProbeTimeoutSec   int
+	ResultValiditySec int
 }
 
 // Config will hold the whole config file's data
 type Config struct {
 	GeneralSettings        GeneralSettingsConfig
+	NodesHealthCheck       NodesHealthCheckConfig
+	ShardDiscovery         ShardDiscoveryConfig
+	NetworkConsistency     NetworkConsistencyConfig
+	NodesReload            NodesReloadConfig
+	CircuitBreaker         CircuitBreakerConfig
+	ConcurrencyLimits      ConcurrencyLimitsConfig
+	RequestCoalescing      RequestCoalescingConfig
+	HttpClient             HttpClientConfig
+	ReadRetries            ReadRetriesConfig
+	HedgedReads            HedgedReadsConfig
+	QuorumReads            QuorumReadsConfig
+	Routing                RoutingConfig
+	ResponsesCache         ResponsesCacheConfig
+	CacheSnapshots         CacheSnapshotsConfig
+	SharedCache            SharedCacheConfig
+	RateLimiting           RateLimitingConfig
+	ApiKeys                ApiKeysConfig
+	Metrics                MetricsConfig
+	Readiness              ReadinessConfig
 	AddressPubkeyConverter config.PubkeyConfig
 	Marshalizer            config.TypeConfig
 	Hasher                 config.TypeConfig
 	Observers              []*data.NodeData
 	FullHistoryNodes       []*data.NodeData
 }
+
+// LoadConfig loads the main config file. The ShardId of each node is set to its configured shard or, if it is left
+// out, to 0 until the shard discovery sets it to the shard reported by the node
+func LoadConfig(filePath string) (*Config, error) {
+	cfg := &Config{}
+	err := core.LoadTomlFile(cfg, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	setConfiguredShards(cfg.Observers)
+	setConfiguredShards(cfg.FullHistoryNodes)
+
+	return cfg, nil
+}
+
+func setConfiguredShards(nodes []*data.NodeData) {
+	for _, node := range nodes {
+		if node.ConfiguredShardId != nil {
+			node.ShardId = *node.ConfiguredShardId
+		}
+	}
+}
@@ -30,9 +30,11 @@ type ValidatorApiResponse struct {
 	ValidatorStatus                    string  `json:"validatorStatus"`
 }
 
-// ValidatorStatisticsResponse respects the format the validator statistics are received from the observers
+// ValidatorStatisticsResponse respects the format the validator statistics are received from the observers.
+// SnapshotAgeSec is set only for the statistics loaded from a persisted snapshot
 type ValidatorStatisticsResponse struct {
-	Statistics map[string]*ValidatorApiResponse `json:"statistics"`
+	Statistics     map[string]*ValidatorApiResponse `json:"statistics"`
+	SnapshotAgeSec uint64                           `json:"snapshotAgeSec,omitempty"`
 }
 
 // ValidatorStatisticsApiResponse respects the format the validator statistics are received from the observers
@@ -31,10 +31,15 @@ type VersionData struct {
 	ApiHandler ApiHandler
 }
 
-// EndpointHandlerData holds the items needed for creating a new HTTP endpoint
+// EndpointHandlerData holds the items needed for creating a new HTTP endpoint. The summary, the query parameters and
+// the instances of the request and response bodies are optional and only describe the endpoint in the OpenAPI document
 type EndpointHandlerData struct {
-	Handler gin.HandlerFunc
-	Method  string
+	Handler     gin.HandlerFunc
+	Method      string
+	Summary     string
+	QueryParams []string
+	Request     interface{}
+	Response    interface{}
 }
 
 // GroupHandler defines the actions that an api group handler should be able to do
@@ -43,6 +48,7 @@ type GroupHandler interface {
 	UpdateEndpoint(path string, handlerData EndpointHandlerData) error
 	RegisterRoutes(ws *gin.RouterGroup)
 	RemoveEndpoint(path string) error
+	GetAllEndpoints() map[string]EndpointHandlerData
 	IsInterfaceNil() bool
 }
 
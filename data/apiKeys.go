@@ -0,0 +1,35 @@
+package data
+
+// ApiKeyTier holds the access rights and the limits shared by the API keys of a tier
+type ApiKeyTier struct {
+	Name              string
+	AllowedPaths      []string
+	RequestsPerSecond float64
+	Burst             int
+	DailyQuota        uint64
+	IsAdmin           bool
+}
+
+// ApiKey holds an API key, along with its owner and its tier
+type ApiKey struct {
+	Key   string
+	Owner string
+	Tier  string
+}
+
+// ApiKeyUsage holds the usage counters of an API key. The key itself is masked
+type ApiKeyUsage struct {
+	Key                  string `json:"key"`
+	Owner                string `json:"owner"`
+	Tier                 string `json:"tier"`
+	RequestsToday        uint64 `json:"requestsToday"`
+	DailyQuota           uint64 `json:"dailyQuota"`
+	TotalRequests        uint64 `json:"totalRequests"`
+	LastRequestTimestamp int64  `json:"lastRequestTimestamp"`
+}
+
+// ApiKeysUsageResponse holds the usage counters of all the API keys for the current day (UTC)
+type ApiKeysUsageResponse struct {
+	Day  string         `json:"day"`
+	Keys []*ApiKeyUsage `json:"keys"`
+}
@@ -0,0 +1,18 @@
+package data
+
+import "context"
+
+type endpointNameContextKey struct{}
+
+// WithEndpointName returns a copy of the context which carries the name of the endpoint the requests sent to the nodes
+// are made for, such as the route template of a REST API request, so they can be labeled by it
+func WithEndpointName(ctx context.Context, endpointName string) context.Context {
+	return context.WithValue(ctx, endpointNameContextKey{}, endpointName)
+}
+
+// GetEndpointName returns the endpoint name carried by the context, if any
+func GetEndpointName(ctx context.Context) (string, bool) {
+	endpointName, ok := ctx.Value(endpointNameContextKey{}).(string)
+
+	return endpointName, ok && len(endpointName) > 0
+}
@@ -2,9 +2,11 @@ package data
 
 import "time"
 
-// HeartbeatResponse matches the output structure the data field for an heartbeat response
+// HeartbeatResponse matches the output structure the data field for an heartbeat response. SnapshotAgeSec is set
+// only for the heartbeats loaded from a persisted snapshot
 type HeartbeatResponse struct {
-	Heartbeats []PubKeyHeartbeat `json:"heartbeats"`
+	Heartbeats     []PubKeyHeartbeat `json:"heartbeats"`
+	SnapshotAgeSec uint64            `json:"snapshotAgeSec,omitempty"`
 }
 
 // HeartbeatApiResponse matches the output of an observer's heartbeat endpoint
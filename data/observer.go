@@ -1,7 +1,122 @@
 package data
 
-// NodeData holds an observer data
+import "time"
+
+// NodeData holds an observer data. The optional credentials are sent on every request towards the node: either
+// Username and Password for basic auth or BearerToken, plus a client certificate for mutual TLS and a custom CA for
+// verifying the node's certificate. The free-form Tags describe the node's capabilities (for example "full-history" or
+// "high-memory"), so the requests which need a capability can be sent only to the nodes having it. ConfiguredShardId is
+// read from the ShardId key of the config, so it is nil if the node's shard is left out. ShardId, the shard the node
+// serves, is not read from the config: it is set from ConfiguredShardId or from the shard reported by the node
 type NodeData struct {
-	ShardId uint32
-	Address string
+	ShardId           uint32  `toml:"-"`
+	ConfiguredShardId *uint32 `toml:"ShardId"`
+	Address           string
+	Weight            uint32
+	Username          string
+	Password          string
+	BearerToken       string
+	ClientCertFile    string
+	ClientKeyFile     string
+	CACertFile        string
+	Tags              []string
+}
+
+// HasTags returns true if the node has all the given tags
+func (nd *NodeData) HasTags(tags []string) bool {
+	for _, tag := range tags {
+		if !nd.hasTag(tag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (nd *NodeData) hasTag(tag string) bool {
+	for _, nodeTag := range nd.Tags {
+		if nodeTag == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ObserverResponse holds the response of the observer which answered a read request, along with the error of the
+// request, if any
+type ObserverResponse struct {
+	Observer   *NodeData
+	StatusCode int
+	Value      interface{}
+	Err        error
+}
+
+// NodeHealthStatus holds the health state of an observer or of a full history node, as seen by the proxy
+type NodeHealthStatus struct {
+	Address             string         `json:"address"`
+	ShardId             uint32         `json:"shardId"`
+	Tags                []string       `json:"tags,omitempty"`
+	IsHealthy           bool           `json:"isHealthy"`
+	IsSynced            bool           `json:"isSynced"`
+	IsInConfiguredShard bool           `json:"isInConfiguredShard"`
+	IsQuarantined       bool           `json:"isQuarantined"`
+	QuarantineReason    string         `json:"quarantineReason,omitempty"`
+	Nonce               uint64         `json:"nonce"`
+	ConsecutiveFailures uint32         `json:"consecutiveFailures"`
+	LastCheck           time.Time      `json:"lastCheck"`
+	LastError           string         `json:"lastError,omitempty"`
+	Circuit             CircuitStatus  `json:"circuit"`
+	Load                NodeLoadStatus `json:"load"`
+}
+
+// CircuitStatus holds the state of a node's circuit breaker
+type CircuitStatus struct {
+	State       string `json:"state,omitempty"`
+	TimesOpened uint32 `json:"timesOpened"`
+}
+
+// NodeLoadStatus holds the number of requests the proxy has in flight towards a node and the number of requests
+// waiting in the node's queue
+type NodeLoadStatus struct {
+	InFlightRequests uint32 `json:"inFlightRequests"`
+	QueuedRequests   uint32 `json:"queuedRequests"`
+}
+
+// ResponsesCacheStats holds the usage counters of the cache of the responses which never change
+type ResponsesCacheStats struct {
+	Hits       uint64 `json:"hits"`
+	Misses     uint64 `json:"misses"`
+	NumEntries int    `json:"numEntries"`
+	Capacity   int    `json:"capacity"`
+}
+
+// NodesHealthResponse holds the health state of all the observers and full history nodes
+type NodesHealthResponse struct {
+	Observers        []*NodeHealthStatus `json:"observers"`
+	FullHistoryNodes []*NodeHealthStatus `json:"fullHistoryNodes"`
+}
+
+// ShardReadiness holds the number of configured and of reachable observers of a shard
+type ShardReadiness struct {
+	ShardId                uint32 `json:"shardId"`
+	NumConfiguredObservers int    `json:"numConfiguredObservers"`
+	NumReachableObservers  int    `json:"numReachableObservers"`
+	IsReachable            bool   `json:"isReachable"`
+}
+
+// ExternalStorageReadiness holds the connectivity of the external storage (ElasticSearch)
+type ExternalStorageReadiness struct {
+	IsReachable bool   `json:"isReachable"`
+	LastError   string `json:"lastError,omitempty"`
+}
+
+// ReadinessResponse holds the readiness of the proxy to serve requests. The proxy is ready if each shard, the
+// metachain included, has at least one reachable observer
+type ReadinessResponse struct {
+	IsReady              bool                      `json:"isReady"`
+	IsMetachainReachable bool                      `json:"isMetachainReachable"`
+	Shards               []*ShardReadiness         `json:"shards"`
+	ElasticSearch        *ExternalStorageReadiness `json:"elasticSearch,omitempty"`
+	CheckTime            time.Time                 `json:"checkTime"`
 }
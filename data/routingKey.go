@@ -0,0 +1,18 @@
+package data
+
+import "context"
+
+type routingKeyContextKey struct{}
+
+// WithRoutingKey returns a copy of the context which carries the key used for routing the requests of the same
+// session to the same observer
+func WithRoutingKey(ctx context.Context, routingKey string) context.Context {
+	return context.WithValue(ctx, routingKeyContextKey{}, routingKey)
+}
+
+// GetRoutingKey returns the routing key carried by the context, if any
+func GetRoutingKey(ctx context.Context) (string, bool) {
+	routingKey, ok := ctx.Value(routingKeyContextKey{}).(string)
+
+	return routingKey, ok && len(routingKey) > 0
+}
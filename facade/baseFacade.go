@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"context"
 	"errors"
 	"math/big"
 
@@ -87,13 +88,13 @@ func NewElrondProxyFacade(
 }
 
 // GetAccount returns an account based on the input address
-func (epf *ElrondProxyFacade) GetAccount(address string) (*data.Account, error) {
-	return epf.accountProc.GetAccount(address)
+func (epf *ElrondProxyFacade) GetAccount(ctx context.Context, address string) (*data.Account, error) {
+	return epf.accountProc.GetAccount(ctx, address)
 }
 
 // GetValueForKey returns the value for the given address and key
-func (epf *ElrondProxyFacade) GetValueForKey(address string, key string) (string, error) {
-	return epf.accountProc.GetValueForKey(address, key)
+func (epf *ElrondProxyFacade) GetValueForKey(ctx context.Context, address string, key string) (string, error) {
+	return epf.accountProc.GetValueForKey(ctx, address, key)
 }
 
 // GetShardIDForAddress returns the computed shard ID for the given address based on the current proxy's configuration
@@ -107,48 +108,48 @@ func (epf *ElrondProxyFacade) GetTransactions(address string) ([]data.DatabaseTr
 }
 
 // GetESDTTokenData returns the token data for a given token name
-func (epf *ElrondProxyFacade) GetESDTTokenData(address string, key string) (*data.GenericAPIResponse, error) {
-	return epf.accountProc.GetESDTTokenData(address, key)
+func (epf *ElrondProxyFacade) GetESDTTokenData(ctx context.Context, address string, key string) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetESDTTokenData(ctx, address, key)
 }
 
 // GetAllESDTTokens returns all the ESDT tokens for a given address
-func (epf *ElrondProxyFacade) GetAllESDTTokens(address string) (*data.GenericAPIResponse, error) {
-	return epf.accountProc.GetAllESDTTokens(address)
+func (epf *ElrondProxyFacade) GetAllESDTTokens(ctx context.Context, address string) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetAllESDTTokens(ctx, address)
 }
 
 // SendTransaction should send the transaction to the correct observer
-func (epf *ElrondProxyFacade) SendTransaction(tx *data.Transaction) (int, string, error) {
-	return epf.txProc.SendTransaction(tx)
+func (epf *ElrondProxyFacade) SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error) {
+	return epf.txProc.SendTransaction(ctx, tx)
 }
 
 // SendMultipleTransactions should send the transactions to the correct observers
-func (epf *ElrondProxyFacade) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
-	return epf.txProc.SendMultipleTransactions(txs)
+func (epf *ElrondProxyFacade) SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+	return epf.txProc.SendMultipleTransactions(ctx, txs)
 }
 
 // SimulateTransaction should send the transaction to the correct observer for simulation
-func (epf *ElrondProxyFacade) SimulateTransaction(tx *data.Transaction) (*data.GenericAPIResponse, error) {
-	return epf.txProc.SimulateTransaction(tx)
+func (epf *ElrondProxyFacade) SimulateTransaction(ctx context.Context, tx *data.Transaction) (*data.GenericAPIResponse, error) {
+	return epf.txProc.SimulateTransaction(ctx, tx)
 }
 
 // TransactionCostRequest should return how many gas units a transaction will cost
-func (epf *ElrondProxyFacade) TransactionCostRequest(tx *data.Transaction) (string, error) {
-	return epf.txProc.TransactionCostRequest(tx)
+func (epf *ElrondProxyFacade) TransactionCostRequest(ctx context.Context, tx *data.Transaction) (string, error) {
+	return epf.txProc.TransactionCostRequest(ctx, tx)
 }
 
 // GetTransactionStatus should return transaction status
-func (epf *ElrondProxyFacade) GetTransactionStatus(txHash string, sender string) (string, error) {
-	return epf.txProc.GetTransactionStatus(txHash, sender)
+func (epf *ElrondProxyFacade) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
+	return epf.txProc.GetTransactionStatus(ctx, txHash, sender)
 }
 
 // GetTransaction should return a transaction by hash
-func (epf *ElrondProxyFacade) GetTransaction(txHash string, withResults bool) (*data.FullTransaction, error) {
-	return epf.txProc.GetTransaction(txHash, withResults)
+func (epf *ElrondProxyFacade) GetTransaction(ctx context.Context, txHash string, withResults bool) (*data.FullTransaction, error) {
+	return epf.txProc.GetTransaction(ctx, txHash, withResults)
 }
 
 // GetTransactionByHashAndSenderAddress should return a transaction by hash and sender address
-func (epf *ElrondProxyFacade) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error) {
-	return epf.txProc.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
+func (epf *ElrondProxyFacade) GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error) {
+	return epf.txProc.GetTransactionByHashAndSenderAddress(ctx, txHash, sndAddr, withEvents)
 }
 
 type networkConfig struct {
@@ -162,18 +163,18 @@ func (epf *ElrondProxyFacade) IsFaucetEnabled() bool {
 }
 
 // SendUserFunds should send a transaction to load one user's account with extra funds from an account in the pem file
-func (epf *ElrondProxyFacade) SendUserFunds(receiver string, value *big.Int) error {
+func (epf *ElrondProxyFacade) SendUserFunds(ctx context.Context, receiver string, value *big.Int) error {
 	senderSk, senderPk, err := epf.faucetProc.SenderDetailsFromPem(receiver)
 	if err != nil {
 		return err
 	}
 
-	senderAccount, err := epf.accountProc.GetAccount(senderPk)
+	senderAccount, err := epf.accountProc.GetAccount(ctx, senderPk)
 	if err != nil {
 		return err
 	}
 
-	networkConfig, err := epf.getNetworkConfig()
+	networkConfig, err := epf.getNetworkConfig(ctx)
 	if err != nil {
 		return err
 	}
@@ -191,12 +192,12 @@ func (epf *ElrondProxyFacade) SendUserFunds(receiver string, value *big.Int) err
 		return err
 	}
 
-	_, _, err = epf.txProc.SendTransaction(tx)
+	_, _, err = epf.txProc.SendTransaction(ctx, tx)
 	return err
 }
 
-func (epf *ElrondProxyFacade) getNetworkConfig() (*networkConfig, error) {
-	netConfig, err := epf.nodeStatusProc.GetNetworkConfigMetrics()
+func (epf *ElrondProxyFacade) getNetworkConfig(ctx context.Context) (*networkConfig, error) {
+	netConfig, err := epf.nodeStatusProc.GetNetworkConfigMetrics(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -223,58 +224,68 @@ func (epf *ElrondProxyFacade) getNetworkConfig() (*networkConfig, error) {
 }
 
 // ExecuteSCQuery retrieves data from existing SC trie through the use of a VM
-func (epf *ElrondProxyFacade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
-	return epf.scQueryService.ExecuteQuery(query)
+func (epf *ElrondProxyFacade) ExecuteSCQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, error) {
+	return epf.scQueryService.ExecuteQuery(ctx, query)
 }
 
 // GetHeartbeatData retrieves the heartbeat status from one observer
-func (epf *ElrondProxyFacade) GetHeartbeatData() (*data.HeartbeatResponse, error) {
-	return epf.heartbeatProc.GetHeartbeatData()
+func (epf *ElrondProxyFacade) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
+	return epf.heartbeatProc.GetHeartbeatData(ctx)
+}
+
+// GetNodesHealth retrieves the health state of the observers and full history nodes
+func (epf *ElrondProxyFacade) GetNodesHealth() (*data.NodesHealthResponse, error) {
+	return epf.nodeStatusProc.GetNodesHealth()
+}
+
+// GetApiKeysUsage retrieves the usage counters of the API keys
+func (epf *ElrondProxyFacade) GetApiKeysUsage() *data.ApiKeysUsageResponse {
+	return epf.nodeStatusProc.GetApiKeysUsage()
+}
+
+// GetResponsesCacheStats retrieves the usage counters of the cache holding the blocks and the hyperblocks
+func (epf *ElrondProxyFacade) GetResponsesCacheStats() data.ResponsesCacheStats {
+	return epf.blockProc.GetResponsesCacheStats()
 }
 
 // GetNetworkConfigMetrics retrieves the node's configuration's metrics
-func (epf *ElrondProxyFacade) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetNetworkConfigMetrics()
+func (epf *ElrondProxyFacade) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetNetworkConfigMetrics(ctx)
 }
 
 // GetNetworkStatusMetrics retrieves the node's network metrics for a given shard
-func (epf *ElrondProxyFacade) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetNetworkStatusMetrics(shardID)
+func (epf *ElrondProxyFacade) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetNetworkStatusMetrics(ctx, shardID)
 }
 
 // GetNetworkStatusMetrics retrieves the node's network metrics for a given shard
-func (epf *ElrondProxyFacade) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
-	return epf.nodeStatusProc.GetEconomicsDataMetrics()
+func (epf *ElrondProxyFacade) GetEconomicsDataMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	return epf.nodeStatusProc.GetEconomicsDataMetrics(ctx)
 }
 
 // GetBlockByHash retrieves the block by hash for a given shard
-func (epf *ElrondProxyFacade) GetBlockByHash(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error) {
-	return epf.blockProc.GetBlockByHash(shardID, hash, withTxs)
+func (epf *ElrondProxyFacade) GetBlockByHash(ctx context.Context, shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error) {
+	return epf.blockProc.GetBlockByHash(ctx, shardID, hash, withTxs)
 }
 
 // GetBlockByNonce retrieves the block by nonce for a given shard
-func (epf *ElrondProxyFacade) GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
-	return epf.blockProc.GetBlockByNonce(shardID, nonce, withTxs)
+func (epf *ElrondProxyFacade) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
+	return epf.blockProc.GetBlockByNonce(ctx, shardID, nonce, withTxs)
 }
 
 // GetHyperBlockByHash retrieves the hyperblock by hash
-func (epf *ElrondProxyFacade) GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error) {
-	return epf.blockProc.GetHyperBlockByHash(hash)
+func (epf *ElrondProxyFacade) GetHyperBlockByHash(ctx context.Context, hash string) (*data.HyperblockApiResponse, error) {
+	return epf.blockProc.GetHyperBlockByHash(ctx, hash)
 }
 
 // GetHyperBlockByNonce retrieves the block by nonce
-func (epf *ElrondProxyFacade) GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error) {
-	return epf.blockProc.GetHyperBlockByNonce(nonce)
+func (epf *ElrondProxyFacade) GetHyperBlockByNonce(ctx context.Context, nonce uint64) (*data.HyperblockApiResponse, error) {
+	return epf.blockProc.GetHyperBlockByNonce(ctx, nonce)
 }
 
 // ValidatorStatistics will return the statistics from an observer
-func (epf *ElrondProxyFacade) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
-	valStats, err := epf.valStatsProc.GetValidatorStatistics()
-	if err != nil {
-		return nil, err
-	}
-
-	return valStats.Statistics, nil
+func (epf *ElrondProxyFacade) ValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
+	return epf.valStatsProc.GetValidatorStatistics(ctx)
 }
 
 // GetAtlasBlockByShardIDAndNonce returns block by shardID and nonce in a BlockAtlas-friendly-format
@@ -288,8 +299,8 @@ func (epf *ElrondProxyFacade) GetAddressConverter() (core.PubkeyConverter, error
 }
 
 // GetLatestFullySynchronizedHyperblockNonce returns the latest fully synchronized hyperblock nonce
-func (epf *ElrondProxyFacade) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
-	return epf.nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
+func (epf *ElrondProxyFacade) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error) {
+	return epf.nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce(ctx)
 }
 
 // ComputeTransactionHash will compute hash of a given transaction
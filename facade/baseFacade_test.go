@@ -1,6 +1,7 @@
 package facade_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
@@ -191,7 +192,7 @@ func TestElrondProxyFacade_GetAccount(t *testing.T) {
 		publicKeyConverter,
 	)
 
-	_, _ = epf.GetAccount("")
+	_, _ = epf.GetAccount(context.Background(), "")
 
 	assert.True(t, wasCalled)
 }
@@ -218,7 +219,7 @@ func TestElrondProxyFacade_SendTransaction(t *testing.T) {
 		publicKeyConverter,
 	)
 
-	_, _, _ = epf.SendTransaction(&data.Transaction{})
+	_, _, _ = epf.SendTransaction(context.Background(), &data.Transaction{})
 
 	assert.True(t, wasCalled)
 }
@@ -244,7 +245,7 @@ func TestElrondProxyFacade_SimulateTransaction(t *testing.T) {
 		publicKeyConverter,
 	)
 
-	_, _ = epf.SimulateTransaction(&data.Transaction{})
+	_, _ = epf.SimulateTransaction(context.Background(), &data.Transaction{})
 
 	assert.True(t, wasCalled)
 }
@@ -294,7 +295,7 @@ func TestElrondProxyFacade_SendUserFunds(t *testing.T) {
 		publicKeyConverter,
 	)
 
-	_ = epf.SendUserFunds("", big.NewInt(0))
+	_ = epf.SendUserFunds(context.Background(), "", big.NewInt(0))
 
 	assert.True(t, wasCalled)
 }
@@ -320,7 +321,7 @@ func TestElrondProxyFacade_GetDataValue(t *testing.T) {
 		publicKeyConverter,
 	)
 
-	_, _ = epf.ExecuteSCQuery(nil)
+	_, _ = epf.ExecuteSCQuery(context.Background(), nil)
 
 	assert.True(t, wasCalled)
 }
@@ -352,7 +353,7 @@ func TestElrondProxyFacade_GetHeartbeatData(t *testing.T) {
 		publicKeyConverter,
 	)
 
-	actualResult, _ := epf.GetHeartbeatData()
+	actualResult, _ := epf.GetHeartbeatData(context.Background())
 
 	assert.Equal(t, expectedResults, actualResult)
 }
@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/crypto"
@@ -10,56 +11,59 @@ import (
 
 // AccountProcessor defines what an account request processor should do
 type AccountProcessor interface {
-	GetAccount(address string) (*data.Account, error)
+	GetAccount(ctx context.Context, address string) (*data.Account, error)
 	GetShardIDForAddress(address string) (uint32, error)
-	GetValueForKey(address string, key string) (string, error)
+	GetValueForKey(ctx context.Context, address string, key string) (string, error)
 	GetTransactions(address string) ([]data.DatabaseTransaction, error)
-	GetAllESDTTokens(address string) (*data.GenericAPIResponse, error)
-	GetESDTTokenData(address string, key string) (*data.GenericAPIResponse, error)
+	GetAllESDTTokens(ctx context.Context, address string) (*data.GenericAPIResponse, error)
+	GetESDTTokenData(ctx context.Context, address string, key string) (*data.GenericAPIResponse, error)
 }
 
 // TransactionProcessor defines what a transaction request processor should do
 type TransactionProcessor interface {
-	SendTransaction(tx *data.Transaction) (int, string, error)
-	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
-	SimulateTransaction(tx *data.Transaction) (*data.GenericAPIResponse, error)
-	TransactionCostRequest(tx *data.Transaction) (string, error)
-	GetTransactionStatus(txHash string, sender string) (string, error)
-	GetTransaction(txHash string, withEvents bool) (*data.FullTransaction, error)
-	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error)
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error)
+	SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	SimulateTransaction(ctx context.Context, tx *data.Transaction) (*data.GenericAPIResponse, error)
+	TransactionCostRequest(ctx context.Context, tx *data.Transaction) (string, error)
+	GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error)
+	GetTransaction(ctx context.Context, txHash string, withEvents bool) (*data.FullTransaction, error)
+	GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error)
 	ComputeTransactionHash(tx *data.Transaction) (string, error)
 }
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, error)
 }
 
 // HeartbeatProcessor defines what a heartbeat processor should do
 type HeartbeatProcessor interface {
-	GetHeartbeatData() (*data.HeartbeatResponse, error)
+	GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error)
 }
 
 // ValidatorStatisticsProcessor defines what a validator statistics processor should do
 type ValidatorStatisticsProcessor interface {
-	GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error)
+	GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error)
 }
 
 // NodeStatusProcessor defines what a node status processor should do
 type NodeStatusProcessor interface {
-	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
-	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
-	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
-	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error)
+	GetEconomicsDataMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error)
+	GetNodesHealth() (*data.NodesHealthResponse, error)
+	GetApiKeysUsage() *data.ApiKeysUsageResponse
 }
 
 // BlockProcessor defines what a block processor should do
 type BlockProcessor interface {
 	GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error)
-	GetBlockByHash(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error)
-	GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
-	GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error)
-	GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error)
+	GetBlockByHash(ctx context.Context, shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
+	GetHyperBlockByHash(ctx context.Context, hash string) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64) (*data.HyperblockApiResponse, error)
+	GetResponsesCacheStats() data.ResponsesCacheStats
 }
 
 // FaucetProcessor defines what a component which will handle faucets should do
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
 
@@ -10,28 +12,28 @@ type AccountProcessorStub struct {
 	GetValueForKeyCalled       func(address string, key string) (string, error)
 	GetShardIDForAddressCalled func(address string) (uint32, error)
 	GetTransactionsCalled      func(address string) ([]data.DatabaseTransaction, error)
-	ValidatorStatisticsCalled  func() (map[string]*data.ValidatorApiResponse, error)
+	ValidatorStatisticsCalled  func() (*data.ValidatorStatisticsResponse, error)
 	GetAllESDTTokensCalled     func(address string) (*data.GenericAPIResponse, error)
 	GetESDTTokenDataCalled     func(address string, key string) (*data.GenericAPIResponse, error)
 }
 
 // GetAllESDTTokens -
-func (aps *AccountProcessorStub) GetAllESDTTokens(address string) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetAllESDTTokens(_ context.Context, address string) (*data.GenericAPIResponse, error) {
 	return aps.GetAllESDTTokensCalled(address)
 }
 
 // GetESDTTokenData -
-func (aps *AccountProcessorStub) GetESDTTokenData(address string, key string) (*data.GenericAPIResponse, error) {
+func (aps *AccountProcessorStub) GetESDTTokenData(_ context.Context, address string, key string) (*data.GenericAPIResponse, error) {
 	return aps.GetESDTTokenDataCalled(address, key)
 }
 
 // GetAccount --
-func (aps *AccountProcessorStub) GetAccount(address string) (*data.Account, error) {
+func (aps *AccountProcessorStub) GetAccount(_ context.Context, address string) (*data.Account, error) {
 	return aps.GetAccountCalled(address)
 }
 
 // GetValueForKey --
-func (aps *AccountProcessorStub) GetValueForKey(address string, key string) (string, error) {
+func (aps *AccountProcessorStub) GetValueForKey(_ context.Context, address string, key string) (string, error) {
 	return aps.GetValueForKeyCalled(address, key)
 }
 
@@ -46,6 +48,6 @@ func (aps *AccountProcessorStub) GetTransactions(address string) ([]data.Databas
 }
 
 // ValidatorStatistics --
-func (aps *AccountProcessorStub) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
+func (aps *AccountProcessorStub) ValidatorStatistics(_ context.Context) (*data.ValidatorStatisticsResponse, error) {
 	return aps.ValidatorStatisticsCalled()
 }
@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 // BlockProcessorStub -
 type BlockProcessorStub struct {
@@ -9,23 +13,33 @@ type BlockProcessorStub struct {
 	GetBlockByNonceCalled           func(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
 	GetHyperBlockByHashCalled       func(hash string) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByNonceCalled      func(nonce uint64) (*data.HyperblockApiResponse, error)
+	GetResponsesCacheStatsCalled    func() data.ResponsesCacheStats
 }
 
-func (bps *BlockProcessorStub) GetBlockByHash(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetBlockByHash(_ context.Context, shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error) {
 	return bps.GetBlockByHashCalled(shardID, hash, withTxs)
 }
 
-func (bps *BlockProcessorStub) GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
+func (bps *BlockProcessorStub) GetBlockByNonce(_ context.Context, shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
 	return bps.GetBlockByNonceCalled(shardID, nonce, withTxs)
 }
 
+// GetResponsesCacheStats -
+func (bps *BlockProcessorStub) GetResponsesCacheStats() data.ResponsesCacheStats {
+	if bps.GetResponsesCacheStatsCalled != nil {
+		return bps.GetResponsesCacheStatsCalled()
+	}
+
+	return data.ResponsesCacheStats{}
+}
+
 // GetAtlasBlockByShardIDAndNonce -
 func (bps *BlockProcessorStub) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
 	return bps.GetBlockByShardIDAndNonceCalled(shardID, nonce)
 }
 
 // GetHyperBlockByHash -
-func (bps *BlockProcessorStub) GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error) {
+func (bps *BlockProcessorStub) GetHyperBlockByHash(_ context.Context, hash string) (*data.HyperblockApiResponse, error) {
 	if bps.GetHyperBlockByHashCalled != nil {
 		return bps.GetHyperBlockByHashCalled(hash)
 	}
@@ -34,7 +48,7 @@ func (bps *BlockProcessorStub) GetHyperBlockByHash(hash string) (*data.Hyperbloc
 }
 
 // GetHyperBlockByNonce -
-func (bps *BlockProcessorStub) GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error) {
+func (bps *BlockProcessorStub) GetHyperBlockByNonce(_ context.Context, nonce uint64) (*data.HyperblockApiResponse, error) {
 	if bps.GetHyperBlockByNonceCalled != nil {
 		return bps.GetHyperBlockByNonceCalled(nonce)
 	}
@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 // HeartbeatProcessorStub represents a stub implementation of a HeartbeatProcessor
 type HeartbeatProcessorStub struct {
@@ -8,6 +12,6 @@ type HeartbeatProcessorStub struct {
 }
 
 // GetHeartbeatData will call the handler func
-func (hbps *HeartbeatProcessorStub) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+func (hbps *HeartbeatProcessorStub) GetHeartbeatData(_ context.Context) (*data.HeartbeatResponse, error) {
 	return hbps.GetHeartbeatDataCalled()
 }
@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 // NodeStatusProcessorStub --
 type NodeStatusProcessorStub struct {
@@ -8,24 +12,36 @@ type NodeStatusProcessorStub struct {
 	GetNetworkMetricsCalled       func(shardID uint32) (*data.GenericAPIResponse, error)
 	GetLatestBlockNonceCalled     func() (uint64, error)
 	GetEconomicsDataMetricsCalled func() (*data.GenericAPIResponse, error)
+	GetNodesHealthCalled          func() (*data.NodesHealthResponse, error)
+	GetApiKeysUsageCalled         func() *data.ApiKeysUsageResponse
 }
 
 // GetNetworkConfigMetrics --
-func (nsps *NodeStatusProcessorStub) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (nsps *NodeStatusProcessorStub) GetNetworkConfigMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	return nsps.GetConfigMetricsCalled()
 }
 
 // GetNetworkStatusMetrics --
-func (nsps *NodeStatusProcessorStub) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
+func (nsps *NodeStatusProcessorStub) GetNetworkStatusMetrics(_ context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
 	return nsps.GetNetworkMetricsCalled(shardID)
 }
 
 // GetEconomicsDataMetrics --
-func (nsps *NodeStatusProcessorStub) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
+func (nsps *NodeStatusProcessorStub) GetEconomicsDataMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	return nsps.GetEconomicsDataMetricsCalled()
 }
 
 // GetLatestBlockNonce -
-func (nsps *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+func (nsps *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce(_ context.Context) (uint64, error) {
 	return nsps.GetLatestBlockNonceCalled()
 }
+
+// GetNodesHealth -
+func (nsps *NodeStatusProcessorStub) GetNodesHealth() (*data.NodesHealthResponse, error) {
+	return nsps.GetNodesHealthCalled()
+}
+
+// GetApiKeysUsage -
+func (nsps *NodeStatusProcessorStub) GetApiKeysUsage() *data.ApiKeysUsageResponse {
+	return nsps.GetApiKeysUsageCalled()
+}
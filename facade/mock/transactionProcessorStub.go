@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
@@ -20,17 +21,17 @@ type TransactionProcessorStub struct {
 }
 
 // SimulateTransaction -
-func (tps *TransactionProcessorStub) SimulateTransaction(tx *data.Transaction) (*data.GenericAPIResponse, error) {
+func (tps *TransactionProcessorStub) SimulateTransaction(_ context.Context, tx *data.Transaction) (*data.GenericAPIResponse, error) {
 	return tps.SimulateTransactionCalled(tx)
 }
 
 // SendTransaction -
-func (tps *TransactionProcessorStub) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (tps *TransactionProcessorStub) SendTransaction(_ context.Context, tx *data.Transaction) (int, string, error) {
 	return tps.SendTransactionCalled(tx)
 }
 
 // SendMultipleTransactions -
-func (tps *TransactionProcessorStub) SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
+func (tps *TransactionProcessorStub) SendMultipleTransactions(_ context.Context, txs []*data.Transaction) (data.MultipleTransactionsResponseData, error) {
 	return tps.SendMultipleTransactionsCalled(txs)
 }
 
@@ -40,26 +41,26 @@ func (tps *TransactionProcessorStub) ComputeTransactionHash(tx *data.Transaction
 }
 
 // SendUserFunds -
-func (tps *TransactionProcessorStub) SendUserFunds(receiver string, value *big.Int) error {
+func (tps *TransactionProcessorStub) SendUserFunds(_ context.Context, receiver string, value *big.Int) error {
 	return tps.SendUserFundsCalled(receiver, value)
 }
 
 // GetTransactionStatus -
-func (tps *TransactionProcessorStub) GetTransactionStatus(txHash string, sender string) (string, error) {
+func (tps *TransactionProcessorStub) GetTransactionStatus(_ context.Context, txHash string, sender string) (string, error) {
 	return tps.GetTransactionStatusHandler(txHash, sender)
 }
 
 // GetTransaction -
-func (tps *TransactionProcessorStub) GetTransaction(txHash string, withEvents bool) (*data.FullTransaction, error) {
+func (tps *TransactionProcessorStub) GetTransaction(_ context.Context, txHash string, withEvents bool) (*data.FullTransaction, error) {
 	return tps.GetTransactionCalled(txHash, withEvents)
 }
 
 // GetTransactionByHashAndSenderAddress -
-func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error) {
+func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(_ context.Context, txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error) {
 	return tps.GetTransactionByHashAndSenderAddressCalled(txHash, sndAddr, withEvents)
 }
 
 // TransactionCostRequest --
-func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction) (string, error) {
+func (tps *TransactionProcessorStub) TransactionCostRequest(_ context.Context, tx *data.Transaction) (string, error) {
 	return tps.TransactionCostRequestHandler(tx)
 }
@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 // ValidatorStatisticsProcessorStub -
 type ValidatorStatisticsProcessorStub struct {
@@ -8,6 +12,6 @@ type ValidatorStatisticsProcessorStub struct {
 }
 
 // GetValidatorStatistics -
-func (v *ValidatorStatisticsProcessorStub) GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error) {
+func (v *ValidatorStatisticsProcessorStub) GetValidatorStatistics(_ context.Context) (*data.ValidatorStatisticsResponse, error) {
 	return v.GetValidatorStatisticsCalled()
 }
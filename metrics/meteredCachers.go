@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+)
+
+const (
+	heartbeatsCacheName          = "heartbeats"
+	validatorStatisticsCacheName = "validator_statistics"
+)
+
+type meteredHeartbeatCacher struct {
+	process.HeartbeatCacheHandler
+	metrics process.MetricsHandler
+}
+
+// NewMeteredHeartbeatCacher wraps the given heartbeat cacher, so each read is recorded as a hit or a miss
+func NewMeteredHeartbeatCacher(
+	cacher process.HeartbeatCacheHandler,
+	metrics process.MetricsHandler,
+) (*meteredHeartbeatCacher, error) {
+	if check.IfNil(cacher) {
+		return nil, process.ErrNilHeartbeatCacher
+	}
+	if check.IfNil(metrics) {
+		return nil, process.ErrNilMetricsHandler
+	}
+
+	return &meteredHeartbeatCacher{
+		HeartbeatCacheHandler: cacher,
+		metrics:               metrics,
+	}, nil
+}
+
+// LoadHeartbeats loads the heartbeats from the wrapped cacher and records the read
+func (mhc *meteredHeartbeatCacher) LoadHeartbeats() (*data.HeartbeatResponse, error) {
+	heartbeats, err := mhc.HeartbeatCacheHandler.LoadHeartbeats()
+	mhc.metrics.RecordCacheAccess(heartbeatsCacheName, err == nil)
+
+	return heartbeats, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mhc *meteredHeartbeatCacher) IsInterfaceNil() bool {
+	return mhc == nil
+}
+
+type meteredValidatorStatsCacher struct {
+	process.ValidatorStatisticsCacheHandler
+	metrics process.MetricsHandler
+}
+
+// NewMeteredValidatorStatsCacher wraps the given validator statistics cacher, so each read is recorded as a hit or
+// a miss
+func NewMeteredValidatorStatsCacher(
+	cacher process.ValidatorStatisticsCacheHandler,
+	metrics process.MetricsHandler,
+) (*meteredValidatorStatsCacher, error) {
+	if check.IfNil(cacher) {
+		return nil, process.ErrNilValidatorStatisticsCacher
+	}
+	if check.IfNil(metrics) {
+		return nil, process.ErrNilMetricsHandler
+	}
+
+	return &meteredValidatorStatsCacher{
+		ValidatorStatisticsCacheHandler: cacher,
+		metrics:                         metrics,
+	}, nil
+}
+
+// LoadValStats loads the validator statistics from the wrapped cacher and records the read
+func (mvsc *meteredValidatorStatsCacher) LoadValStats() (*data.ValidatorStatisticsResponse, error) {
+	valStats, err := mvsc.ValidatorStatisticsCacheHandler.LoadValStats()
+	mvsc.metrics.RecordCacheAccess(validatorStatisticsCacheName, err == nil)
+
+	return valStats, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mvsc *meteredValidatorStatsCacher) IsInterfaceNil() bool {
+	return mvsc == nil
+}
@@ -0,0 +1,75 @@
+package metrics_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/metrics"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMeteredHeartbeatCacher_NilArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	mhc, err := metrics.NewMeteredHeartbeatCacher(nil, &mock.MetricsHandlerStub{})
+	assert.Nil(t, mhc)
+	assert.Equal(t, process.ErrNilHeartbeatCacher, err)
+
+	mhc, err = metrics.NewMeteredHeartbeatCacher(&mock.HeartbeatCacherMock{}, nil)
+	assert.Nil(t, mhc)
+	assert.Equal(t, process.ErrNilMetricsHandler, err)
+}
+
+func TestMeteredHeartbeatCacher_LoadHeartbeatsShouldRecordHitsAndMisses(t *testing.T) {
+	t.Parallel()
+
+	accesses := make([]bool, 0)
+	metricsHandler := &mock.MetricsHandlerStub{
+		RecordCacheAccessCalled: func(cacheName string, isHit bool) {
+			assert.Equal(t, "heartbeats", cacheName)
+			accesses = append(accesses, isHit)
+		},
+	}
+	cacher := &mock.HeartbeatCacherMock{}
+	mhc, err := metrics.NewMeteredHeartbeatCacher(cacher, metricsHandler)
+	require.Nil(t, err)
+
+	_, err = mhc.LoadHeartbeats()
+	assert.NotNil(t, err)
+
+	heartbeats := &data.HeartbeatResponse{Heartbeats: []data.PubKeyHeartbeat{{NodeDisplayName: "node"}}}
+	err = mhc.StoreHeartbeats(heartbeats)
+	require.Nil(t, err)
+
+	loadedHeartbeats, err := mhc.LoadHeartbeats()
+	assert.Nil(t, err)
+	assert.Equal(t, heartbeats, loadedHeartbeats)
+	assert.Equal(t, []bool{false, true}, accesses)
+}
+
+func TestMeteredValidatorStatsCacher_LoadValStatsShouldRecordHitsAndMisses(t *testing.T) {
+	t.Parallel()
+
+	accesses := make([]bool, 0)
+	metricsHandler := &mock.MetricsHandlerStub{
+		RecordCacheAccessCalled: func(cacheName string, isHit bool) {
+			assert.Equal(t, "validator_statistics", cacheName)
+			accesses = append(accesses, isHit)
+		},
+	}
+	mvsc, err := metrics.NewMeteredValidatorStatsCacher(&mock.ValStatsCacherMock{}, metricsHandler)
+	require.Nil(t, err)
+
+	_, err = mvsc.LoadValStats()
+	assert.NotNil(t, err)
+
+	err = mvsc.StoreValStats(map[string]*data.ValidatorApiResponse{"pubkey": {NumLeaderSuccess: 1}})
+	require.Nil(t, err)
+
+	_, err = mvsc.LoadValStats()
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{false, true}, accesses)
+}
@@ -0,0 +1,169 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// defaultDurationBuckets holds the upper bounds, in seconds, of the buckets of the durations histograms
+var defaultDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+type series struct {
+	labelValues  []string
+	value        float64
+	bucketCounts []uint64
+	count        uint64
+}
+
+// metricVec holds the series of a metric, one for each combination of label values. A metric is either a counter, a
+// gauge or a histogram
+type metricVec struct {
+	name       string
+	help       string
+	metricType string
+	labelNames []string
+	buckets    []float64
+	mutSeries  sync.Mutex
+	series     map[string]*series
+}
+
+func newCounterVec(name string, help string, labelNames ...string) *metricVec {
+	return newMetricVec(name, help, "counter", labelNames, nil)
+}
+
+func newGaugeVec(name string, help string, labelNames ...string) *metricVec {
+	return newMetricVec(name, help, "gauge", labelNames, nil)
+}
+
+func newHistogramVec(name string, help string, buckets []float64, labelNames ...string) *metricVec {
+	return newMetricVec(name, help, "histogram", labelNames, buckets)
+}
+
+func newMetricVec(name string, help string, metricType string, labelNames []string, buckets []float64) *metricVec {
+	return &metricVec{
+		name:       name,
+		help:       help,
+		metricType: metricType,
+		labelNames: labelNames,
+		buckets:    buckets,
+		series:     make(map[string]*series),
+	}
+}
+
+// getSeries should be called under mutex protection
+func (mv *metricVec) getSeries(labelValues []string) *series {
+	key := strings.Join(labelValues, "\xff")
+	s, found := mv.series[key]
+	if !found {
+		s = &series{
+			labelValues:  labelValues,
+			bucketCounts: make([]uint64, len(mv.buckets)),
+		}
+		mv.series[key] = s
+	}
+
+	return s
+}
+
+// add adds the given value to the counter having the given label values
+func (mv *metricVec) add(value float64, labelValues ...string) {
+	mv.mutSeries.Lock()
+	mv.getSeries(labelValues).value += value
+	mv.mutSeries.Unlock()
+}
+
+// set sets the value of the gauge having the given label values
+func (mv *metricVec) set(value float64, labelValues ...string) {
+	mv.mutSeries.Lock()
+	mv.getSeries(labelValues).value = value
+	mv.mutSeries.Unlock()
+}
+
+// observe records the given value in the histogram having the given label values
+func (mv *metricVec) observe(value float64, labelValues ...string) {
+	mv.mutSeries.Lock()
+	defer mv.mutSeries.Unlock()
+
+	s := mv.getSeries(labelValues)
+	s.value += value
+	s.count++
+	for i, upperBound := range mv.buckets {
+		if value <= upperBound {
+			s.bucketCounts[i]++
+		}
+	}
+}
+
+// get returns the value of the counter or gauge having the given label values
+func (mv *metricVec) get(labelValues ...string) float64 {
+	mv.mutSeries.Lock()
+	defer mv.mutSeries.Unlock()
+
+	s, found := mv.series[strings.Join(labelValues, "\xff")]
+	if !found {
+		return 0
+	}
+
+	return s.value
+}
+
+// writeTo writes the metric in the Prometheus text exposition format, with its series sorted by label values
+func (mv *metricVec) writeTo(w io.Writer) {
+	mv.mutSeries.Lock()
+	defer mv.mutSeries.Unlock()
+
+	keys := make([]string, 0, len(mv.series))
+	for key := range mv.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	_, _ = fmt.Fprintf(w, "# HELP %s %s\n", mv.name, mv.help)
+	_, _ = fmt.Fprintf(w, "# TYPE %s %s\n", mv.name, mv.metricType)
+	for _, key := range keys {
+		s := mv.series[key]
+		if mv.metricType != "histogram" {
+			_, _ = fmt.Fprintf(w, "%s%s %s\n", mv.name, mv.formatLabels(s.labelValues, ""), formatValue(s.value))
+			continue
+		}
+
+		// the buckets are already cumulative, as each value is counted in all the buckets it fits in
+		for i, upperBound := range mv.buckets {
+			labels := mv.formatLabels(s.labelValues, formatValue(upperBound))
+			_, _ = fmt.Fprintf(w, "%s_bucket%s %d\n", mv.name, labels, s.bucketCounts[i])
+		}
+		_, _ = fmt.Fprintf(w, "%s_bucket%s %d\n", mv.name, mv.formatLabels(s.labelValues, "+Inf"), s.count)
+		_, _ = fmt.Fprintf(w, "%s_sum%s %s\n", mv.name, mv.formatLabels(s.labelValues, ""), formatValue(s.value))
+		_, _ = fmt.Fprintf(w, "%s_count%s %d\n", mv.name, mv.formatLabels(s.labelValues, ""), s.count)
+	}
+}
+
+func (mv *metricVec) formatLabels(labelValues []string, upperBound string) string {
+	labels := make([]string, 0, len(labelValues)+1)
+	for i, labelValue := range labelValues {
+		labels = append(labels, fmt.Sprintf(`%s="%s"`, mv.labelNames[i], labelValueEscaper.Replace(labelValue)))
+	}
+	if len(upperBound) > 0 {
+		labels = append(labels, fmt.Sprintf(`le="%s"`, upperBound))
+	}
+	if len(labels) == 0 {
+		return ""
+	}
+
+	return "{" + strings.Join(labels, ",") + "}"
+}
+
+func formatValue(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
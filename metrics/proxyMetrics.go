@@ -0,0 +1,133 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	metricsPrefix   = "elrond_proxy_"
+	unmatchedRoute  = "unmatched"
+	unknownEndpoint = "unknown"
+)
+
+// ProxyMetrics collects the metrics of the REST API requests, of the requests sent to the nodes, of the heartbeat and
+// validator statistics caches and of the observers health, and exposes them in the Prometheus text format
+type ProxyMetrics struct {
+	apiRequests              *metricVec
+	apiRequestsDuration      *metricVec
+	upstreamRequests         *metricVec
+	upstreamRequestsDuration *metricVec
+	cacheRequests            *metricVec
+	cacheHitRatio            *metricVec
+	healthyObservers         *metricVec
+}
+
+// NewProxyMetrics creates a new instance of ProxyMetrics
+func NewProxyMetrics() *ProxyMetrics {
+	return &ProxyMetrics{
+		apiRequests: newCounterVec(
+			metricsPrefix+"api_requests_total",
+			"Number of REST API requests, by route, method and status code",
+			"route", "method", "status"),
+		apiRequestsDuration: newHistogramVec(
+			metricsPrefix+"api_request_duration_seconds",
+			"Duration of the REST API requests, by route and method",
+			defaultDurationBuckets,
+			"route", "method"),
+		upstreamRequests: newCounterVec(
+			metricsPrefix+"upstream_requests_total",
+			"Number of requests sent to the nodes, by node address, endpoint and status code or error",
+			"address", "endpoint", "status"),
+		upstreamRequestsDuration: newHistogramVec(
+			metricsPrefix+"upstream_request_duration_seconds",
+			"Duration of the requests sent to the nodes, by node address and endpoint",
+			defaultDurationBuckets,
+			"address", "endpoint"),
+		cacheRequests: newCounterVec(
+			metricsPrefix+"cache_requests_total",
+			"Number of reads of the heartbeat and validator statistics caches, by cache and result (hit or miss)",
+			"cache", "result"),
+		cacheHitRatio: newGaugeVec(
+			metricsPrefix+"cache_hit_ratio",
+			"Ratio of the reads of the heartbeat and validator statistics caches which were hits",
+			"cache"),
+		healthyObservers: newGaugeVec(
+			metricsPrefix+"healthy_observers",
+			"Number of observers of each shard which receive requests, as found by the last health check",
+			"shard"),
+	}
+}
+
+// RecordApiRequest records a REST API request. The route is the path pattern of the request's route (for example
+// /v1.0/address/:address), so the requests of the same endpoint share their series
+func (pm *ProxyMetrics) RecordApiRequest(route string, method string, statusCode int, duration time.Duration) {
+	if len(route) == 0 {
+		route = unmatchedRoute
+	}
+
+	pm.apiRequests.add(1, route, method, strconv.Itoa(statusCode))
+	pm.apiRequestsDuration.observe(duration.Seconds(), route, method)
+}
+
+// RecordUpstreamRequest records a request sent to a node. The endpoint is the fixed name given by the request's sender,
+// such as the route template of the REST API request served (for example /v1.0/address/:address), never the concrete
+// path, so the number of series stays bounded. The status is either the response's status code or the kind of error
+// which prevented getting a response
+func (pm *ProxyMetrics) RecordUpstreamRequest(address string, endpoint string, status string, duration time.Duration) {
+	if len(endpoint) == 0 {
+		endpoint = unknownEndpoint
+	}
+
+	pm.upstreamRequests.add(1, address, endpoint, status)
+	pm.upstreamRequestsDuration.observe(duration.Seconds(), address, endpoint)
+}
+
+// RecordCacheAccess records a read of the given cache
+func (pm *ProxyMetrics) RecordCacheAccess(cacheName string, isHit bool) {
+	result := "miss"
+	if isHit {
+		result = "hit"
+	}
+	pm.cacheRequests.add(1, cacheName, result)
+
+	hits := pm.cacheRequests.get(cacheName, "hit")
+	misses := pm.cacheRequests.get(cacheName, "miss")
+	pm.cacheHitRatio.set(hits/(hits+misses), cacheName)
+}
+
+// SetHealthyObservers sets the number of observers of the given shard which receive requests
+func (pm *ProxyMetrics) SetHealthyObservers(shardID uint32, numHealthy int) {
+	pm.healthyObservers.set(float64(numHealthy), strconv.FormatUint(uint64(shardID), 10))
+}
+
+// ServeHTTP writes all the metrics in the Prometheus text format
+func (pm *ProxyMetrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	buff := &bytes.Buffer{}
+	for _, metric := range pm.allMetrics() {
+		metric.writeTo(buff)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buff.Bytes())
+}
+
+func (pm *ProxyMetrics) allMetrics() []*metricVec {
+	return []*metricVec{
+		pm.apiRequests,
+		pm.apiRequestsDuration,
+		pm.upstreamRequests,
+		pm.upstreamRequestsDuration,
+		pm.cacheRequests,
+		pm.cacheHitRatio,
+		pm.healthyObservers,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pm *ProxyMetrics) IsInterfaceNil() bool {
+	return pm == nil
+}
@@ -0,0 +1,85 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func scrapeMetrics(pm *metrics.ProxyMetrics) string {
+	req, _ := http.NewRequest(http.MethodGet, "/metrics", nil)
+	resp := httptest.NewRecorder()
+	pm.ServeHTTP(resp, req)
+
+	return resp.Body.String()
+}
+
+func assertContainsLines(t *testing.T, text string, lines ...string) {
+	for _, line := range lines {
+		assert.True(t, strings.Contains(text, line+"\n"), "missing line: "+line)
+	}
+}
+
+func TestProxyMetrics_ApiRequests(t *testing.T) {
+	t.Parallel()
+
+	pm := metrics.NewProxyMetrics()
+	pm.RecordApiRequest("/v1.0/address/:address", http.MethodGet, http.StatusOK, 20*time.Millisecond)
+	pm.RecordApiRequest("/v1.0/address/:address", http.MethodGet, http.StatusOK, 200*time.Millisecond)
+	pm.RecordApiRequest("", http.MethodGet, http.StatusNotFound, time.Millisecond)
+
+	assertContainsLines(t, scrapeMetrics(pm),
+		"# TYPE elrond_proxy_api_requests_total counter",
+		`elrond_proxy_api_requests_total{route="/v1.0/address/:address",method="GET",status="200"} 2`,
+		`elrond_proxy_api_requests_total{route="unmatched",method="GET",status="404"} 1`,
+		"# TYPE elrond_proxy_api_request_duration_seconds histogram",
+		`elrond_proxy_api_request_duration_seconds_bucket{route="/v1.0/address/:address",method="GET",le="0.01"} 0`,
+		`elrond_proxy_api_request_duration_seconds_bucket{route="/v1.0/address/:address",method="GET",le="0.025"} 1`,
+		`elrond_proxy_api_request_duration_seconds_bucket{route="/v1.0/address/:address",method="GET",le="0.25"} 2`,
+		`elrond_proxy_api_request_duration_seconds_bucket{route="/v1.0/address/:address",method="GET",le="+Inf"} 2`,
+		`elrond_proxy_api_request_duration_seconds_sum{route="/v1.0/address/:address",method="GET"} 0.22`,
+		`elrond_proxy_api_request_duration_seconds_count{route="/v1.0/address/:address",method="GET"} 2`,
+	)
+}
+
+func TestProxyMetrics_UpstreamRequestsShouldBeLabeledByEndpoint(t *testing.T) {
+	t.Parallel()
+
+	pm := metrics.NewProxyMetrics()
+	pm.RecordUpstreamRequest("http://obs0:8080", "/v1.0/address/:address/balance", "200", time.Millisecond)
+	pm.RecordUpstreamRequest("http://obs0:8080", "/v1.0/address/:address/balance", "200", time.Millisecond)
+	pm.RecordUpstreamRequest("http://obs0:8080", "", "timeout", time.Second)
+
+	assertContainsLines(t, scrapeMetrics(pm),
+		`elrond_proxy_upstream_requests_total{address="http://obs0:8080",endpoint="/v1.0/address/:address/balance",status="200"} 2`,
+		`elrond_proxy_upstream_requests_total{address="http://obs0:8080",endpoint="unknown",status="timeout"} 1`,
+		`elrond_proxy_upstream_request_duration_seconds_count{address="http://obs0:8080",endpoint="unknown"} 1`,
+	)
+}
+
+func TestProxyMetrics_CacheHitRatioAndHealthyObservers(t *testing.T) {
+	t.Parallel()
+
+	pm := metrics.NewProxyMetrics()
+	pm.RecordCacheAccess("heartbeats", true)
+	pm.RecordCacheAccess("heartbeats", true)
+	pm.RecordCacheAccess("heartbeats", true)
+	pm.RecordCacheAccess("heartbeats", false)
+	pm.SetHealthyObservers(0, 2)
+	pm.SetHealthyObservers(4294967295, 1)
+	pm.SetHealthyObservers(0, 1)
+
+	assertContainsLines(t, scrapeMetrics(pm),
+		`elrond_proxy_cache_requests_total{cache="heartbeats",result="hit"} 3`,
+		`elrond_proxy_cache_requests_total{cache="heartbeats",result="miss"} 1`,
+		"# TYPE elrond_proxy_cache_hit_ratio gauge",
+		`elrond_proxy_cache_hit_ratio{cache="heartbeats"} 0.75`,
+		`elrond_proxy_healthy_observers{shard="0"} 1`,
+		`elrond_proxy_healthy_observers{shard="4294967295"} 1`,
+	)
+}
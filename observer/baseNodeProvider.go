@@ -1,6 +1,7 @@
 package observer
 
 import (
+	"fmt"
 	"sort"
 	"sync"
 
@@ -8,9 +9,12 @@ import (
 )
 
 type baseNodeProvider struct {
-	mutNodes sync.RWMutex
-	nodes    map[uint32][]*data.NodeData
-	allNodes []*data.NodeData
+	mutNodes        sync.RWMutex
+	nodes           map[uint32][]*data.NodeData
+	allNodes        []*data.NodeData
+	unhealthyNodes  map[string]struct{}
+	healthyNodes    map[uint32][]*data.NodeData
+	allHealthyNodes []*data.NodeData
 }
 
 func (bop *baseNodeProvider) initNodesMaps(nodes []*data.NodeData) error {
@@ -27,11 +31,60 @@ func (bop *baseNodeProvider) initNodesMaps(nodes []*data.NodeData) error {
 	bop.mutNodes.Lock()
 	bop.nodes = newNodes
 	bop.allNodes = initAllNodesSlice(newNodes)
+	bop.computeHealthyNodes()
 	bop.mutNodes.Unlock()
 
 	return nil
 }
 
+// ReloadNodes will atomically replace the current nodes with the provided ones. The nodes marked as unhealthy
+// will remain excluded, if still present in the new list. On error, the current nodes are kept
+func (bop *baseNodeProvider) ReloadNodes(nodes []*data.NodeData) error {
+	return bop.initNodesMaps(nodes)
+}
+
+// GetAllConfiguredNodes will return all the nodes, including the ones which are currently marked as unhealthy
+func (bop *baseNodeProvider) GetAllConfiguredNodes() ([]*data.NodeData, error) {
+	bop.mutNodes.RLock()
+	defer bop.mutNodes.RUnlock()
+
+	return bop.allNodes, nil
+}
+
+// UpdateNodesHealth will replace the set of unhealthy nodes. The nodes with the given addresses won't be returned anymore
+// until a new update re-admits them
+func (bop *baseNodeProvider) UpdateNodesHealth(unhealthyNodes map[string]struct{}) {
+	bop.mutNodes.Lock()
+	bop.unhealthyNodes = unhealthyNodes
+	bop.computeHealthyNodes()
+	bop.mutNodes.Unlock()
+}
+
+// computeHealthyNodes should be called under mutex protection
+func (bop *baseNodeProvider) computeHealthyNodes() {
+	healthyNodes := make(map[uint32][]*data.NodeData)
+	for shardID, nodesInShard := range bop.nodes {
+		healthyNodesInShard := make([]*data.NodeData, 0, len(nodesInShard))
+		for _, node := range nodesInShard {
+			_, isUnhealthy := bop.unhealthyNodes[node.Address]
+			if !isUnhealthy {
+				healthyNodesInShard = append(healthyNodesInShard, node)
+			}
+		}
+
+		if len(healthyNodesInShard) == 0 {
+			// all the nodes in this shard are unhealthy: it is better to keep trying them than to fail right away
+			log.Warn("no healthy node available, will use all the nodes in shard", "shard ID", shardID)
+			healthyNodesInShard = nodesInShard
+		}
+
+		healthyNodes[shardID] = healthyNodesInShard
+	}
+
+	bop.healthyNodes = healthyNodes
+	bop.allHealthyNodes = initAllNodesSlice(healthyNodes)
+}
+
 func initAllNodesSlice(nodesOnShards map[uint32][]*data.NodeData) []*data.NodeData {
 	sliceToReturn := make([]*data.NodeData, 0)
 	shardIDs := getSortedSliceIDsSlice(nodesOnShards)
@@ -66,3 +119,42 @@ func getSortedSliceIDsSlice(nodesOnShards map[uint32][]*data.NodeData) []uint32
 
 	return shardIDs
 }
+
+// getNodesByShardIdWithTags returns the nodes selected by the given function which have all the required tags
+func getNodesByShardIdWithTags(
+	shardId uint32,
+	key string,
+	requiredTags []string,
+	getNodesByShardIdForKey func(shardId uint32, key string) ([]*data.NodeData, error),
+) ([]*data.NodeData, error) {
+	nodes, err := getNodesByShardIdForKey(shardId, key)
+	return filterNodesByTags(nodes, err, requiredTags)
+}
+
+// getAllNodesWithTags returns the nodes selected by the given function which have all the required tags
+func getAllNodesWithTags(
+	requiredTags []string,
+	getAllNodes func() ([]*data.NodeData, error),
+) ([]*data.NodeData, error) {
+	nodes, err := getAllNodes()
+	return filterNodesByTags(nodes, err, requiredTags)
+}
+
+// filterNodesByTags returns the given nodes which have all the required tags, keeping their order
+func filterNodesByTags(nodes []*data.NodeData, err error, requiredTags []string) ([]*data.NodeData, error) {
+	if err != nil || len(requiredTags) == 0 {
+		return nodes, err
+	}
+
+	filteredNodes := make([]*data.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if node.HasTags(requiredTags) {
+			filteredNodes = append(filteredNodes, node)
+		}
+	}
+	if len(filteredNodes) == 0 {
+		return nil, fmt.Errorf("%w %v", ErrNoNodeWithRequiredTags, requiredTags)
+	}
+
+	return filteredNodes, nil
+}
@@ -1,6 +1,7 @@
 package observer
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -160,3 +161,126 @@ func TestInitAllNodesSlice_SingleShardShouldWork(t *testing.T) {
 		assert.Equal(t, expectedOrder[i], r.Address)
 	}
 }
+
+func TestBaseNodeProvider_UpdateNodesHealthShouldFilterUnhealthyNodes(t *testing.T) {
+	t.Parallel()
+
+	bnp := &baseNodeProvider{}
+	_ = bnp.initNodesMaps([]*data.NodeData{
+		{Address: "shard 0 - id 0", ShardId: 0},
+		{Address: "shard 0 - id 1", ShardId: 0},
+		{Address: "shard 1 - id 0", ShardId: 1},
+	})
+
+	bnp.UpdateNodesHealth(map[string]struct{}{"shard 0 - id 0": {}})
+
+	assert.Equal(t, []*data.NodeData{{Address: "shard 0 - id 1", ShardId: 0}}, bnp.healthyNodes[0])
+	assert.Equal(t, []*data.NodeData{{Address: "shard 1 - id 0", ShardId: 1}}, bnp.healthyNodes[1])
+	assert.Equal(t, 2, len(bnp.allHealthyNodes))
+
+	configuredNodes, err := bnp.GetAllConfiguredNodes()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(configuredNodes))
+}
+
+func TestBaseNodeProvider_UpdateNodesHealthAllUnhealthyInShardShouldKeepAllNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "shard 0 - id 0", ShardId: 0},
+		{Address: "shard 0 - id 1", ShardId: 0},
+	}
+	bnp := &baseNodeProvider{}
+	_ = bnp.initNodesMaps(nodes)
+
+	bnp.UpdateNodesHealth(map[string]struct{}{"shard 0 - id 0": {}, "shard 0 - id 1": {}})
+
+	assert.Equal(t, nodes, bnp.healthyNodes[0])
+	assert.Equal(t, nodes, bnp.allHealthyNodes)
+}
+
+func TestBaseNodeProvider_UpdateNodesHealthShouldReAdmitRecoveredNodes(t *testing.T) {
+	t.Parallel()
+
+	bnp := &baseNodeProvider{}
+	_ = bnp.initNodesMaps([]*data.NodeData{
+		{Address: "shard 0 - id 0", ShardId: 0},
+		{Address: "shard 0 - id 1", ShardId: 0},
+	})
+
+	bnp.UpdateNodesHealth(map[string]struct{}{"shard 0 - id 0": {}})
+	assert.Equal(t, 1, len(bnp.healthyNodes[0]))
+
+	bnp.UpdateNodesHealth(make(map[string]struct{}))
+	assert.Equal(t, 2, len(bnp.healthyNodes[0]))
+	assert.Equal(t, 2, len(bnp.allHealthyNodes))
+}
+
+func TestBaseNodeProvider_ReloadNodesShouldReplaceNodesAndKeepUnhealthyOnes(t *testing.T) {
+	t.Parallel()
+
+	bnp := &baseNodeProvider{}
+	_ = bnp.initNodesMaps([]*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0},
+	})
+	bnp.UpdateNodesHealth(map[string]struct{}{"addr1": {}})
+
+	err := bnp.ReloadNodes([]*data.NodeData{
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+		{Address: "addr3", ShardId: 1},
+	})
+	assert.Nil(t, err)
+
+	allNodes, _ := bnp.GetAllConfiguredNodes()
+	assert.Equal(t, 3, len(allNodes))
+	assert.Equal(t, []*data.NodeData{{Address: "addr2", ShardId: 0}}, bnp.healthyNodes[0])
+	assert.Equal(t, []*data.NodeData{{Address: "addr3", ShardId: 1}}, bnp.healthyNodes[1])
+}
+
+func TestBaseNodeProvider_ReloadNodesWithEmptyListShouldKeepCurrentNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+	}
+	bnp := &baseNodeProvider{}
+	_ = bnp.initNodesMaps(nodes)
+
+	err := bnp.ReloadNodes(nil)
+	assert.Equal(t, ErrEmptyObserversList, err)
+
+	allNodes, _ := bnp.GetAllConfiguredNodes()
+	assert.Equal(t, nodes, allNodes)
+}
+
+func TestFilterNodesByTags(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "addr0", Tags: []string{"full-history"}},
+		{Address: "addr1", Tags: []string{"high-memory", "full-history"}},
+		{Address: "addr2"},
+	}
+
+	filteredNodes, err := filterNodesByTags(nodes, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, nodes, filteredNodes)
+
+	filteredNodes, err = filterNodesByTags(nodes, nil, []string{"full-history"})
+	assert.Nil(t, err)
+	assert.Equal(t, nodes[:2], filteredNodes)
+
+	filteredNodes, err = filterNodesByTags(nodes, nil, []string{"full-history", "high-memory"})
+	assert.Nil(t, err)
+	assert.Equal(t, nodes[1:2], filteredNodes)
+
+	filteredNodes, err = filterNodesByTags(nodes, nil, []string{"esdt-indexed"})
+	assert.Nil(t, filteredNodes)
+	assert.True(t, errors.Is(err, ErrNoNodeWithRequiredTags))
+
+	filteredNodes, err = filterNodesByTags(nil, ErrShardNotAvailable, []string{"full-history"})
+	assert.Nil(t, filteredNodes)
+	assert.Equal(t, ErrShardNotAvailable, err)
+}
@@ -36,27 +36,49 @@ func NewCircularQueueNodesProvider(observers []*data.NodeData) (*circularQueueNo
 func (cqnp *circularQueueNodesProvider) GetNodesByShardId(shardId uint32) ([]*data.NodeData, error) {
 	cqnp.mutNodes.Lock()
 	defer cqnp.mutNodes.Unlock()
-	nodesForShard := cqnp.nodes[shardId]
+	nodesForShard := cqnp.healthyNodes[shardId]
 	if len(nodesForShard) == 0 {
 		return nil, ErrShardNotAvailable
 	}
 
 	position := cqnp.computeCounterForShard(shardId, uint32(len(nodesForShard)))
-	sliceToRet := append(nodesForShard[position:], nodesForShard[:position]...)
 
-	return sliceToRet, nil
+	return rotateNodes(nodesForShard, position), nil
+}
+
+// GetNodesByShardIdForKey will return the same nodes as GetNodesByShardId, as this strategy does not map keys to nodes
+func (cqnp *circularQueueNodesProvider) GetNodesByShardIdForKey(shardId uint32, _ string) ([]*data.NodeData, error) {
+	return cqnp.GetNodesByShardId(shardId)
 }
 
 // GetAllNodes will return a slice containing all observers
 func (cqnp *circularQueueNodesProvider) GetAllNodes() ([]*data.NodeData, error) {
 	cqnp.mutNodes.Lock()
 	defer cqnp.mutNodes.Unlock()
-	allNodes := cqnp.allNodes
+	allNodes := cqnp.allHealthyNodes
 
 	position := cqnp.computeCounterForAllNodes(uint32(len(allNodes)))
-	sliceToRet := append(allNodes[position:], allNodes[:position]...)
 
-	return sliceToRet, nil
+	return rotateNodes(allNodes, position), nil
+}
+
+// GetNodesByShardIdWithTags will return the nodes GetNodesByShardIdForKey returns which have all the required tags
+func (cqnp *circularQueueNodesProvider) GetNodesByShardIdWithTags(shardId uint32, key string, requiredTags []string) ([]*data.NodeData, error) {
+	return getNodesByShardIdWithTags(shardId, key, requiredTags, cqnp.GetNodesByShardIdForKey)
+}
+
+// GetAllNodesWithTags will return the nodes GetAllNodes returns which have all the required tags
+func (cqnp *circularQueueNodesProvider) GetAllNodesWithTags(requiredTags []string) ([]*data.NodeData, error) {
+	return getAllNodesWithTags(requiredTags, cqnp.GetAllNodes)
+}
+
+// rotateNodes returns a new slice so the internal slices won't get altered by the callers
+func rotateNodes(nodes []*data.NodeData, position uint32) []*data.NodeData {
+	sliceToRet := make([]*data.NodeData, 0, len(nodes))
+	sliceToRet = append(sliceToRet, nodes[position:]...)
+	sliceToRet = append(sliceToRet, nodes[:position]...)
+
+	return sliceToRet
 }
 
 func (cqnp *circularQueueNodesProvider) computeCounterForShard(shardID uint32, lenNodes uint32) uint32 {
@@ -248,3 +248,100 @@ func TestCircularQueueObserversProvider_GetObserversByShardId_ConcurrentSafe(t *
 	}
 	mutMap.RUnlock()
 }
+
+func TestCircularQueueObserversProvider_GetObserversByShardIdShouldBalanceOnlyHealthyNodes(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+		{Address: "addr3", ShardId: 0},
+	}
+	cqop, _ := NewCircularQueueNodesProvider(observers)
+	cqop.UpdateNodesHealth(map[string]struct{}{"addr2": {}})
+
+	for i := 0; i < 4; i++ {
+		res, err := cqop.GetNodesByShardId(0)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		assert.NotEqual(t, "addr2", res[0].Address)
+
+		res, err = cqop.GetAllNodes()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		assert.NotEqual(t, "addr2", res[0].Address)
+	}
+}
+
+func TestCircularQueueObserversProvider_GetObserversByShardIdShouldNotAlterInternalSlice(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+		{Address: "addr3", ShardId: 0},
+	}
+	cqop, _ := NewCircularQueueNodesProvider(observers)
+
+	res, _ := cqop.GetNodesByShardId(0)
+	res[0] = &data.NodeData{Address: "altered"}
+
+	configuredNodes, _ := cqop.GetAllConfiguredNodes()
+	for _, node := range configuredNodes {
+		assert.NotEqual(t, "altered", node.Address)
+	}
+}
+
+func TestCircularQueueObserversProvider_ReloadNodesShouldKeepCounters(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+		{Address: "addr3", ShardId: 0},
+	}
+	cqop, _ := NewCircularQueueNodesProvider(observers)
+
+	res, _ := cqop.GetNodesByShardId(0)
+	assert.Equal(t, "addr2", res[0].Address)
+
+	err := cqop.ReloadNodes([]*data.NodeData{
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+		{Address: "addr3", ShardId: 0},
+		{Address: "addr4", ShardId: 0},
+	})
+	assert.Nil(t, err)
+
+	res, _ = cqop.GetNodesByShardId(0)
+	assert.Equal(t, 4, len(res))
+	assert.Equal(t, "addr3", res[0].Address)
+}
+
+func TestCircularQueueObserversProvider_ReloadNodesConcurrentlyWithGetShouldWork(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+		{Address: "addr3", ShardId: 0},
+	}
+	cqop, _ := NewCircularQueueNodesProvider(observers)
+
+	numCalls := 100
+	wg := &sync.WaitGroup{}
+	wg.Add(2 * numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			_ = cqop.ReloadNodes(observers[:idx%len(observers)+1])
+			wg.Done()
+		}(i)
+		go func() {
+			res, err := cqop.GetNodesByShardId(0)
+			assert.Nil(t, err)
+			assert.NotEqual(t, 0, len(res))
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+}
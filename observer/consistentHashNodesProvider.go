@@ -0,0 +1,159 @@
+package observer
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// numRingPointsPerWeight represents the number of points a node with weight 1 gets on the hash ring of its shard.
+// More points lead to a more even distribution of the keys between the nodes
+const numRingPointsPerWeight = 64
+
+type ringPoint struct {
+	hash uint64
+	node *data.NodeData
+}
+
+// consistentHashNodesProvider will map each key (an address or a session ID) to the same node of a shard, using a
+// hash ring built from all the configured nodes, so the mapping of a key does not change when other nodes become
+// unavailable. The nodes are returned in the ring order starting with the key's node, skipping the unavailable ones,
+// so the requests fall back on the same next nodes. The requests without a key are distributed in a round-robin way
+type consistentHashNodesProvider struct {
+	*circularQueueNodesProvider
+	mutRings sync.RWMutex
+	rings    map[uint32][]ringPoint
+}
+
+// NewConsistentHashNodesProvider returns a new instance of consistentHashNodesProvider
+func NewConsistentHashNodesProvider(nodes []*data.NodeData) (*consistentHashNodesProvider, error) {
+	cqnp, err := NewCircularQueueNodesProvider(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &consistentHashNodesProvider{
+		circularQueueNodesProvider: cqnp,
+		rings:                      createHashRings(nodes),
+	}, nil
+}
+
+// ReloadNodes will atomically replace the current nodes with the provided ones and will rebuild the hash rings
+func (chnp *consistentHashNodesProvider) ReloadNodes(nodes []*data.NodeData) error {
+	err := chnp.circularQueueNodesProvider.ReloadNodes(nodes)
+	if err != nil {
+		return err
+	}
+
+	chnp.mutRings.Lock()
+	chnp.rings = createHashRings(nodes)
+	chnp.mutRings.Unlock()
+
+	return nil
+}
+
+func createHashRings(nodes []*data.NodeData) map[uint32][]ringPoint {
+	rings := make(map[uint32][]ringPoint)
+	for _, node := range nodes {
+		numPoints := int(getWeight(node)) * numRingPointsPerWeight
+		for i := 0; i < numPoints; i++ {
+			point := ringPoint{
+				hash: hashKey(fmt.Sprintf("%s#%d", node.Address, i)),
+				node: node,
+			}
+			rings[node.ShardId] = append(rings[node.ShardId], point)
+		}
+	}
+
+	for _, ring := range rings {
+		sortRing(ring)
+	}
+
+	return rings
+}
+
+func sortRing(ring []ringPoint) {
+	sort.Slice(ring, func(i, j int) bool {
+		if ring[i].hash == ring[j].hash {
+			return ring[i].node.Address < ring[j].node.Address
+		}
+
+		return ring[i].hash < ring[j].hash
+	})
+}
+
+func hashKey(key string) uint64 {
+	hash := sha256.Sum256([]byte(key))
+
+	return binary.BigEndian.Uint64(hash[:8])
+}
+
+// GetNodesByShardIdForKey will return the available nodes of the given shard in the ring order, starting with the
+// node the key is mapped to
+func (chnp *consistentHashNodesProvider) GetNodesByShardIdForKey(shardId uint32, key string) ([]*data.NodeData, error) {
+	if len(key) == 0 {
+		return chnp.GetNodesByShardId(shardId)
+	}
+
+	chnp.mutNodes.RLock()
+	availableNodes := chnp.healthyNodes[shardId]
+	chnp.mutNodes.RUnlock()
+	if len(availableNodes) == 0 {
+		return nil, ErrShardNotAvailable
+	}
+
+	chnp.mutRings.RLock()
+	ring := chnp.rings[shardId]
+	chnp.mutRings.RUnlock()
+
+	return walkRing(ring, hashKey(key), availableNodes), nil
+}
+
+// GetNodesByShardIdWithTags will return the nodes GetNodesByShardIdForKey returns which have all the required tags
+func (chnp *consistentHashNodesProvider) GetNodesByShardIdWithTags(shardId uint32, key string, requiredTags []string) ([]*data.NodeData, error) {
+	return getNodesByShardIdWithTags(shardId, key, requiredTags, chnp.GetNodesByShardIdForKey)
+}
+
+// walkRing returns the available nodes in the order in which they are first met on the ring, starting with the
+// point of the given hash. The available nodes missing from the ring are appended at the end
+func walkRing(ring []ringPoint, hash uint64, availableNodes []*data.NodeData) []*data.NodeData {
+	isAvailable := make(map[string]struct{}, len(availableNodes))
+	for _, node := range availableNodes {
+		isAvailable[node.Address] = struct{}{}
+	}
+
+	sortedNodes := make([]*data.NodeData, 0, len(availableNodes))
+	isAdded := make(map[string]struct{}, len(availableNodes))
+	start := sort.Search(len(ring), func(i int) bool {
+		return ring[i].hash >= hash
+	})
+	for i := 0; i < len(ring) && len(sortedNodes) < len(availableNodes); i++ {
+		node := ring[(start+i)%len(ring)].node
+		_, available := isAvailable[node.Address]
+		_, added := isAdded[node.Address]
+		if !available || added {
+			continue
+		}
+
+		sortedNodes = append(sortedNodes, node)
+		isAdded[node.Address] = struct{}{}
+	}
+
+	for _, node := range availableNodes {
+		if _, added := isAdded[node.Address]; !added {
+			sortedNodes = append(sortedNodes, node)
+			isAdded[node.Address] = struct{}{}
+		}
+	}
+
+	return sortedNodes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (chnp *consistentHashNodesProvider) IsInterfaceNil() bool {
+	return chnp == nil
+}
@@ -0,0 +1,151 @@
+package observer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodesForConsistentHashing() []*data.NodeData {
+	return []*data.NodeData{
+		{Address: "addr0", ShardId: 0},
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+		{Address: "addr3", ShardId: 0},
+		{Address: "addr4", ShardId: 1},
+	}
+}
+
+func TestNewConsistentHashNodesProvider_EmptyNodesListShouldErr(t *testing.T) {
+	t.Parallel()
+
+	chnp, err := NewConsistentHashNodesProvider(nil)
+	assert.Nil(t, chnp)
+	assert.Equal(t, ErrEmptyObserversList, err)
+}
+
+func TestNewConsistentHashNodesProvider_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	chnp, err := NewConsistentHashNodesProvider(getDummyConfig().Observers)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(chnp))
+}
+
+func TestConsistentHashNodesProvider_GetNodesByShardIdForKeyShouldReturnTheSameNodesForTheSameKey(t *testing.T) {
+	t.Parallel()
+
+	chnp, _ := NewConsistentHashNodesProvider(createNodesForConsistentHashing())
+
+	firstNodes, err := chnp.GetNodesByShardIdForKey(0, "erd1address")
+	require.Nil(t, err)
+	assert.Equal(t, 4, len(firstNodes))
+	for i := 0; i < 10; i++ {
+		nodes, _ := chnp.GetNodesByShardIdForKey(0, "erd1address")
+		assert.Equal(t, firstNodes, nodes)
+	}
+
+	nodes, err := chnp.GetNodesByShardIdForKey(1, "erd1address")
+	require.Nil(t, err)
+	assert.Equal(t, []*data.NodeData{{Address: "addr4", ShardId: 1}}, nodes)
+
+	_, err = chnp.GetNodesByShardIdForKey(2, "erd1address")
+	assert.Equal(t, ErrShardNotAvailable, err)
+}
+
+func TestConsistentHashNodesProvider_GetNodesByShardIdForKeyShouldSpreadTheKeys(t *testing.T) {
+	t.Parallel()
+
+	chnp, _ := NewConsistentHashNodesProvider(createNodesForConsistentHashing())
+
+	numKeys := 4000
+	numKeysPerNode := make(map[string]int)
+	for i := 0; i < numKeys; i++ {
+		nodes, _ := chnp.GetNodesByShardIdForKey(0, fmt.Sprintf("key%d", i))
+		numKeysPerNode[nodes[0].Address]++
+	}
+
+	require.Equal(t, 4, len(numKeysPerNode))
+	for address, numKeysOnNode := range numKeysPerNode {
+		assert.True(t, numKeysOnNode > numKeys/8, "node %s got only %d keys", address, numKeysOnNode)
+	}
+}
+
+func TestConsistentHashNodesProvider_GetNodesByShardIdForKeyShouldFallBackInTheRingOrder(t *testing.T) {
+	t.Parallel()
+
+	chnp, _ := NewConsistentHashNodesProvider(createNodesForConsistentHashing())
+
+	nodesBefore, _ := chnp.GetNodesByShardIdForKey(0, "erd1address")
+	chnp.UpdateNodesHealth(map[string]struct{}{nodesBefore[0].Address: {}})
+
+	nodesAfter, err := chnp.GetNodesByShardIdForKey(0, "erd1address")
+	require.Nil(t, err)
+	assert.Equal(t, nodesBefore[1:], nodesAfter)
+
+	chnp.UpdateNodesHealth(nil)
+	nodes, _ := chnp.GetNodesByShardIdForKey(0, "erd1address")
+	assert.Equal(t, nodesBefore, nodes)
+}
+
+func TestConsistentHashNodesProvider_GetNodesByShardIdForKeyShouldKeepTheMappingOfTheOtherKeys(t *testing.T) {
+	t.Parallel()
+
+	chnp, _ := NewConsistentHashNodesProvider(createNodesForConsistentHashing())
+
+	numKeys := 1000
+	nodesBefore := make([]string, numKeys)
+	for i := 0; i < numKeys; i++ {
+		nodes, _ := chnp.GetNodesByShardIdForKey(0, fmt.Sprintf("key%d", i))
+		nodesBefore[i] = nodes[0].Address
+	}
+
+	err := chnp.ReloadNodes(append(createNodesForConsistentHashing(), &data.NodeData{Address: "addr5", ShardId: 0}))
+	require.Nil(t, err)
+
+	for i := 0; i < numKeys; i++ {
+		nodes, _ := chnp.GetNodesByShardIdForKey(0, fmt.Sprintf("key%d", i))
+		if nodes[0].Address != "addr5" {
+			assert.Equal(t, nodesBefore[i], nodes[0].Address)
+		}
+	}
+}
+
+func TestConsistentHashNodesProvider_GetNodesByShardIdForKeyWithoutKeyShouldRotate(t *testing.T) {
+	t.Parallel()
+
+	chnp, _ := NewConsistentHashNodesProvider(createNodesForConsistentHashing())
+
+	firstNodes, _ := chnp.GetNodesByShardIdForKey(0, "")
+	secondNodes, _ := chnp.GetNodesByShardIdForKey(0, "")
+	assert.NotEqual(t, firstNodes[0].Address, secondNodes[0].Address)
+}
+
+func TestConsistentHashNodesProvider_GetNodesByShardIdWithTagsShouldKeepTheRingOrder(t *testing.T) {
+	t.Parallel()
+
+	nodes := createNodesForConsistentHashing()
+	nodes[1].Tags = []string{"high-memory"}
+	nodes[3].Tags = []string{"high-memory"}
+	chnp, _ := NewConsistentHashNodesProvider(nodes)
+
+	allNodes, _ := chnp.GetNodesByShardIdForKey(0, "erd1address")
+	expectedNodes := make([]*data.NodeData, 0)
+	for _, node := range allNodes {
+		if len(node.Tags) > 0 {
+			expectedNodes = append(expectedNodes, node)
+		}
+	}
+
+	taggedNodes, err := chnp.GetNodesByShardIdWithTags(0, "erd1address", []string{"high-memory"})
+	require.Nil(t, err)
+	assert.Equal(t, expectedNodes, taggedNodes)
+
+	_, err = chnp.GetNodesByShardIdWithTags(1, "erd1address", []string{"high-memory"})
+	assert.True(t, errors.Is(err, ErrNoNodeWithRequiredTags))
+}
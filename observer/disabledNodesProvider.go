@@ -25,11 +25,40 @@ func (d *disabledNodesProvider) GetNodesByShardId(_ uint32) ([]*data.NodeData, e
 	return nil, errors.New(d.returnMessage)
 }
 
+// GetNodesByShardIdForKey returns the desired return message as an error
+func (d *disabledNodesProvider) GetNodesByShardIdForKey(_ uint32, _ string) ([]*data.NodeData, error) {
+	return nil, errors.New(d.returnMessage)
+}
+
+// GetNodesByShardIdWithTags returns the desired return message as an error
+func (d *disabledNodesProvider) GetNodesByShardIdWithTags(_ uint32, _ string, _ []string) ([]*data.NodeData, error) {
+	return nil, errors.New(d.returnMessage)
+}
+
 // GetAllNodes returns the desired return message as an error
 func (d *disabledNodesProvider) GetAllNodes() ([]*data.NodeData, error) {
 	return nil, errors.New(d.returnMessage)
 }
 
+// GetAllNodesWithTags returns the desired return message as an error
+func (d *disabledNodesProvider) GetAllNodesWithTags(_ []string) ([]*data.NodeData, error) {
+	return nil, errors.New(d.returnMessage)
+}
+
+// GetAllConfiguredNodes returns the desired return message as an error
+func (d *disabledNodesProvider) GetAllConfiguredNodes() ([]*data.NodeData, error) {
+	return nil, errors.New(d.returnMessage)
+}
+
+// ReloadNodes returns the desired return message as an error
+func (d *disabledNodesProvider) ReloadNodes(_ []*data.NodeData) error {
+	return errors.New(d.returnMessage)
+}
+
+// UpdateNodesHealth does nothing as there are no nodes to be updated
+func (d *disabledNodesProvider) UpdateNodesHealth(_ map[string]struct{}) {
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (d *disabledNodesProvider) IsInterfaceNil() bool {
 	return d == nil
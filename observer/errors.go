@@ -7,3 +7,27 @@ var ErrEmptyObserversList = errors.New("empty observers list")
 
 // ErrShardNotAvailable signals that the specified shard ID cannot be found in internal maps
 var ErrShardNotAvailable = errors.New("the specified shard ID does not exist in proxy's configuration")
+
+// ErrNilNodesProvider signals that a nil nodes provider has been provided
+var ErrNilNodesProvider = errors.New("nil nodes provider")
+
+// ErrEmptyConfigFilePath signals that an empty config file path has been provided
+var ErrEmptyConfigFilePath = errors.New("empty config file path")
+
+// ErrInvalidFileCheckInterval signals that an invalid interval for checking the config file has been provided
+var ErrInvalidFileCheckInterval = errors.New("invalid config file check interval")
+
+// ErrNilNodesStatistics signals that a nil nodes statistics handler has been provided
+var ErrNilNodesStatistics = errors.New("nil nodes statistics handler")
+
+// ErrInvalidBalancingStrategy signals that an unknown balancing strategy has been provided
+var ErrInvalidBalancingStrategy = errors.New("invalid balancing strategy")
+
+// ErrNilNodesCredentials signals that a nil nodes credentials handler has been provided
+var ErrNilNodesCredentials = errors.New("nil nodes credentials handler")
+
+// ErrNoNodeWithRequiredTags signals that none of the available nodes has all the required tags
+var ErrNoNodeWithRequiredTags = errors.New("no available node has all the required tags")
+
+// ErrNilShardDiscoverer signals that a nil shard discoverer has been provided
+var ErrNilShardDiscoverer = errors.New("nil shard discoverer")
@@ -1,10 +1,48 @@
 package observer
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 // NodesProviderHandler defines what a nodes provider should be able to do
 type NodesProviderHandler interface {
 	GetNodesByShardId(shardId uint32) ([]*data.NodeData, error)
+	// GetNodesByShardIdForKey returns the nodes of the given shard for a request identified by the given key (for
+	// example an address). The strategies which map keys to nodes return the same nodes for the same key
+	GetNodesByShardIdForKey(shardId uint32, key string) ([]*data.NodeData, error)
+	// GetNodesByShardIdWithTags returns the nodes GetNodesByShardIdForKey returns, keeping only the ones having all the
+	// required tags
+	GetNodesByShardIdWithTags(shardId uint32, key string, requiredTags []string) ([]*data.NodeData, error)
 	GetAllNodes() ([]*data.NodeData, error)
+	GetAllNodesWithTags(requiredTags []string) ([]*data.NodeData, error)
+	GetAllConfiguredNodes() ([]*data.NodeData, error)
+	// UpdateNodesHealth receives the addresses of the nodes which should not receive requests, either because they
+	// are unreachable or because they are out of sync
+	UpdateNodesHealth(unhealthyNodes map[string]struct{})
+	ReloadNodes(nodes []*data.NodeData) error
+	IsInterfaceNil() bool
+}
+
+// NodesStatisticsHandler defines what a component which keeps track of the requests sent to nodes should be able to do
+type NodesStatisticsHandler interface {
+	RequestStarted(address string)
+	RequestFinished(address string, duration time.Duration, outcome RequestOutcome)
+	GetOutstandingRequests(address string) uint32
+	GetAverageLatency(address string) time.Duration
+	IsInterfaceNil() bool
+}
+
+// ShardDiscovererHandler defines what a component which checks the nodes against the shards they report should be able
+// to do
+type ShardDiscovererHandler interface {
+	DiscoverNodesShards(observers []*data.NodeData, fullHistoryNodes []*data.NodeData) ([]*data.NodeData, []*data.NodeData, uint32)
+	IsInterfaceNil() bool
+}
+
+// NodesCredentialsHandler defines what a component which applies the nodes' credentials should be able to do
+type NodesCredentialsHandler interface {
+	ReloadNodes(nodes []*data.NodeData) error
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,102 @@
+package observer
+
+import (
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// latencyAwareNodesProvider will handle the providing of nodes based on their average latency and on their number
+// of in-flight requests, so the fastest and least loaded nodes are returned first
+type latencyAwareNodesProvider struct {
+	*baseNodeProvider
+	nodesStatistics NodesStatisticsHandler
+}
+
+// NewLatencyAwareNodesProvider returns a new instance of latencyAwareNodesProvider
+func NewLatencyAwareNodesProvider(
+	nodes []*data.NodeData,
+	nodesStatistics NodesStatisticsHandler,
+) (*latencyAwareNodesProvider, error) {
+	if check.IfNil(nodesStatistics) {
+		return nil, ErrNilNodesStatistics
+	}
+
+	bop := &baseNodeProvider{}
+
+	err := bop.initNodesMaps(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &latencyAwareNodesProvider{
+		baseNodeProvider: bop,
+		nodesStatistics:  nodesStatistics,
+	}, nil
+}
+
+// GetNodesByShardId will return a slice of the nodes for the given shard, sorted by their score
+func (lanp *latencyAwareNodesProvider) GetNodesByShardId(shardId uint32) ([]*data.NodeData, error) {
+	lanp.mutNodes.RLock()
+	defer lanp.mutNodes.RUnlock()
+
+	nodesForShard := lanp.healthyNodes[shardId]
+	if len(nodesForShard) == 0 {
+		return nil, ErrShardNotAvailable
+	}
+
+	return lanp.sortNodesByScore(nodesForShard), nil
+}
+
+// GetNodesByShardIdForKey will return the same nodes as GetNodesByShardId, as this strategy does not map keys to nodes
+func (lanp *latencyAwareNodesProvider) GetNodesByShardIdForKey(shardId uint32, _ string) ([]*data.NodeData, error) {
+	return lanp.GetNodesByShardId(shardId)
+}
+
+// GetAllNodes will return a slice containing all the nodes, sorted by their score
+func (lanp *latencyAwareNodesProvider) GetAllNodes() ([]*data.NodeData, error) {
+	lanp.mutNodes.RLock()
+	defer lanp.mutNodes.RUnlock()
+
+	return lanp.sortNodesByScore(lanp.allHealthyNodes), nil
+}
+
+// GetNodesByShardIdWithTags will return the nodes GetNodesByShardIdForKey returns which have all the required tags
+func (lanp *latencyAwareNodesProvider) GetNodesByShardIdWithTags(shardId uint32, key string, requiredTags []string) ([]*data.NodeData, error) {
+	return getNodesByShardIdWithTags(shardId, key, requiredTags, lanp.GetNodesByShardIdForKey)
+}
+
+// GetAllNodesWithTags will return the nodes GetAllNodes returns which have all the required tags
+func (lanp *latencyAwareNodesProvider) GetAllNodesWithTags(requiredTags []string) ([]*data.NodeData, error) {
+	return getAllNodesWithTags(requiredTags, lanp.GetAllNodes)
+}
+
+// sortNodesByScore returns a new sorted slice so the internal slices won't get altered. The nodes which didn't
+// answer any request yet have the best score, so they get measured as soon as possible
+func (lanp *latencyAwareNodesProvider) sortNodesByScore(nodes []*data.NodeData) []*data.NodeData {
+	scores := make(map[string]float64, len(nodes))
+	for _, node := range nodes {
+		scores[node.Address] = lanp.computeScore(node.Address)
+	}
+
+	sortedNodes := make([]*data.NodeData, len(nodes))
+	copy(sortedNodes, nodes)
+	sort.SliceStable(sortedNodes, func(i, j int) bool {
+		return scores[sortedNodes[i].Address] < scores[sortedNodes[j].Address]
+	})
+
+	return sortedNodes
+}
+
+func (lanp *latencyAwareNodesProvider) computeScore(address string) float64 {
+	averageLatency := float64(lanp.nodesStatistics.GetAverageLatency(address))
+	outstandingRequests := float64(lanp.nodesStatistics.GetOutstandingRequests(address))
+
+	return (averageLatency + 1) * (outstandingRequests + 1)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lanp *latencyAwareNodesProvider) IsInterfaceNil() bool {
+	return lanp == nil
+}
@@ -0,0 +1,125 @@
+package observer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLatencyAwareNodesProvider_NilNodesStatisticsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lanp, err := NewLatencyAwareNodesProvider(getDummyConfig().Observers, nil)
+	assert.Nil(t, lanp)
+	assert.Equal(t, ErrNilNodesStatistics, err)
+}
+
+func TestNewLatencyAwareNodesProvider_EmptyNodesListShouldErr(t *testing.T) {
+	t.Parallel()
+
+	lanp, err := NewLatencyAwareNodesProvider(nil, NewNodesStatistics())
+	assert.Nil(t, lanp)
+	assert.Equal(t, ErrEmptyObserversList, err)
+}
+
+func TestNewLatencyAwareNodesProvider_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	lanp, err := NewLatencyAwareNodesProvider(getDummyConfig().Observers, NewNodesStatistics())
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(lanp))
+}
+
+func TestLatencyAwareNodesProvider_GetNodesByShardIdShouldPreferFastNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "slow", ShardId: 0},
+		{Address: "fast", ShardId: 0},
+		{Address: "medium", ShardId: 0},
+	}
+	ns := NewNodesStatistics()
+	ns.RequestFinished("slow", 300*time.Millisecond, RequestAnswered)
+	ns.RequestFinished("fast", 10*time.Millisecond, RequestAnswered)
+	ns.RequestFinished("medium", 50*time.Millisecond, RequestAnswered)
+
+	lanp, _ := NewLatencyAwareNodesProvider(nodes, ns)
+
+	res, err := lanp.GetNodesByShardId(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"fast", "medium", "slow"}, getAddresses(res))
+
+	configuredNodes, _ := lanp.GetAllConfiguredNodes()
+	assert.Equal(t, []string{"slow", "fast", "medium"}, getAddresses(configuredNodes))
+}
+
+func TestLatencyAwareNodesProvider_GetAllNodesShouldPenalizeBusyNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "fast but busy", ShardId: 0},
+		{Address: "slower", ShardId: 1},
+	}
+	ns := NewNodesStatistics()
+	ns.RequestFinished("fast but busy", 10*time.Millisecond, RequestAnswered)
+	ns.RequestFinished("slower", 30*time.Millisecond, RequestAnswered)
+	for i := 0; i < 5; i++ {
+		ns.RequestStarted("fast but busy")
+	}
+
+	lanp, _ := NewLatencyAwareNodesProvider(nodes, ns)
+
+	res, err := lanp.GetAllNodes()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"slower", "fast but busy"}, getAddresses(res))
+}
+
+func TestLatencyAwareNodesProvider_GetNodesByShardIdShouldNotPreferFastFailingNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "fast failing", ShardId: 0},
+		{Address: "slow answering", ShardId: 0},
+	}
+	ns := NewNodesStatistics()
+	for i := 0; i < 10; i++ {
+		ns.RequestStarted("fast failing")
+		ns.RequestFinished("fast failing", time.Millisecond, RequestFailed)
+		ns.RequestStarted("slow answering")
+		ns.RequestFinished("slow answering", 500*time.Millisecond, RequestAnswered)
+	}
+
+	lanp, _ := NewLatencyAwareNodesProvider(nodes, ns)
+
+	res, err := lanp.GetNodesByShardId(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"slow answering", "fast failing"}, getAddresses(res))
+}
+
+func TestLatencyAwareNodesProvider_GetNodesByShardIdShouldPreferUnmeasuredNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "measured", ShardId: 0},
+		{Address: "new", ShardId: 0},
+	}
+	ns := NewNodesStatistics()
+	ns.RequestFinished("measured", 10*time.Millisecond, RequestAnswered)
+
+	lanp, _ := NewLatencyAwareNodesProvider(nodes, ns)
+
+	res, _ := lanp.GetNodesByShardId(0)
+	assert.Equal(t, []string{"new", "measured"}, getAddresses(res))
+}
+
+func getAddresses(nodes []*data.NodeData) []string {
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		addresses = append(addresses, node.Address)
+	}
+
+	return addresses
+}
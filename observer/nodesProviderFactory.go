@@ -1,45 +1,65 @@
 package observer
 
 import (
+	"fmt"
+
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
 
 var log = logger.GetOrCreate("observer")
 
+const (
+	// SimpleBalancingStrategy returns the nodes in the order in which they were provided in the config file
+	SimpleBalancingStrategy = "simple"
+	// RoundRobinBalancingStrategy distributes the requests equally between the nodes
+	RoundRobinBalancingStrategy = "round-robin"
+	// WeightedRoundRobinBalancingStrategy distributes the requests proportionally to the nodes' weights
+	WeightedRoundRobinBalancingStrategy = "weighted-round-robin"
+	// LatencyAwareBalancingStrategy prefers the nodes with the lowest latency and the fewest in-flight requests
+	LatencyAwareBalancingStrategy = "latency-aware"
+	// ConsistentHashBalancingStrategy sends the requests for the same address, or session, to the same node
+	ConsistentHashBalancingStrategy = "consistent-hash"
+)
+
 // nodesProviderFactory handles the creation of an nodes provider based on config
 type nodesProviderFactory struct {
-	cfg config.Config
+	cfg             config.Config
+	nodesStatistics NodesStatisticsHandler
 }
 
 // NewNodesProviderFactory returns a new instance of nodesProviderFactory
-func NewNodesProviderFactory(cfg config.Config) (*nodesProviderFactory, error) {
+func NewNodesProviderFactory(cfg config.Config, nodesStatistics NodesStatisticsHandler) (*nodesProviderFactory, error) {
+	if check.IfNil(nodesStatistics) {
+		return nil, ErrNilNodesStatistics
+	}
+
 	return &nodesProviderFactory{
-		cfg: cfg,
+		cfg:             cfg,
+		nodesStatistics: nodesStatistics,
 	}, nil
 }
 
-// CreateObservers will create and return an object of type NodesProviderHandler based on a flag
+// CreateObservers will create and return an object of type NodesProviderHandler based on the configured strategy
 func (npf *nodesProviderFactory) CreateObservers() (NodesProviderHandler, error) {
-	if npf.cfg.GeneralSettings.BalancedObservers {
-		return NewCircularQueueNodesProvider(npf.cfg.Observers)
-	}
+	strategy := getBalancingStrategy(
+		npf.cfg.GeneralSettings.ObserversBalancingStrategy,
+		npf.cfg.GeneralSettings.BalancedObservers,
+	)
 
-	return NewSimpleNodesProvider(npf.cfg.Observers)
+	return npf.createNodesProvider(strategy, npf.cfg.Observers)
 }
 
-// CreateObservers will create and return an object of type NodesProviderHandler based on a flag
+// CreateFullHistoryNodes will create and return an object of type NodesProviderHandler based on the configured strategy
 func (npf *nodesProviderFactory) CreateFullHistoryNodes() (NodesProviderHandler, error) {
-	if npf.cfg.GeneralSettings.BalancedFullHistoryNodes {
-		nodesProviderHandler, err := NewCircularQueueNodesProvider(npf.cfg.FullHistoryNodes)
-		if err != nil {
-			return getDisabledFullHistoryNodesProviderIfNeeded(err)
-		}
-
-		return nodesProviderHandler, nil
-	}
+	strategy := getBalancingStrategy(
+		npf.cfg.GeneralSettings.FullHistoryNodesBalancingStrategy,
+		npf.cfg.GeneralSettings.BalancedFullHistoryNodes,
+	)
 
-	nodesProviderHandler, err := NewSimpleNodesProvider(npf.cfg.FullHistoryNodes)
+	nodesProviderHandler, err := npf.createNodesProvider(strategy, npf.cfg.FullHistoryNodes)
 	if err != nil {
 		return getDisabledFullHistoryNodesProviderIfNeeded(err)
 	}
@@ -47,6 +67,35 @@ func (npf *nodesProviderFactory) CreateFullHistoryNodes() (NodesProviderHandler,
 	return nodesProviderHandler, nil
 }
 
+// getBalancingStrategy falls back on the balanced flag if no strategy is configured
+func getBalancingStrategy(configuredStrategy string, isBalanced bool) string {
+	if len(configuredStrategy) > 0 {
+		return configuredStrategy
+	}
+	if isBalanced {
+		return RoundRobinBalancingStrategy
+	}
+
+	return SimpleBalancingStrategy
+}
+
+func (npf *nodesProviderFactory) createNodesProvider(strategy string, nodes []*data.NodeData) (NodesProviderHandler, error) {
+	switch strategy {
+	case SimpleBalancingStrategy:
+		return NewSimpleNodesProvider(nodes)
+	case RoundRobinBalancingStrategy:
+		return NewCircularQueueNodesProvider(nodes)
+	case WeightedRoundRobinBalancingStrategy:
+		return NewWeightedRoundRobinNodesProvider(nodes)
+	case LatencyAwareBalancingStrategy:
+		return NewLatencyAwareNodesProvider(nodes, npf.nodesStatistics)
+	case ConsistentHashBalancingStrategy:
+		return NewConsistentHashNodesProvider(nodes)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidBalancingStrategy, strategy)
+	}
+}
+
 func getDisabledFullHistoryNodesProviderIfNeeded(err error) (NodesProviderHandler, error) {
 	if err == ErrEmptyObserversList {
 		log.Warn("no configuration found for full history nodes. Calls to endpoints specific to full history nodes" +
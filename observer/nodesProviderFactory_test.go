@@ -1,6 +1,7 @@
 package observer
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
@@ -10,7 +11,7 @@ import (
 func TestNewObserversProviderFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	opf, err := NewNodesProviderFactory(config.Config{})
+	opf, err := NewNodesProviderFactory(config.Config{}, NewNodesStatistics())
 	assert.Nil(t, err)
 	assert.NotNil(t, opf)
 }
@@ -21,7 +22,7 @@ func TestObserversProviderFactory_CreateShouldReturnSimple(t *testing.T) {
 	cfg := getDummyConfig()
 	cfg.GeneralSettings.BalancedObservers = false
 
-	opf, _ := NewNodesProviderFactory(cfg)
+	opf, _ := NewNodesProviderFactory(cfg, NewNodesStatistics())
 	op, err := opf.CreateObservers()
 	assert.Nil(t, err)
 	_, ok := op.(*simpleNodesProvider)
@@ -34,9 +35,63 @@ func TestObserversProviderFactory_CreateShouldReturnCircularQueue(t *testing.T)
 	cfg := getDummyConfig()
 	cfg.GeneralSettings.BalancedObservers = true
 
-	opf, _ := NewNodesProviderFactory(cfg)
+	opf, _ := NewNodesProviderFactory(cfg, NewNodesStatistics())
 	op, err := opf.CreateObservers()
 	assert.Nil(t, err)
 	_, ok := op.(*circularQueueNodesProvider)
 	assert.True(t, ok)
 }
+
+func TestNewObserversProviderFactory_NilNodesStatisticsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	opf, err := NewNodesProviderFactory(config.Config{}, nil)
+	assert.Nil(t, opf)
+	assert.Equal(t, ErrNilNodesStatistics, err)
+}
+
+func TestObserversProviderFactory_CreateShouldUseTheConfiguredStrategy(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.GeneralSettings.BalancedObservers = true
+	cfg.GeneralSettings.ObserversBalancingStrategy = WeightedRoundRobinBalancingStrategy
+	cfg.GeneralSettings.FullHistoryNodesBalancingStrategy = LatencyAwareBalancingStrategy
+	cfg.FullHistoryNodes = cfg.Observers
+
+	opf, _ := NewNodesProviderFactory(cfg, NewNodesStatistics())
+	op, err := opf.CreateObservers()
+	assert.Nil(t, err)
+	_, ok := op.(*weightedRoundRobinNodesProvider)
+	assert.True(t, ok)
+
+	fhnp, err := opf.CreateFullHistoryNodes()
+	assert.Nil(t, err)
+	_, ok = fhnp.(*latencyAwareNodesProvider)
+	assert.True(t, ok)
+}
+
+func TestObserversProviderFactory_CreateWithInvalidStrategyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.GeneralSettings.ObserversBalancingStrategy = "invalid"
+
+	opf, _ := NewNodesProviderFactory(cfg, NewNodesStatistics())
+	op, err := opf.CreateObservers()
+	assert.Nil(t, op)
+	assert.True(t, errors.Is(err, ErrInvalidBalancingStrategy))
+}
+
+func TestObserversProviderFactory_CreateShouldReturnConsistentHash(t *testing.T) {
+	t.Parallel()
+
+	cfg := getDummyConfig()
+	cfg.GeneralSettings.ObserversBalancingStrategy = ConsistentHashBalancingStrategy
+
+	opf, _ := NewNodesProviderFactory(cfg, NewNodesStatistics())
+	op, err := opf.CreateObservers()
+	assert.Nil(t, err)
+	_, ok := op.(*consistentHashNodesProvider)
+	assert.True(t, ok)
+}
@@ -0,0 +1,197 @@
+package observer
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ArgsNodesReloader holds the arguments needed for creating a new nodesReloader
+type ArgsNodesReloader struct {
+	ConfigFilePath           string
+	ObserversProvider        NodesProviderHandler
+	FullHistoryNodesProvider NodesProviderHandler
+	NodesCredentials         NodesCredentialsHandler
+	ShardDiscoverer          ShardDiscovererHandler
+}
+
+// nodesReloader will re-read the observers and the full history nodes from the main config file and will
+// replace them in the nodes providers, without the need of restarting the proxy
+type nodesReloader struct {
+	configFilePath           string
+	observersProvider        NodesProviderHandler
+	fullHistoryNodesProvider NodesProviderHandler
+	nodesCredentials         NodesCredentialsHandler
+	shardDiscoverer          ShardDiscovererHandler
+	mutReload                sync.Mutex
+	lastModTime              time.Time
+}
+
+// NewNodesReloader returns a new instance of nodesReloader
+func NewNodesReloader(args ArgsNodesReloader) (*nodesReloader, error) {
+	if len(args.ConfigFilePath) == 0 {
+		return nil, ErrEmptyConfigFilePath
+	}
+	if check.IfNil(args.ObserversProvider) {
+		return nil, fmt.Errorf("%w for observers", ErrNilNodesProvider)
+	}
+	if check.IfNil(args.FullHistoryNodesProvider) {
+		return nil, fmt.Errorf("%w for full history nodes", ErrNilNodesProvider)
+	}
+	if check.IfNil(args.NodesCredentials) {
+		return nil, ErrNilNodesCredentials
+	}
+	if check.IfNil(args.ShardDiscoverer) {
+		return nil, ErrNilShardDiscoverer
+	}
+
+	return &nodesReloader{
+		configFilePath:           args.ConfigFilePath,
+		observersProvider:        args.ObserversProvider,
+		fullHistoryNodesProvider: args.FullHistoryNodesProvider,
+		nodesCredentials:         args.NodesCredentials,
+		shardDiscoverer:          args.ShardDiscoverer,
+		lastModTime:              getModTime(args.ConfigFilePath),
+	}, nil
+}
+
+// ReloadNodes will load the config file and will replace the nodes in the providers, along with their credentials. The
+// new nodes go through the same shard discovery as the ones loaded at startup. If the new lists of nodes are invalid,
+// the current nodes and credentials are kept
+func (nr *nodesReloader) ReloadNodes() error {
+	nr.mutReload.Lock()
+	defer nr.mutReload.Unlock()
+
+	cfg, err := config.LoadConfig(nr.configFilePath)
+	if err != nil {
+		return err
+	}
+	if len(cfg.Observers) == 0 {
+		return fmt.Errorf("cannot reload observers: %w", ErrEmptyObserversList)
+	}
+
+	// a disabled provider has no nodes
+	currentObservers, _ := nr.observersProvider.GetAllConfiguredNodes()
+	currentFullHistoryNodes, _ := nr.fullHistoryNodesProvider.GetAllConfiguredNodes()
+	newFullHistoryNodes := cfg.FullHistoryNodes
+	if len(newFullHistoryNodes) == 0 {
+		log.Warn("no full history nodes found in config file, will keep the current ones")
+		newFullHistoryNodes = currentFullHistoryNodes
+	}
+
+	// the credentials are replaced first, so no request towards a new node is sent without them, not even the shard
+	// discovery ones
+	err = nr.reloadNodesCredentials(cfg.Observers, newFullHistoryNodes)
+	if err != nil {
+		return fmt.Errorf("cannot reload nodes credentials: %w", err)
+	}
+
+	hasFullHistoryNodes := len(cfg.FullHistoryNodes) > 0
+	cfg.Observers, cfg.FullHistoryNodes, _ = nr.shardDiscoverer.DiscoverNodesShards(cfg.Observers, cfg.FullHistoryNodes)
+	if len(cfg.Observers) == 0 {
+		nr.restoreNodesCredentials(currentObservers, currentFullHistoryNodes)
+		return fmt.Errorf("cannot reload observers: %w", ErrEmptyObserversList)
+	}
+	if hasFullHistoryNodes && len(cfg.FullHistoryNodes) == 0 {
+		nr.restoreNodesCredentials(currentObservers, currentFullHistoryNodes)
+		return fmt.Errorf("cannot reload full history nodes: %w", ErrEmptyObserversList)
+	}
+
+	err = nr.observersProvider.ReloadNodes(cfg.Observers)
+	if err != nil {
+		nr.restoreNodesCredentials(currentObservers, currentFullHistoryNodes)
+		return fmt.Errorf("cannot reload observers: %w", err)
+	}
+
+	if len(cfg.FullHistoryNodes) > 0 {
+		err = nr.fullHistoryNodesProvider.ReloadNodes(cfg.FullHistoryNodes)
+		if err != nil {
+			nr.restoreObservers(currentObservers)
+			nr.restoreNodesCredentials(currentObservers, currentFullHistoryNodes)
+			return fmt.Errorf("cannot reload full history nodes: %w", err)
+		}
+		log.Info("reloaded full history nodes", "num full history nodes", len(cfg.FullHistoryNodes))
+	}
+	log.Info("reloaded observers", "num observers", len(cfg.Observers))
+
+	return nil
+}
+
+func (nr *nodesReloader) reloadNodesCredentials(observers []*data.NodeData, fullHistoryNodes []*data.NodeData) error {
+	nodes := make([]*data.NodeData, 0, len(observers)+len(fullHistoryNodes))
+	nodes = append(nodes, observers...)
+	nodes = append(nodes, fullHistoryNodes...)
+
+	return nr.nodesCredentials.ReloadNodes(nodes)
+}
+
+func (nr *nodesReloader) restoreObservers(observers []*data.NodeData) {
+	err := nr.observersProvider.ReloadNodes(observers)
+	if err != nil {
+		log.Error("cannot restore the observers", "error", err.Error())
+	}
+}
+
+// restoreNodesCredentials puts back the credentials of the current nodes, which were valid when they were loaded
+func (nr *nodesReloader) restoreNodesCredentials(observers []*data.NodeData, fullHistoryNodes []*data.NodeData) {
+	err := nr.reloadNodesCredentials(observers, fullHistoryNodes)
+	if err != nil {
+		log.Error("cannot restore the nodes credentials", "error", err.Error())
+	}
+}
+
+// StartWatchingConfigFile will check the config file at the given interval and will reload the nodes whenever
+// the file gets modified
+func (nr *nodesReloader) StartWatchingConfigFile(checkInterval time.Duration) error {
+	if checkInterval <= 0 {
+		return ErrInvalidFileCheckInterval
+	}
+
+	go func() {
+		for {
+			time.Sleep(checkInterval)
+
+			if !nr.configFileChanged() {
+				continue
+			}
+
+			log.Info("config file changed, reloading nodes", "file", nr.configFilePath)
+			err := nr.ReloadNodes()
+			if err != nil {
+				log.Warn("cannot reload nodes", "error", err.Error())
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (nr *nodesReloader) configFileChanged() bool {
+	modTime := getModTime(nr.configFilePath)
+	if modTime.IsZero() || modTime.Equal(nr.lastModTime) {
+		return false
+	}
+
+	nr.lastModTime = modTime
+
+	return true
+}
+
+func getModTime(filePath string) time.Time {
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return fileInfo.ModTime()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nr *nodesReloader) IsInterfaceNil() bool {
+	return nr == nil
+}
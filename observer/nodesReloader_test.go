@@ -0,0 +1,337 @@
+package observer
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const configWithNodes = `
+[[Observers]]
+   ShardId = 0
+   Address = "http://127.0.0.1:8081"
+
+[[Observers]]
+   ShardId = 1
+   Address = "http://127.0.0.1:8082"
+
+[[FullHistoryNodes]]
+   ShardId = 0
+   Address = "http://127.0.0.1:8083"
+`
+
+const configWithoutFullHistoryNodes = `
+[[Observers]]
+   ShardId = 0
+   Address = "http://127.0.0.1:8084"
+`
+
+const configWithoutShardId = `
+[[Observers]]
+   Address = "http://127.0.0.1:8085"
+`
+
+func writeConfigFile(t *testing.T, dir string, content string) string {
+	filePath := filepath.Join(dir, "config.toml")
+	err := ioutil.WriteFile(filePath, []byte(content), os.ModePerm)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+type nodesCredentialsStub struct {
+	reloadedNodes []*data.NodeData
+	err           error
+}
+
+func (ncs *nodesCredentialsStub) ReloadNodes(nodes []*data.NodeData) error {
+	if ncs.err != nil {
+		return ncs.err
+	}
+
+	ncs.reloadedNodes = nodes
+	return nil
+}
+
+func (ncs *nodesCredentialsStub) IsInterfaceNil() bool {
+	return ncs == nil
+}
+
+type shardDiscovererStub struct {
+	discoverNodesShardsCalled func(observers []*data.NodeData, fullHistoryNodes []*data.NodeData) ([]*data.NodeData, []*data.NodeData, uint32)
+}
+
+func (sds *shardDiscovererStub) DiscoverNodesShards(
+	observers []*data.NodeData,
+	fullHistoryNodes []*data.NodeData,
+) ([]*data.NodeData, []*data.NodeData, uint32) {
+	if sds.discoverNodesShardsCalled != nil {
+		return sds.discoverNodesShardsCalled(observers, fullHistoryNodes)
+	}
+
+	return observers, fullHistoryNodes, 0
+}
+
+func (sds *shardDiscovererStub) IsInterfaceNil() bool {
+	return sds == nil
+}
+
+func createArgsNodesReloader(configFilePath string) ArgsNodesReloader {
+	observersProvider, _ := NewSimpleNodesProvider([]*data.NodeData{{Address: "old observer", ShardId: 0}})
+	fullHistoryNodesProvider, _ := NewSimpleNodesProvider([]*data.NodeData{{Address: "old full history node", ShardId: 0}})
+
+	return ArgsNodesReloader{
+		ConfigFilePath:           configFilePath,
+		ObserversProvider:        observersProvider,
+		FullHistoryNodesProvider: fullHistoryNodesProvider,
+		NodesCredentials:         &nodesCredentialsStub{},
+		ShardDiscoverer:          &shardDiscovererStub{},
+	}
+}
+
+func TestNewNodesReloader_EmptyConfigFilePathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nr, err := NewNodesReloader(createArgsNodesReloader(""))
+	assert.True(t, check.IfNil(nr))
+	assert.Equal(t, ErrEmptyConfigFilePath, err)
+}
+
+func TestNewNodesReloader_NilProvidersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesReloader("config.toml")
+	args.ObserversProvider = nil
+	nr, err := NewNodesReloader(args)
+	assert.True(t, check.IfNil(nr))
+	assert.True(t, errors.Is(err, ErrNilNodesProvider))
+
+	args = createArgsNodesReloader("config.toml")
+	args.FullHistoryNodesProvider = nil
+	nr, err = NewNodesReloader(args)
+	assert.True(t, check.IfNil(nr))
+	assert.True(t, errors.Is(err, ErrNilNodesProvider))
+}
+
+func TestNewNodesReloader_NilNodesCredentialsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesReloader("config.toml")
+	args.NodesCredentials = nil
+	nr, err := NewNodesReloader(args)
+	assert.True(t, check.IfNil(nr))
+	assert.Equal(t, ErrNilNodesCredentials, err)
+}
+
+func TestNewNodesReloader_NilShardDiscovererShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesReloader("config.toml")
+	args.ShardDiscoverer = nil
+	nr, err := NewNodesReloader(args)
+	assert.True(t, check.IfNil(nr))
+	assert.Equal(t, ErrNilShardDiscoverer, err)
+}
+
+func TestNodesReloader_ReloadNodesShouldReloadTheCredentialsOfAllTheNodes(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesReloader(writeConfigFile(t, t.TempDir(), configWithoutFullHistoryNodes))
+	credentials := &nodesCredentialsStub{}
+	args.NodesCredentials = credentials
+	nr, _ := NewNodesReloader(args)
+
+	err := nr.ReloadNodes()
+	require.Nil(t, err)
+
+	configuredShardId := uint32(0)
+	expectedNodes := []*data.NodeData{
+		{Address: "http://127.0.0.1:8084", ShardId: 0, ConfiguredShardId: &configuredShardId},
+		{Address: "old full history node", ShardId: 0},
+	}
+	assert.Equal(t, expectedNodes, credentials.reloadedNodes)
+}
+
+func TestNodesReloader_ReloadNodesWithInvalidCredentialsShouldErrAndKeepTheCurrentNodes(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createArgsNodesReloader(writeConfigFile(t, t.TempDir(), configWithNodes))
+	args.NodesCredentials = &nodesCredentialsStub{err: expectedErr}
+	nr, _ := NewNodesReloader(args)
+
+	err := nr.ReloadNodes()
+	assert.True(t, errors.Is(err, expectedErr))
+
+	observers, _ := args.ObserversProvider.GetAllConfiguredNodes()
+	assert.Equal(t, []*data.NodeData{{Address: "old observer", ShardId: 0}}, observers)
+}
+
+func TestNodesReloader_ReloadNodesShouldReplaceNodes(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesReloader(writeConfigFile(t, t.TempDir(), configWithNodes))
+	nr, _ := NewNodesReloader(args)
+
+	err := nr.ReloadNodes()
+	require.Nil(t, err)
+
+	observers, _ := args.ObserversProvider.GetAllConfiguredNodes()
+	require.Equal(t, 2, len(observers))
+	assert.Equal(t, "http://127.0.0.1:8081", observers[0].Address)
+	assert.Equal(t, "http://127.0.0.1:8082", observers[1].Address)
+
+	fullHistoryNodes, _ := args.FullHistoryNodesProvider.GetAllConfiguredNodes()
+	require.Equal(t, 1, len(fullHistoryNodes))
+	assert.Equal(t, "http://127.0.0.1:8083", fullHistoryNodes[0].Address)
+}
+
+func TestNodesReloader_ReloadNodesShouldTellTheConfiguredShards(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesReloader(writeConfigFile(t, t.TempDir(), configWithNodes))
+	nr, _ := NewNodesReloader(args)
+	err := nr.ReloadNodes()
+	require.Nil(t, err)
+
+	observers, _ := args.ObserversProvider.GetAllConfiguredNodes()
+	require.NotNil(t, observers[1].ConfiguredShardId)
+	assert.Equal(t, uint32(1), *observers[1].ConfiguredShardId)
+	assert.Equal(t, uint32(1), observers[1].ShardId)
+
+	args = createArgsNodesReloader(writeConfigFile(t, t.TempDir(), configWithoutShardId))
+	nr, _ = NewNodesReloader(args)
+	err = nr.ReloadNodes()
+	require.Nil(t, err)
+
+	observers, _ = args.ObserversProvider.GetAllConfiguredNodes()
+	assert.Nil(t, observers[0].ConfiguredShardId)
+	assert.Equal(t, uint32(0), observers[0].ShardId)
+}
+
+func TestNodesReloader_ReloadNodesShouldDiscoverTheShardsOfTheNewNodes(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesReloader(writeConfigFile(t, t.TempDir(), configWithNodes))
+	args.ShardDiscoverer = &shardDiscovererStub{
+		discoverNodesShardsCalled: func(observers []*data.NodeData, fullHistoryNodes []*data.NodeData) ([]*data.NodeData, []*data.NodeData, uint32) {
+			fullHistoryNodes[0].ShardId = 1
+			return observers[1:], fullHistoryNodes, 2
+		},
+	}
+	nr, _ := NewNodesReloader(args)
+
+	err := nr.ReloadNodes()
+	require.Nil(t, err)
+
+	observers, _ := args.ObserversProvider.GetAllConfiguredNodes()
+	require.Equal(t, 1, len(observers))
+	assert.Equal(t, "http://127.0.0.1:8082", observers[0].Address)
+
+	fullHistoryNodes, _ := args.FullHistoryNodesProvider.GetAllConfiguredNodes()
+	require.Equal(t, 1, len(fullHistoryNodes))
+	assert.Equal(t, uint32(1), fullHistoryNodes[0].ShardId)
+}
+
+func TestNodesReloader_ReloadNodesWithAllTheNodesRejectedShouldErrAndKeepTheCurrentNodesAndCredentials(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesReloader(writeConfigFile(t, t.TempDir(), configWithoutShardId))
+	credentials := &nodesCredentialsStub{}
+	args.NodesCredentials = credentials
+	args.ShardDiscoverer = &shardDiscovererStub{
+		discoverNodesShardsCalled: func(_ []*data.NodeData, fullHistoryNodes []*data.NodeData) ([]*data.NodeData, []*data.NodeData, uint32) {
+			return make([]*data.NodeData, 0), fullHistoryNodes, 0
+		},
+	}
+	nr, _ := NewNodesReloader(args)
+
+	err := nr.ReloadNodes()
+	assert.True(t, errors.Is(err, ErrEmptyObserversList))
+
+	currentObservers := []*data.NodeData{{Address: "old observer", ShardId: 0}}
+	observers, _ := args.ObserversProvider.GetAllConfiguredNodes()
+	assert.Equal(t, currentObservers, observers)
+
+	expectedCredentials := []*data.NodeData{{Address: "old observer", ShardId: 0}, {Address: "old full history node", ShardId: 0}}
+	assert.Equal(t, expectedCredentials, credentials.reloadedNodes)
+}
+
+func TestNodesReloader_ReloadNodesWithoutFullHistoryNodesShouldKeepTheCurrentOnes(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesReloader(writeConfigFile(t, t.TempDir(), configWithoutFullHistoryNodes))
+	nr, _ := NewNodesReloader(args)
+
+	err := nr.ReloadNodes()
+	require.Nil(t, err)
+
+	configuredShardId := uint32(0)
+	observers, _ := args.ObserversProvider.GetAllConfiguredNodes()
+	assert.Equal(t, []*data.NodeData{{Address: "http://127.0.0.1:8084", ShardId: 0, ConfiguredShardId: &configuredShardId}}, observers)
+
+	fullHistoryNodes, _ := args.FullHistoryNodesProvider.GetAllConfiguredNodes()
+	assert.Equal(t, []*data.NodeData{{Address: "old full history node", ShardId: 0}}, fullHistoryNodes)
+}
+
+func TestNodesReloader_ReloadNodesWithoutObserversShouldErrAndKeepTheCurrentOnes(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesReloader(writeConfigFile(t, t.TempDir(), "[GeneralSettings]\n"))
+	nr, _ := NewNodesReloader(args)
+
+	err := nr.ReloadNodes()
+	assert.True(t, errors.Is(err, ErrEmptyObserversList))
+
+	observers, _ := args.ObserversProvider.GetAllConfiguredNodes()
+	assert.Equal(t, []*data.NodeData{{Address: "old observer", ShardId: 0}}, observers)
+}
+
+func TestNodesReloader_FailedFullHistoryNodesReloadShouldKeepTheCurrentNodesAndCredentials(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesReloader(writeConfigFile(t, t.TempDir(), configWithNodes))
+	args.FullHistoryNodesProvider = NewDisabledNodesProvider("no full history nodes")
+	credentials := &nodesCredentialsStub{}
+	args.NodesCredentials = credentials
+	nr, _ := NewNodesReloader(args)
+
+	err := nr.ReloadNodes()
+	assert.NotNil(t, err)
+
+	currentObservers := []*data.NodeData{{Address: "old observer", ShardId: 0}}
+	observers, _ := args.ObserversProvider.GetAllConfiguredNodes()
+	assert.Equal(t, currentObservers, observers)
+	assert.Equal(t, currentObservers, credentials.reloadedNodes)
+}
+
+func TestNodesReloader_StartWatchingConfigFileShouldReloadOnChange(t *testing.T) {
+	t.Parallel()
+
+	configFilePath := writeConfigFile(t, t.TempDir(), configWithoutFullHistoryNodes)
+	args := createArgsNodesReloader(configFilePath)
+	nr, _ := NewNodesReloader(args)
+
+	err := nr.StartWatchingConfigFile(0)
+	assert.Equal(t, ErrInvalidFileCheckInterval, err)
+
+	err = nr.StartWatchingConfigFile(10 * time.Millisecond)
+	require.Nil(t, err)
+
+	newModTime := time.Now().Add(time.Minute)
+	_ = writeConfigFile(t, filepath.Dir(configFilePath), configWithNodes)
+	_ = os.Chtimes(configFilePath, newModTime, newModTime)
+
+	assert.Eventually(t, func() bool {
+		observers, _ := args.ObserversProvider.GetAllConfiguredNodes()
+		return len(observers) == 2
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,129 @@
+package observer
+
+import (
+	"sync"
+	"time"
+)
+
+// ewmaSmoothingFactor is the weight of the newest sample in the exponentially weighted moving average of the latency
+const ewmaSmoothingFactor = 0.2
+
+// failedRequestLatency is the latency sample recorded for a failed request, unless the request took even longer, so a
+// node which fails fast is not preferred over the nodes which answer
+const failedRequestLatency = 5 * time.Second
+
+// RequestOutcome tells how a request sent to a node ended
+type RequestOutcome int
+
+const (
+	// RequestAnswered is the outcome of the requests the node answered, including the ones rejected because of their
+	// input (4xx)
+	RequestAnswered RequestOutcome = iota
+	// RequestFailed is the outcome of the requests which got no answer, timed out or got a 5xx status
+	RequestFailed
+	// RequestAborted is the outcome of the requests canceled by the proxy itself or never sent, which tell nothing
+	// about the node
+	RequestAborted
+)
+
+type nodeStatistics struct {
+	outstandingRequests uint32
+	averageLatency      time.Duration
+	hasSamples          bool
+}
+
+// nodesStatistics keeps, for each node, the number of in-flight requests and an exponentially weighted moving average
+// of the requests' latency
+type nodesStatistics struct {
+	mutStatistics sync.RWMutex
+	statistics    map[string]*nodeStatistics
+}
+
+// NewNodesStatistics returns a new instance of nodesStatistics
+func NewNodesStatistics() *nodesStatistics {
+	return &nodesStatistics{
+		statistics: make(map[string]*nodeStatistics),
+	}
+}
+
+// RequestStarted will increment the number of in-flight requests for the given node
+func (ns *nodesStatistics) RequestStarted(address string) {
+	ns.mutStatistics.Lock()
+	defer ns.mutStatistics.Unlock()
+
+	ns.getOrCreateStatistics(address).outstandingRequests++
+}
+
+// RequestFinished will decrement the number of in-flight requests for the given node and will update its average latency
+// with the request's duration, if it was answered, or with the failed request latency, if it failed. The aborted
+// requests do not change the average latency
+func (ns *nodesStatistics) RequestFinished(address string, duration time.Duration, outcome RequestOutcome) {
+	ns.mutStatistics.Lock()
+	defer ns.mutStatistics.Unlock()
+
+	stats := ns.getOrCreateStatistics(address)
+	if stats.outstandingRequests > 0 {
+		stats.outstandingRequests--
+	}
+
+	switch outcome {
+	case RequestAnswered:
+	case RequestFailed:
+		if duration < failedRequestLatency {
+			duration = failedRequestLatency
+		}
+	default:
+		return
+	}
+
+	if !stats.hasSamples {
+		stats.averageLatency = duration
+		stats.hasSamples = true
+		return
+	}
+
+	stats.averageLatency = time.Duration(ewmaSmoothingFactor*float64(duration) + (1-ewmaSmoothingFactor)*float64(stats.averageLatency))
+}
+
+// getOrCreateStatistics should be called under mutex protection
+func (ns *nodesStatistics) getOrCreateStatistics(address string) *nodeStatistics {
+	stats, found := ns.statistics[address]
+	if !found {
+		stats = &nodeStatistics{}
+		ns.statistics[address] = stats
+	}
+
+	return stats
+}
+
+// GetOutstandingRequests returns the number of in-flight requests for the given node
+func (ns *nodesStatistics) GetOutstandingRequests(address string) uint32 {
+	ns.mutStatistics.RLock()
+	defer ns.mutStatistics.RUnlock()
+
+	stats, found := ns.statistics[address]
+	if !found {
+		return 0
+	}
+
+	return stats.outstandingRequests
+}
+
+// GetAverageLatency returns the average latency of the requests sent to the given node. It returns 0 for the nodes
+// which haven't answered any request yet
+func (ns *nodesStatistics) GetAverageLatency(address string) time.Duration {
+	ns.mutStatistics.RLock()
+	defer ns.mutStatistics.RUnlock()
+
+	stats, found := ns.statistics[address]
+	if !found {
+		return 0
+	}
+
+	return stats.averageLatency
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ns *nodesStatistics) IsInterfaceNil() bool {
+	return ns == nil
+}
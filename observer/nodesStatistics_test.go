@@ -0,0 +1,67 @@
+package observer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodesStatistics_ShouldTrackOutstandingRequests(t *testing.T) {
+	t.Parallel()
+
+	ns := NewNodesStatistics()
+	ns.RequestStarted("addr1")
+	ns.RequestStarted("addr1")
+	assert.Equal(t, uint32(2), ns.GetOutstandingRequests("addr1"))
+	assert.Equal(t, uint32(0), ns.GetOutstandingRequests("addr2"))
+
+	ns.RequestFinished("addr1", time.Millisecond, RequestAnswered)
+	ns.RequestFinished("addr1", time.Millisecond, RequestAnswered)
+	ns.RequestFinished("addr1", time.Millisecond, RequestAnswered)
+	assert.Equal(t, uint32(0), ns.GetOutstandingRequests("addr1"))
+}
+
+func TestNodesStatistics_ShouldComputeMovingAverageLatency(t *testing.T) {
+	t.Parallel()
+
+	ns := NewNodesStatistics()
+	assert.Equal(t, time.Duration(0), ns.GetAverageLatency("addr1"))
+
+	ns.RequestStarted("addr1")
+	ns.RequestFinished("addr1", 100*time.Millisecond, RequestAnswered)
+	assert.Equal(t, 100*time.Millisecond, ns.GetAverageLatency("addr1"))
+
+	ns.RequestStarted("addr1")
+	ns.RequestFinished("addr1", 200*time.Millisecond, RequestAnswered)
+	assert.Equal(t, 120*time.Millisecond, ns.GetAverageLatency("addr1"))
+}
+
+func TestNodesStatistics_FailedRequestsShouldRecordTheFailedRequestLatency(t *testing.T) {
+	t.Parallel()
+
+	ns := NewNodesStatistics()
+	ns.RequestStarted("addr1")
+	ns.RequestFinished("addr1", time.Millisecond, RequestFailed)
+	assert.Equal(t, failedRequestLatency, ns.GetAverageLatency("addr1"))
+
+	ns.RequestStarted("addr2")
+	ns.RequestFinished("addr2", 2*failedRequestLatency, RequestFailed)
+	assert.Equal(t, 2*failedRequestLatency, ns.GetAverageLatency("addr2"))
+}
+
+func TestNodesStatistics_AbortedRequestsShouldNotChangeTheAverageLatency(t *testing.T) {
+	t.Parallel()
+
+	ns := NewNodesStatistics()
+	ns.RequestStarted("addr1")
+	ns.RequestFinished("addr1", time.Millisecond, RequestAborted)
+	assert.Equal(t, time.Duration(0), ns.GetAverageLatency("addr1"))
+	assert.Equal(t, uint32(0), ns.GetOutstandingRequests("addr1"))
+
+	ns.RequestStarted("addr1")
+	ns.RequestFinished("addr1", 100*time.Millisecond, RequestAnswered)
+	ns.RequestStarted("addr1")
+	ns.RequestFinished("addr1", time.Millisecond, RequestAborted)
+	assert.Equal(t, 100*time.Millisecond, ns.GetAverageLatency("addr1"))
+}
@@ -29,7 +29,7 @@ func (snp *simpleNodesProvider) GetNodesByShardId(shardId uint32) ([]*data.NodeD
 	snp.mutNodes.RLock()
 	defer snp.mutNodes.RUnlock()
 
-	nodesForShard, ok := snp.nodes[shardId]
+	nodesForShard, ok := snp.healthyNodes[shardId]
 	if !ok {
 		return nil, ErrShardNotAvailable
 	}
@@ -37,12 +37,27 @@ func (snp *simpleNodesProvider) GetNodesByShardId(shardId uint32) ([]*data.NodeD
 	return nodesForShard, nil
 }
 
+// GetNodesByShardIdForKey will return the same nodes as GetNodesByShardId, as this strategy does not map keys to nodes
+func (snp *simpleNodesProvider) GetNodesByShardIdForKey(shardId uint32, _ string) ([]*data.NodeData, error) {
+	return snp.GetNodesByShardId(shardId)
+}
+
 // GetAllNodes will return a slice containing all the nodes
 func (snp *simpleNodesProvider) GetAllNodes() ([]*data.NodeData, error) {
 	snp.mutNodes.RLock()
 	defer snp.mutNodes.RUnlock()
 
-	return snp.allNodes, nil
+	return snp.allHealthyNodes, nil
+}
+
+// GetNodesByShardIdWithTags will return the nodes GetNodesByShardIdForKey returns which have all the required tags
+func (snp *simpleNodesProvider) GetNodesByShardIdWithTags(shardId uint32, key string, requiredTags []string) ([]*data.NodeData, error) {
+	return getNodesByShardIdWithTags(shardId, key, requiredTags, snp.GetNodesByShardIdForKey)
+}
+
+// GetAllNodesWithTags will return the nodes GetAllNodes returns which have all the required tags
+func (snp *simpleNodesProvider) GetAllNodesWithTags(requiredTags []string) ([]*data.NodeData, error) {
+	return getAllNodesWithTags(requiredTags, snp.GetAllNodes)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -189,3 +189,21 @@ func TestSimpleObserversProvider_GetAllObservers_ConcurrentSafe(t *testing.T) {
 	}
 	mutMap.RUnlock()
 }
+
+func TestSimpleObserversProvider_GetObserversByShardIdShouldSkipUnhealthyNodes(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+	}
+	sop, _ := NewSimpleNodesProvider(observers)
+	sop.UpdateNodesHealth(map[string]struct{}{"addr1": {}})
+
+	res, err := sop.GetNodesByShardId(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []*data.NodeData{{Address: "addr2", ShardId: 0}}, res)
+
+	res, _ = sop.GetAllNodes()
+	assert.Equal(t, []*data.NodeData{{Address: "addr2", ShardId: 0}}, res)
+}
@@ -0,0 +1,112 @@
+package observer
+
+import (
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// weightedRoundRobinNodesProvider will handle the providing of nodes using a smooth weighted round-robin, so a node
+// will receive requests proportionally to its configured weight. The nodes without a configured weight have weight 1
+type weightedRoundRobinNodesProvider struct {
+	*baseNodeProvider
+	currentWeightsForShards   map[uint32]map[string]int64
+	currentWeightsForAllNodes map[string]int64
+}
+
+// NewWeightedRoundRobinNodesProvider returns a new instance of weightedRoundRobinNodesProvider
+func NewWeightedRoundRobinNodesProvider(nodes []*data.NodeData) (*weightedRoundRobinNodesProvider, error) {
+	bop := &baseNodeProvider{}
+
+	err := bop.initNodesMaps(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weightedRoundRobinNodesProvider{
+		baseNodeProvider:          bop,
+		currentWeightsForShards:   make(map[uint32]map[string]int64),
+		currentWeightsForAllNodes: make(map[string]int64),
+	}, nil
+}
+
+// GetNodesByShardId will return a slice of the nodes for the given shard, starting with the selected node
+func (wrrnp *weightedRoundRobinNodesProvider) GetNodesByShardId(shardId uint32) ([]*data.NodeData, error) {
+	wrrnp.mutNodes.Lock()
+	defer wrrnp.mutNodes.Unlock()
+
+	nodesForShard := wrrnp.healthyNodes[shardId]
+	if len(nodesForShard) == 0 {
+		return nil, ErrShardNotAvailable
+	}
+
+	currentWeights, found := wrrnp.currentWeightsForShards[shardId]
+	if !found {
+		currentWeights = make(map[string]int64)
+		wrrnp.currentWeightsForShards[shardId] = currentWeights
+	}
+
+	position := selectWeightedNode(nodesForShard, currentWeights)
+
+	return rotateNodes(nodesForShard, position), nil
+}
+
+// GetNodesByShardIdForKey will return the same nodes as GetNodesByShardId, as this strategy does not map keys to nodes
+func (wrrnp *weightedRoundRobinNodesProvider) GetNodesByShardIdForKey(shardId uint32, _ string) ([]*data.NodeData, error) {
+	return wrrnp.GetNodesByShardId(shardId)
+}
+
+// GetAllNodes will return a slice containing all the nodes, starting with the selected node
+func (wrrnp *weightedRoundRobinNodesProvider) GetAllNodes() ([]*data.NodeData, error) {
+	wrrnp.mutNodes.Lock()
+	defer wrrnp.mutNodes.Unlock()
+
+	allNodes := wrrnp.allHealthyNodes
+	position := selectWeightedNode(allNodes, wrrnp.currentWeightsForAllNodes)
+
+	return rotateNodes(allNodes, position), nil
+}
+
+// GetNodesByShardIdWithTags will return the nodes GetNodesByShardIdForKey returns which have all the required tags
+func (wrrnp *weightedRoundRobinNodesProvider) GetNodesByShardIdWithTags(shardId uint32, key string, requiredTags []string) ([]*data.NodeData, error) {
+	return getNodesByShardIdWithTags(shardId, key, requiredTags, wrrnp.GetNodesByShardIdForKey)
+}
+
+// GetAllNodesWithTags will return the nodes GetAllNodes returns which have all the required tags
+func (wrrnp *weightedRoundRobinNodesProvider) GetAllNodesWithTags(requiredTags []string) ([]*data.NodeData, error) {
+	return getAllNodesWithTags(requiredTags, wrrnp.GetAllNodes)
+}
+
+// selectWeightedNode returns the position of the selected node. The current weights are kept by address so they
+// survive the changes in the nodes lists (reloads or health updates)
+func selectWeightedNode(nodes []*data.NodeData, currentWeights map[string]int64) uint32 {
+	if len(nodes) == 0 {
+		return 0
+	}
+
+	totalWeight := int64(0)
+	selectedPosition := 0
+	for i, node := range nodes {
+		weight := getWeight(node)
+		currentWeights[node.Address] += weight
+		totalWeight += weight
+
+		if currentWeights[node.Address] > currentWeights[nodes[selectedPosition].Address] {
+			selectedPosition = i
+		}
+	}
+	currentWeights[nodes[selectedPosition].Address] -= totalWeight
+
+	return uint32(selectedPosition)
+}
+
+func getWeight(node *data.NodeData) int64 {
+	if node.Weight == 0 {
+		return 1
+	}
+
+	return int64(node.Weight)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrrnp *weightedRoundRobinNodesProvider) IsInterfaceNil() bool {
+	return wrrnp == nil
+}
@@ -0,0 +1,97 @@
+package observer
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWeightedRoundRobinNodesProvider_EmptyNodesListShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrrnp, err := NewWeightedRoundRobinNodesProvider(nil)
+	assert.Nil(t, wrrnp)
+	assert.Equal(t, ErrEmptyObserversList, err)
+}
+
+func TestNewWeightedRoundRobinNodesProvider_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	wrrnp, err := NewWeightedRoundRobinNodesProvider(getDummyConfig().Observers)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(wrrnp))
+}
+
+func TestWeightedRoundRobinNodesProvider_GetNodesByShardIdShouldRespectWeights(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "addr1", ShardId: 0, Weight: 5},
+		{Address: "addr2", ShardId: 0, Weight: 2},
+		{Address: "addr3", ShardId: 0},
+		{Address: "addr4", ShardId: 1},
+	}
+	wrrnp, _ := NewWeightedRoundRobinNodesProvider(nodes)
+
+	numRounds := 10
+	numCalls := make(map[string]int)
+	for i := 0; i < numRounds*8; i++ {
+		res, err := wrrnp.GetNodesByShardId(0)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(res))
+		numCalls[res[0].Address]++
+	}
+
+	assert.Equal(t, 5*numRounds, numCalls["addr1"])
+	assert.Equal(t, 2*numRounds, numCalls["addr2"])
+	assert.Equal(t, numRounds, numCalls["addr3"])
+}
+
+func TestWeightedRoundRobinNodesProvider_GetNodesByShardIdShouldInterleaveNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "addr1", ShardId: 0, Weight: 2},
+		{Address: "addr2", ShardId: 0, Weight: 1},
+	}
+	wrrnp, _ := NewWeightedRoundRobinNodesProvider(nodes)
+
+	expectedOrder := []string{"addr1", "addr2", "addr1", "addr1", "addr2", "addr1"}
+	for _, expectedAddress := range expectedOrder {
+		res, _ := wrrnp.GetNodesByShardId(0)
+		assert.Equal(t, expectedAddress, res[0].Address)
+	}
+}
+
+func TestWeightedRoundRobinNodesProvider_GetAllNodesShouldRespectWeights(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{
+		{Address: "addr1", ShardId: 0, Weight: 3},
+		{Address: "addr2", ShardId: 1, Weight: 1},
+	}
+	wrrnp, _ := NewWeightedRoundRobinNodesProvider(nodes)
+
+	numCalls := make(map[string]int)
+	for i := 0; i < 8; i++ {
+		res, err := wrrnp.GetAllNodes()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		numCalls[res[0].Address]++
+	}
+
+	assert.Equal(t, 6, numCalls["addr1"])
+	assert.Equal(t, 2, numCalls["addr2"])
+}
+
+func TestWeightedRoundRobinNodesProvider_GetNodesByShardIdInvalidShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrrnp, _ := NewWeightedRoundRobinNodesProvider(getDummyConfig().Observers)
+
+	res, err := wrrnp.GetNodesByShardId(37)
+	assert.Nil(t, res)
+	assert.Equal(t, ErrShardNotAvailable, err)
+}
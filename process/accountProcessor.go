@@ -1,9 +1,9 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"net/http"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -50,116 +50,137 @@ func (ap *AccountProcessor) GetShardIDForAddress(address string) (uint32, error)
 }
 
 // GetAccount resolves the request by sending the request to the right observer and replies back the answer
-func (ap *AccountProcessor) GetAccount(address string) (*data.Account, error) {
-	observers, err := ap.getObserversForAddress(address)
+func (ap *AccountProcessor) GetAccount(ctx context.Context, address string) (*data.Account, error) {
+	observers, err := ap.getObserversForAddress(ctx, address)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, observer := range observers {
+	readAccount := func(ctx context.Context, observer *data.NodeData) (interface{}, int, error) {
 		responseAccount := &data.AccountApiResponse{}
-
-		_, err = ap.proc.CallGetRestEndPoint(observer.Address, AddressPath+address, responseAccount)
-		if err == nil {
-			log.Info("account request", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
-			return &responseAccount.Data.AccountData, nil
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, AddressPath+address, responseAccount)
+		return responseAccount, respCode, err
+	}
+	response, err := ap.proc.ReadFromObserversWithQuorum(ctx, QuorumEndpointAccounts, observers, readAccount, isAnswerOrRequestError, accountDigest)
+	if err != nil {
+		log.Error("account request", "address", address, "error", err.Error())
+		if errors.Is(err, ErrObserversDisagreement) || errors.Is(err, ErrObserversOverloaded) {
+			return nil, err
 		}
-
-		log.Error("account request", "observer", observer.Address, "address", address, "error", err.Error())
+		return nil, ErrSendingRequest
+	}
+	if response.Err != nil {
+		log.Info("account request rejected", "address", address, "http code", response.StatusCode,
+			"error", response.Err.Error())
+		return nil, response.Err
 	}
 
-	return nil, ErrSendingRequest
+	log.Info("account request", "address", address, "shard ID", response.Observer.ShardId, "observer", response.Observer.Address)
+	responseAccount := response.Value.(*data.AccountApiResponse)
+	return &responseAccount.Data.AccountData, nil
+}
+
+// accountDigest describes the state of an account, as compared between the observers of a quorum read
+func accountDigest(value interface{}) (string, error) {
+	account := value.(*data.AccountApiResponse).Data.AccountData
+
+	return fmt.Sprintf("nonce %d, balance %s, username %s, code hash %x, root hash %x",
+		account.Nonce, account.Balance, account.Username, account.CodeHash, account.RootHash), nil
 }
 
 // GetValueForKey returns the value for the given address and key
-func (ap *AccountProcessor) GetValueForKey(address string, key string) (string, error) {
-	observers, err := ap.getObserversForAddress(address)
+func (ap *AccountProcessor) GetValueForKey(ctx context.Context, address string, key string) (string, error) {
+	observers, err := ap.getObserversForAddress(ctx, address)
 	if err != nil {
 		return "", err
 	}
 
-	for _, observer := range observers {
-		apiResponse := data.AccountKeyValueResponse{}
-		apiPath := AddressPath + address + "/key/" + key
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
-		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account value for key request",
-				"address", address,
-				"shard ID", observer.ShardId,
-				"observer", observer.Address,
-				"http code", respCode)
-			if apiResponse.Error != "" {
-				return "", errors.New(apiResponse.Error)
-			}
-
-			return apiResponse.Data.Value, nil
-		}
+	apiPath := AddressPath + address + "/key/" + key
+	readValue := func(ctx context.Context, observer *data.NodeData) (interface{}, int, error) {
+		apiResponse := &data.AccountKeyValueResponse{}
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, apiResponse)
+		return apiResponse, respCode, err
+	}
+	response, err := ap.proc.ReadFromObservers(ctx, observers, readValue, isAnswerOrRequestError)
+	if err != nil {
+		log.Error("account value for key request", "address", address, "error", err.Error())
+		return "", ErrSendingRequest
+	}
 
-		log.Error("account value for key request", "observer", observer.Address, "address", address, "error", err.Error())
+	log.Info("account value for key request",
+		"address", address,
+		"shard ID", response.Observer.ShardId,
+		"observer", response.Observer.Address,
+		"http code", response.StatusCode)
+	apiResponse := response.Value.(*data.AccountKeyValueResponse)
+	if apiResponse.Error != "" {
+		return "", errors.New(apiResponse.Error)
 	}
 
-	return "", ErrSendingRequest
+	return apiResponse.Data.Value, nil
 }
 
 // GetESDTTokenData returns the token data for a token with the given name
-func (ap *AccountProcessor) GetESDTTokenData(address string, key string) (*data.GenericAPIResponse, error) {
-	observers, err := ap.getObserversForAddress(address)
+func (ap *AccountProcessor) GetESDTTokenData(ctx context.Context, address string, key string) (*data.GenericAPIResponse, error) {
+	observers, err := ap.getObserversForAddress(ctx, address)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, observer := range observers {
-		apiResponse := data.GenericAPIResponse{}
-		apiPath := AddressPath + address + "/esdt/" + key
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
-		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account all ESDT token data error",
-				"address", address,
-				"token", key,
-				"shard ID", observer.ShardId,
-				"observer", observer.Address,
-				"http code", respCode)
-			if apiResponse.Error != "" {
-				return nil, errors.New(apiResponse.Error)
-			}
-
-			return &apiResponse, nil
-		}
+	apiPath := AddressPath + address + "/esdt/" + key
+	response, err := ap.proc.ReadFromObservers(ctx, observers, ap.genericRead(apiPath), isAnswerOrRequestError)
+	if err != nil {
+		log.Error("account get ESDT token data error", "address", address, "error", err.Error())
+		return nil, ErrSendingRequest
+	}
 
-		log.Error("account get ESDT token data error", "observer", observer.Address, "address", address, "error", err.Error())
+	log.Info("account all ESDT token data error",
+		"address", address,
+		"token", key,
+		"shard ID", response.Observer.ShardId,
+		"observer", response.Observer.Address,
+		"http code", response.StatusCode)
+	apiResponse := response.Value.(*data.GenericAPIResponse)
+	if apiResponse.Error != "" {
+		return nil, errors.New(apiResponse.Error)
 	}
 
-	return nil, ErrSendingRequest
+	return apiResponse, nil
 }
 
 // GetAllESDTTokens returns all the tokens for a given address
-func (ap *AccountProcessor) GetAllESDTTokens(address string) (*data.GenericAPIResponse, error) {
-	observers, err := ap.getObserversForAddress(address)
+func (ap *AccountProcessor) GetAllESDTTokens(ctx context.Context, address string) (*data.GenericAPIResponse, error) {
+	observers, err := ap.getObserversForAddress(ctx, address)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, observer := range observers {
-		apiResponse := data.GenericAPIResponse{}
-		apiPath := AddressPath + address + "/esdt"
-		respCode, err := ap.proc.CallGetRestEndPoint(observer.Address, apiPath, &apiResponse)
-		if err == nil || respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
-			log.Info("account all ESDT tokens error",
-				"address", address,
-				"shard ID", observer.ShardId,
-				"observer", observer.Address,
-				"http code", respCode)
-			if apiResponse.Error != "" {
-				return nil, errors.New(apiResponse.Error)
-			}
-
-			return &apiResponse, nil
-		}
+	apiPath := AddressPath + address + "/esdt"
+	response, err := ap.proc.ReadFromObservers(ctx, observers, ap.genericRead(apiPath), isAnswerOrRequestError)
+	if err != nil {
+		log.Error("account get all ESDT tokens error", "address", address, "error", err.Error())
+		return nil, ErrSendingRequest
+	}
 
-		log.Error("account get all ESDT tokens error", "observer", observer.Address, "address", address, "error", err.Error())
+	log.Info("account all ESDT tokens error",
+		"address", address,
+		"shard ID", response.Observer.ShardId,
+		"observer", response.Observer.Address,
+		"http code", response.StatusCode)
+	apiResponse := response.Value.(*data.GenericAPIResponse)
+	if apiResponse.Error != "" {
+		return nil, errors.New(apiResponse.Error)
 	}
 
-	return nil, ErrSendingRequest
+	return apiResponse, nil
+}
+
+func (ap *AccountProcessor) genericRead(apiPath string) func(ctx context.Context, observer *data.NodeData) (interface{}, int, error) {
+	return func(ctx context.Context, observer *data.NodeData) (interface{}, int, error) {
+		apiResponse := &data.GenericAPIResponse{}
+		respCode, err := ap.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, apiResponse)
+		return apiResponse, respCode, err
+	}
 }
 
 // GetTransactions resolves the request and returns a slice of transaction for the specific address
@@ -171,7 +192,7 @@ func (ap *AccountProcessor) GetTransactions(address string) ([]data.DatabaseTran
 	return ap.connector.GetTransactionsByAddress(address)
 }
 
-func (ap *AccountProcessor) getObserversForAddress(address string) ([]*data.NodeData, error) {
+func (ap *AccountProcessor) getObserversForAddress(ctx context.Context, address string) ([]*data.NodeData, error) {
 	addressBytes, err := ap.pubKeyConverter.Decode(address)
 	if err != nil {
 		return nil, err
@@ -182,7 +203,7 @@ func (ap *AccountProcessor) getObserversForAddress(address string) ([]*data.Node
 		return nil, err
 	}
 
-	observers, err := ap.proc.GetObservers(shardID)
+	observers, err := ap.proc.GetNodesForRequest(ctx, RequestCategoryAccount, shardID, address)
 	if err != nil {
 		return nil, err
 	}
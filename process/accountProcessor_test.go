@@ -1,7 +1,10 @@
 package process_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -49,7 +52,7 @@ func TestAccountProcessor_GetAccountInvalidHexAddressShouldErr(t *testing.T) {
 	t.Parallel()
 
 	ap, _ := process.NewAccountProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, database.NewDisabledElasticSearchConnector())
-	accnt, err := ap.GetAccount("invalid hex number")
+	accnt, err := ap.GetAccount(context.Background(), "invalid hex number")
 
 	assert.Nil(t, accnt)
 	assert.NotNil(t, err)
@@ -70,7 +73,7 @@ func TestAccountProcessor_GetAccountComputeShardIdFailsShouldErr(t *testing.T) {
 		database.NewDisabledElasticSearchConnector(),
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address)
+	accnt, err := ap.GetAccount(context.Background(), address)
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -93,7 +96,7 @@ func TestAccountProcessor_GetAccountGetObserversFailsShouldErr(t *testing.T) {
 		database.NewDisabledElasticSearchConnector(),
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address)
+	accnt, err := ap.GetAccount(context.Background(), address)
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, errExpected, err)
@@ -114,7 +117,7 @@ func TestAccountProcessor_GetAccountSendingFailsOnAllObserversShouldErr(t *testi
 					{Address: "address2", ShardId: 0},
 				}, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 				return 0, errExpected
 			},
 		},
@@ -122,12 +125,71 @@ func TestAccountProcessor_GetAccountSendingFailsOnAllObserversShouldErr(t *testi
 		database.NewDisabledElasticSearchConnector(),
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address)
+	accnt, err := ap.GetAccount(context.Background(), address)
 
 	assert.Nil(t, accnt)
 	assert.Equal(t, process.ErrSendingRequest, err)
 }
 
+func TestAccountProcessor_GetAccountRejectedByTheObserverShouldNotRetry(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("invalid address")
+	numCalls := 0
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: "address1", ShardId: 0},
+					{Address: "address2", ShardId: 0},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
+				numCalls++
+				return http.StatusBadRequest, errExpected
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+	accnt, err := ap.GetAccount(context.Background(), "DEADBEEF")
+
+	assert.Nil(t, accnt)
+	assert.Equal(t, errExpected, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestAccountProcessor_GetAccountObserversDisagreementShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errDisagreement := fmt.Errorf("%w: 2 different answers", process.ErrObserversDisagreement)
+	usedEndpoint := ""
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{{Address: "address1", ShardId: 0}}, nil
+			},
+			ReadFromObserversWithQuorumCalled: func(_ context.Context, endpoint string, _ []*data.NodeData, _ func(ctx context.Context, observer *data.NodeData) (interface{}, int, error), _ func(responseCode int, err error) bool, _ func(value interface{}) (string, error)) (*data.ObserverResponse, error) {
+				usedEndpoint = endpoint
+				return nil, errDisagreement
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+	accnt, err := ap.GetAccount(context.Background(), "DEADBEEF")
+
+	assert.Nil(t, accnt)
+	assert.Equal(t, errDisagreement, err)
+	assert.Equal(t, process.QuorumEndpointAccounts, usedEndpoint)
+}
+
 func TestAccountProcessor_GetAccountSendingFailsOnFirstObserverShouldStillSend(t *testing.T) {
 	t.Parallel()
 
@@ -149,7 +211,7 @@ func TestAccountProcessor_GetAccountSendingFailsOnFirstObserverShouldStillSend(t
 					{Address: "adress2", ShardId: 0},
 				}, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 				if address == addressFail {
 					return 0, errExpected
 				}
@@ -163,12 +225,40 @@ func TestAccountProcessor_GetAccountSendingFailsOnFirstObserverShouldStillSend(t
 		database.NewDisabledElasticSearchConnector(),
 	)
 	address := "DEADBEEF"
-	accnt, err := ap.GetAccount(address)
+	accnt, err := ap.GetAccount(context.Background(), address)
 
 	assert.Equal(t, &respondedAccount.AccountData, accnt)
 	assert.Nil(t, err)
 }
 
+func TestAccountProcessor_GetAccountShouldPassTheContextToTheObservers(t *testing.T) {
+	t.Parallel()
+
+	type ctxKey string
+	ctx := context.WithValue(context.Background(), ctxKey("key"), "value")
+	var receivedCtx context.Context
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{{Address: "address", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(ctx context.Context, address string, path string, value interface{}) (int, error) {
+				receivedCtx = ctx
+				return 0, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+	_, err := ap.GetAccount(ctx, "DEADBEEF")
+
+	assert.Nil(t, err)
+	assert.Equal(t, ctx, receivedCtx)
+}
+
 func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -183,7 +273,7 @@ func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 					{Address: "address", ShardId: 0},
 				}, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 				valRespond := value.(*data.AccountKeyValueResponse)
 				valRespond.Data.Value = expectedValue
 				return 0, nil
@@ -195,7 +285,7 @@ func TestAccountProcessor_GetValueForAKeyShouldWork(t *testing.T) {
 
 	key := "key"
 	addr1 := "DEADBEEF"
-	value, err := ap.GetValueForKey(addr1, key)
+	value, err := ap.GetValueForKey(context.Background(), addr1, key)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedValue, value)
 }
@@ -214,7 +304,7 @@ func TestAccountProcessor_GetValueForAKeyShouldError(t *testing.T) {
 					{Address: "address", ShardId: 0},
 				}, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 				return 0, expectedError
 			},
 		},
@@ -224,7 +314,7 @@ func TestAccountProcessor_GetValueForAKeyShouldError(t *testing.T) {
 
 	key := "key"
 	addr1 := "DEADBEEF"
-	value, err := ap.GetValueForKey(addr1, key)
+	value, err := ap.GetValueForKey(context.Background(), addr1, key)
 	assert.Equal(t, "", value)
 	assert.Equal(t, process.ErrSendingRequest, err)
 }
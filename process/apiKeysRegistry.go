@@ -0,0 +1,340 @@
+package process
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+const (
+	usageDayFormat       = "2006-01-02"
+	numVisibleKeyChars   = 4
+	minUsageSaveInterval = time.Second
+)
+
+// ArgsApiKeysRegistry holds the arguments needed for creating a new ApiKeysRegistry
+type ArgsApiKeysRegistry struct {
+	Tiers             []data.ApiKeyTier
+	Keys              []data.ApiKey
+	UsageFilePath     string
+	UsageSaveInterval time.Duration
+}
+
+type apiKeyCounters struct {
+	RequestsToday        uint64 `json:"requestsToday"`
+	TotalRequests        uint64 `json:"totalRequests"`
+	LastRequestTimestamp int64  `json:"lastRequestTimestamp"`
+}
+
+// apiKeysUsage is the content of the usage file. The counters are indexed by the hash of the keys, so the file does not
+// hold the keys themselves
+type apiKeysUsage struct {
+	Day      string                     `json:"day"`
+	Counters map[string]*apiKeyCounters `json:"counters"`
+}
+
+type registeredApiKey struct {
+	apiKey  data.ApiKey
+	keyHash string
+	tier    *data.ApiKeyTier
+}
+
+// ApiKeysRegistry holds the API keys and their tiers, and counts the requests of each key, so the daily quotas can
+// be enforced. The counters are reset at midnight (UTC) and, if a usage file is provided, they are persisted in it,
+// so they survive the restarts
+type ApiKeysRegistry struct {
+	tiers             map[string]*data.ApiKeyTier
+	keys              map[string]*registeredApiKey
+	usageFilePath     string
+	usageSaveInterval time.Duration
+	getTimeHandler    func() time.Time
+	mutUsage          sync.Mutex
+	usage             apiKeysUsage
+	isUsageChanged    bool
+}
+
+// NewApiKeysRegistry creates a new instance of ApiKeysRegistry, loading the usage counters from the usage file, if any
+func NewApiKeysRegistry(args ArgsApiKeysRegistry) (*ApiKeysRegistry, error) {
+	if len(args.UsageFilePath) > 0 && args.UsageSaveInterval < minUsageSaveInterval {
+		return nil, ErrInvalidUsageSaveInterval
+	}
+
+	tiers, err := createApiKeyTiers(args.Tiers)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*registeredApiKey, len(args.Keys))
+	for _, apiKey := range args.Keys {
+		if len(apiKey.Key) == 0 {
+			return nil, fmt.Errorf("%w: empty key of owner %s", ErrInvalidApiKeysConfig, apiKey.Owner)
+		}
+		_, isDuplicate := keys[apiKey.Key]
+		if isDuplicate {
+			return nil, fmt.Errorf("%w: duplicate key of owner %s", ErrInvalidApiKeysConfig, apiKey.Owner)
+		}
+		tier, found := tiers[apiKey.Tier]
+		if !found {
+			return nil, fmt.Errorf("%w: unknown tier %s of owner %s", ErrInvalidApiKeysConfig, apiKey.Tier, apiKey.Owner)
+		}
+
+		keys[apiKey.Key] = &registeredApiKey{
+			apiKey:  apiKey,
+			keyHash: hashApiKey(apiKey.Key),
+			tier:    tier,
+		}
+	}
+
+	akr := &ApiKeysRegistry{
+		tiers:             tiers,
+		keys:              keys,
+		usageFilePath:     args.UsageFilePath,
+		usageSaveInterval: args.UsageSaveInterval,
+		getTimeHandler:    time.Now,
+		usage: apiKeysUsage{
+			Counters: make(map[string]*apiKeyCounters),
+		},
+	}
+	akr.loadUsage()
+
+	return akr, nil
+}
+
+func createApiKeyTiers(tiersList []data.ApiKeyTier) (map[string]*data.ApiKeyTier, error) {
+	tiers := make(map[string]*data.ApiKeyTier, len(tiersList))
+	for i := range tiersList {
+		tier := tiersList[i]
+		if len(tier.Name) == 0 {
+			return nil, fmt.Errorf("%w: empty tier name", ErrInvalidApiKeysConfig)
+		}
+		_, isDuplicate := tiers[tier.Name]
+		if isDuplicate {
+			return nil, fmt.Errorf("%w: duplicate tier %s", ErrInvalidApiKeysConfig, tier.Name)
+		}
+		if tier.RequestsPerSecond < 0 || (tier.RequestsPerSecond > 0 && tier.Burst < 1) {
+			return nil, fmt.Errorf("%w: invalid rate limit of tier %s", ErrInvalidApiKeysConfig, tier.Name)
+		}
+
+		tiers[tier.Name] = &tier
+	}
+
+	return tiers, nil
+}
+
+func hashApiKey(key string) string {
+	keyHash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(keyHash[:])
+}
+
+func (akr *ApiKeysRegistry) loadUsage() {
+	if len(akr.usageFilePath) == 0 {
+		return
+	}
+
+	usageBytes, err := ioutil.ReadFile(akr.usageFilePath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn("api keys: cannot read the usage file", "file", akr.usageFilePath, "error", err.Error())
+		}
+		return
+	}
+
+	var usage apiKeysUsage
+	err = json.Unmarshal(usageBytes, &usage)
+	if err != nil {
+		log.Warn("api keys: cannot decode the usage file", "file", akr.usageFilePath, "error", err.Error())
+		return
+	}
+	if usage.Counters == nil {
+		usage.Counters = make(map[string]*apiKeyCounters)
+	}
+
+	akr.usage = usage
+}
+
+// GetApiKeyTier returns the tier of the given API key, if the key is known
+func (akr *ApiKeysRegistry) GetApiKeyTier(key string) (*data.ApiKeyTier, bool) {
+	registeredKey, found := akr.keys[key]
+	if !found {
+		return nil, false
+	}
+
+	return registeredKey.tier, true
+}
+
+// GetTier returns the tier with the given name, if any
+func (akr *ApiKeysRegistry) GetTier(name string) (*data.ApiKeyTier, bool) {
+	tier, found := akr.tiers[name]
+	return tier, found
+}
+
+// TryConsumeQuota counts a request of the given API key, unless the key has already reached its daily quota. It
+// returns false if the key is unknown or if its quota is exhausted
+func (akr *ApiKeysRegistry) TryConsumeQuota(key string) bool {
+	registeredKey, found := akr.keys[key]
+	if !found {
+		return false
+	}
+
+	now := akr.getTimeHandler()
+
+	akr.mutUsage.Lock()
+	defer akr.mutUsage.Unlock()
+
+	akr.resetCountersOnNewDay(now)
+
+	counters, found := akr.usage.Counters[registeredKey.keyHash]
+	if !found {
+		counters = &apiKeyCounters{}
+		akr.usage.Counters[registeredKey.keyHash] = counters
+	}
+
+	dailyQuota := registeredKey.tier.DailyQuota
+	if dailyQuota > 0 && counters.RequestsToday >= dailyQuota {
+		return false
+	}
+
+	counters.RequestsToday++
+	counters.TotalRequests++
+	counters.LastRequestTimestamp = now.Unix()
+	akr.isUsageChanged = true
+
+	return true
+}
+
+func (akr *ApiKeysRegistry) resetCountersOnNewDay(now time.Time) {
+	day := now.UTC().Format(usageDayFormat)
+	if akr.usage.Day == day {
+		return
+	}
+
+	akr.usage.Day = day
+	for _, counters := range akr.usage.Counters {
+		counters.RequestsToday = 0
+	}
+	akr.isUsageChanged = true
+}
+
+// GetApiKeysUsage returns the usage counters of all the API keys, sorted by owner
+func (akr *ApiKeysRegistry) GetApiKeysUsage() *data.ApiKeysUsageResponse {
+	akr.mutUsage.Lock()
+	defer akr.mutUsage.Unlock()
+
+	akr.resetCountersOnNewDay(akr.getTimeHandler())
+
+	keysUsage := make([]*data.ApiKeyUsage, 0, len(akr.keys))
+	for _, registeredKey := range akr.keys {
+		keyUsage := &data.ApiKeyUsage{
+			Key:        maskApiKey(registeredKey.apiKey.Key),
+			Owner:      registeredKey.apiKey.Owner,
+			Tier:       registeredKey.tier.Name,
+			DailyQuota: registeredKey.tier.DailyQuota,
+		}
+
+		counters, found := akr.usage.Counters[registeredKey.keyHash]
+		if found {
+			keyUsage.RequestsToday = counters.RequestsToday
+			keyUsage.TotalRequests = counters.TotalRequests
+			keyUsage.LastRequestTimestamp = counters.LastRequestTimestamp
+		}
+
+		keysUsage = append(keysUsage, keyUsage)
+	}
+
+	sort.Slice(keysUsage, func(i, j int) bool {
+		if keysUsage[i].Owner == keysUsage[j].Owner {
+			return keysUsage[i].Key < keysUsage[j].Key
+		}
+		return keysUsage[i].Owner < keysUsage[j].Owner
+	})
+
+	return &data.ApiKeysUsageResponse{
+		Day:  akr.usage.Day,
+		Keys: keysUsage,
+	}
+}
+
+func maskApiKey(key string) string {
+	if len(key) <= 2*numVisibleKeyChars {
+		return "****"
+	}
+
+	return key[:numVisibleKeyChars] + "****"
+}
+
+// StartUsagePersistence starts saving the usage counters in the usage file, at the configured interval, if they changed
+func (akr *ApiKeysRegistry) StartUsagePersistence() {
+	if len(akr.usageFilePath) == 0 {
+		return
+	}
+
+	go func() {
+		for {
+			time.Sleep(akr.usageSaveInterval)
+
+			err := akr.SaveUsage()
+			if err != nil {
+				log.Warn("api keys: cannot save the usage file", "file", akr.usageFilePath, "error", err.Error())
+			}
+		}
+	}()
+}
+
+// SaveUsage saves the usage counters in the usage file, if they changed since they were last saved
+func (akr *ApiKeysRegistry) SaveUsage() error {
+	if len(akr.usageFilePath) == 0 {
+		return nil
+	}
+
+	akr.mutUsage.Lock()
+	if !akr.isUsageChanged {
+		akr.mutUsage.Unlock()
+		return nil
+	}
+	usageBytes, err := json.Marshal(akr.usage)
+	akr.isUsageChanged = false
+	akr.mutUsage.Unlock()
+	if err == nil {
+		err = akr.writeUsageFile(usageBytes)
+	}
+	if err != nil {
+		// the counters were not saved, so the next call retries even if they do not change in the meantime
+		akr.mutUsage.Lock()
+		akr.isUsageChanged = true
+		akr.mutUsage.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+func (akr *ApiKeysRegistry) writeUsageFile(usageBytes []byte) error {
+	err := os.MkdirAll(filepath.Dir(akr.usageFilePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	// the counters are written in a temporary file which is then renamed, so the usage file is never left half written
+	tempFilePath := akr.usageFilePath + ".tmp"
+	err = ioutil.WriteFile(tempFilePath, usageBytes, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, akr.usageFilePath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (akr *ApiKeysRegistry) IsInterfaceNil() bool {
+	return akr == nil
+}
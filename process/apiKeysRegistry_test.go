@@ -0,0 +1,191 @@
+package process
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsApiKeysRegistry() ArgsApiKeysRegistry {
+	return ArgsApiKeysRegistry{
+		Tiers: []data.ApiKeyTier{
+			{Name: "free", AllowedPaths: []string{"/address"}, RequestsPerSecond: 5, Burst: 10, DailyQuota: 2},
+			{Name: "admin", IsAdmin: true},
+		},
+		Keys: []data.ApiKey{
+			{Key: "free-key-of-alice", Owner: "alice", Tier: "free"},
+			{Key: "admin-key-of-bob", Owner: "bob", Tier: "admin"},
+		},
+	}
+}
+
+func createUsageDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "apiKeysUsage")
+	require.Nil(t, err)
+
+	return dir
+}
+
+func TestNewApiKeysRegistry_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsApiKeysRegistry()
+	args.Tiers[1].Name = "free"
+	akr, err := NewApiKeysRegistry(args)
+	assert.Nil(t, akr)
+	assert.True(t, errors.Is(err, ErrInvalidApiKeysConfig))
+
+	args = createArgsApiKeysRegistry()
+	args.Tiers[0].Burst = 0
+	akr, err = NewApiKeysRegistry(args)
+	assert.Nil(t, akr)
+	assert.True(t, errors.Is(err, ErrInvalidApiKeysConfig))
+
+	args = createArgsApiKeysRegistry()
+	args.Keys[1].Key = args.Keys[0].Key
+	akr, err = NewApiKeysRegistry(args)
+	assert.Nil(t, akr)
+	assert.True(t, errors.Is(err, ErrInvalidApiKeysConfig))
+
+	args = createArgsApiKeysRegistry()
+	args.Keys[0].Tier = "missing"
+	akr, err = NewApiKeysRegistry(args)
+	assert.Nil(t, akr)
+	assert.True(t, errors.Is(err, ErrInvalidApiKeysConfig))
+
+	args = createArgsApiKeysRegistry()
+	args.UsageFilePath = "usage.json"
+	akr, err = NewApiKeysRegistry(args)
+	assert.Nil(t, akr)
+	assert.Equal(t, ErrInvalidUsageSaveInterval, err)
+}
+
+func TestApiKeysRegistry_GetApiKeyTier(t *testing.T) {
+	t.Parallel()
+
+	akr, err := NewApiKeysRegistry(createArgsApiKeysRegistry())
+	require.Nil(t, err)
+
+	tier, found := akr.GetApiKeyTier("admin-key-of-bob")
+	require.True(t, found)
+	assert.Equal(t, "admin", tier.Name)
+	assert.True(t, tier.IsAdmin)
+
+	_, found = akr.GetApiKeyTier("unknown-key")
+	assert.False(t, found)
+}
+
+func TestApiKeysRegistry_TryConsumeQuotaShouldResetTheCountersOnNewDay(t *testing.T) {
+	t.Parallel()
+
+	akr, _ := NewApiKeysRegistry(createArgsApiKeysRegistry())
+	now := time.Date(2021, 3, 4, 23, 59, 0, 0, time.UTC)
+	akr.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	assert.True(t, akr.TryConsumeQuota("free-key-of-alice"))
+	assert.True(t, akr.TryConsumeQuota("free-key-of-alice"))
+	assert.False(t, akr.TryConsumeQuota("free-key-of-alice"))
+	assert.False(t, akr.TryConsumeQuota("unknown-key"))
+
+	// the admin tier has no daily quota
+	for i := 0; i < 5; i++ {
+		assert.True(t, akr.TryConsumeQuota("admin-key-of-bob"))
+	}
+
+	now = now.Add(time.Minute)
+	assert.True(t, akr.TryConsumeQuota("free-key-of-alice"))
+
+	usage := akr.GetApiKeysUsage()
+	assert.Equal(t, "2021-03-05", usage.Day)
+	require.Equal(t, 2, len(usage.Keys))
+	assert.Equal(t, &data.ApiKeyUsage{
+		Key:                  "free****",
+		Owner:                "alice",
+		Tier:                 "free",
+		RequestsToday:        1,
+		DailyQuota:           2,
+		TotalRequests:        3,
+		LastRequestTimestamp: now.Unix(),
+	}, usage.Keys[0])
+	assert.Equal(t, "bob", usage.Keys[1].Owner)
+	assert.Equal(t, uint64(0), usage.Keys[1].RequestsToday)
+	assert.Equal(t, uint64(5), usage.Keys[1].TotalRequests)
+}
+
+func TestApiKeysRegistry_UsageShouldSurviveTheRestarts(t *testing.T) {
+	t.Parallel()
+
+	dir := createUsageDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	args := createArgsApiKeysRegistry()
+	args.UsageFilePath = filepath.Join(dir, "usage", "apiKeysUsage.json")
+	args.UsageSaveInterval = time.Second
+
+	akr, _ := NewApiKeysRegistry(args)
+	assert.True(t, akr.TryConsumeQuota("free-key-of-alice"))
+	assert.True(t, akr.TryConsumeQuota("free-key-of-alice"))
+
+	err := akr.SaveUsage()
+	require.Nil(t, err)
+
+	usageBytes, err := ioutil.ReadFile(args.UsageFilePath)
+	require.Nil(t, err)
+	assert.False(t, strings.Contains(string(usageBytes), "free-key-of-alice"))
+
+	restartedAkr, _ := NewApiKeysRegistry(args)
+	assert.False(t, restartedAkr.TryConsumeQuota("free-key-of-alice"))
+
+	usage := restartedAkr.GetApiKeysUsage()
+	require.Equal(t, 2, len(usage.Keys))
+	assert.Equal(t, uint64(2), usage.Keys[0].RequestsToday)
+	assert.Equal(t, uint64(2), usage.Keys[0].TotalRequests)
+}
+
+func TestApiKeysRegistry_FailedSaveShouldBeRetried(t *testing.T) {
+	t.Parallel()
+
+	dir := createUsageDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	// a file in place of the usage directory makes the save fail
+	blockingFilePath := filepath.Join(dir, "usage")
+	err := ioutil.WriteFile(blockingFilePath, []byte("not a directory"), 0644)
+	require.Nil(t, err)
+
+	args := createArgsApiKeysRegistry()
+	args.UsageFilePath = filepath.Join(blockingFilePath, "apiKeysUsage.json")
+	args.UsageSaveInterval = time.Second
+
+	akr, _ := NewApiKeysRegistry(args)
+	assert.True(t, akr.TryConsumeQuota("free-key-of-alice"))
+
+	err = akr.SaveUsage()
+	require.NotNil(t, err)
+	assert.True(t, akr.isUsageChanged)
+
+	err = os.Remove(blockingFilePath)
+	require.Nil(t, err)
+	err = akr.SaveUsage()
+	require.Nil(t, err)
+	assert.False(t, akr.isUsageChanged)
+
+	restartedAkr, _ := NewApiKeysRegistry(args)
+	usage := restartedAkr.GetApiKeysUsage()
+	require.Equal(t, 2, len(usage.Keys))
+	assert.Equal(t, uint64(1), usage.Keys[0].TotalRequests)
+}
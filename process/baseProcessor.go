@@ -2,12 +2,14 @@ package process
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -20,7 +22,6 @@ import (
 )
 
 var log = logger.GetOrCreate("process")
-var mutHttpClient sync.RWMutex
 
 // BaseProcessor represents an implementation of CoreProcessor that helps
 // processing requests
@@ -29,48 +30,100 @@ type BaseProcessor struct {
 	shardCoordinator         sharding.Coordinator
 	observersProvider        observer.NodesProviderHandler
 	fullHistoryNodesProvider observer.NodesProviderHandler
+	nodesStatistics          observer.NodesStatisticsHandler
+	circuitBreaker           CircuitBreakerHandler
+	concurrencyLimiter       ConcurrencyLimiterHandler
+	retryPolicy              RetryPolicyHandler
+	hedgingPolicy            HedgingPolicyHandler
+	quorumPolicy             QuorumPolicyHandler
+	routingTable             RoutingTableHandler
+	requestsCoalescer        RequestsCoalescerHandler
+	metrics                  MetricsHandler
 	pubKeyConverter          core.PubkeyConverter
 	shardIDs                 []uint32
 
-	httpClient *http.Client
+	httpClients NodesHttpClientsHandler
+}
+
+// ArgsBaseProcessor holds the arguments needed for creating a new BaseProcessor
+type ArgsBaseProcessor struct {
+	HttpClients              NodesHttpClientsHandler
+	ShardCoordinator         sharding.Coordinator
+	ObserversProvider        observer.NodesProviderHandler
+	FullHistoryNodesProvider observer.NodesProviderHandler
+	NodesStatistics          observer.NodesStatisticsHandler
+	CircuitBreaker           CircuitBreakerHandler
+	ConcurrencyLimiter       ConcurrencyLimiterHandler
+	RetryPolicy              RetryPolicyHandler
+	HedgingPolicy            HedgingPolicyHandler
+	QuorumPolicy             QuorumPolicyHandler
+	RoutingTable             RoutingTableHandler
+	RequestsCoalescer        RequestsCoalescerHandler
+	Metrics                  MetricsHandler
+	PubKeyConverter          core.PubkeyConverter
 }
 
 // NewBaseProcessor creates a new instance of BaseProcessor struct
-func NewBaseProcessor(
-	requestTimeoutSec int,
-	shardCoord sharding.Coordinator,
-	observersProvider observer.NodesProviderHandler,
-	fullHistoryNodesProvider observer.NodesProviderHandler,
-	pubKeyConverter core.PubkeyConverter,
-) (*BaseProcessor, error) {
-	if check.IfNil(shardCoord) {
-		return nil, ErrNilShardCoordinator
+func NewBaseProcessor(args ArgsBaseProcessor) (*BaseProcessor, error) {
+	if check.IfNil(args.HttpClients) {
+		return nil, ErrNilNodesHttpClients
 	}
-	if requestTimeoutSec <= 0 {
-		return nil, ErrInvalidRequestTimeout
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
 	}
-	if check.IfNil(observersProvider) {
+	if check.IfNil(args.ObserversProvider) {
 		return nil, fmt.Errorf("%w for observers", ErrNilNodesProvider)
 	}
-	if check.IfNil(fullHistoryNodesProvider) {
+	if check.IfNil(args.FullHistoryNodesProvider) {
 		return nil, fmt.Errorf("%w for full history nodes", ErrNilNodesProvider)
 	}
-	if check.IfNil(pubKeyConverter) {
+	if check.IfNil(args.NodesStatistics) {
+		return nil, ErrNilNodesStatistics
+	}
+	if check.IfNil(args.CircuitBreaker) {
+		return nil, ErrNilCircuitBreaker
+	}
+	if check.IfNil(args.ConcurrencyLimiter) {
+		return nil, ErrNilConcurrencyLimiter
+	}
+	if check.IfNil(args.RetryPolicy) {
+		return nil, ErrNilRetryPolicy
+	}
+	if check.IfNil(args.HedgingPolicy) {
+		return nil, ErrNilHedgingPolicy
+	}
+	if check.IfNil(args.QuorumPolicy) {
+		return nil, ErrNilQuorumPolicy
+	}
+	if check.IfNil(args.RoutingTable) {
+		return nil, ErrNilRoutingTable
+	}
+	if check.IfNil(args.RequestsCoalescer) {
+		return nil, ErrNilRequestsCoalescer
+	}
+	if check.IfNil(args.Metrics) {
+		return nil, ErrNilMetricsHandler
+	}
+	if check.IfNil(args.PubKeyConverter) {
 		return nil, ErrNilPubKeyConverter
 	}
 
-	httpClient := http.DefaultClient
-	mutHttpClient.Lock()
-	httpClient.Timeout = time.Duration(requestTimeoutSec) * time.Second
-	mutHttpClient.Unlock()
-
 	return &BaseProcessor{
-		shardCoordinator:         shardCoord,
-		observersProvider:        observersProvider,
-		fullHistoryNodesProvider: fullHistoryNodesProvider,
-		httpClient:               httpClient,
-		pubKeyConverter:          pubKeyConverter,
-		shardIDs:                 computeShardIDs(shardCoord),
+		shardCoordinator:         args.ShardCoordinator,
+		observersProvider:        args.ObserversProvider,
+		fullHistoryNodesProvider: args.FullHistoryNodesProvider,
+		nodesStatistics:          args.NodesStatistics,
+		circuitBreaker:           args.CircuitBreaker,
+		concurrencyLimiter:       args.ConcurrencyLimiter,
+		retryPolicy:              args.RetryPolicy,
+		hedgingPolicy:            args.HedgingPolicy,
+		quorumPolicy:             args.QuorumPolicy,
+		routingTable:             args.RoutingTable,
+		requestsCoalescer:        args.RequestsCoalescer,
+		metrics:                  args.Metrics,
+		httpClients:              args.HttpClients,
+		pubKeyConverter:          args.PubKeyConverter,
+		shardIDs:                 computeShardIDs(args.ShardCoordinator),
 	}, nil
 }
 
@@ -81,32 +134,168 @@ func (bp *BaseProcessor) GetShardIDs() []uint32 {
 
 // GetObservers returns the registered observers on a shard
 func (bp *BaseProcessor) GetObservers(shardID uint32) ([]*proxyData.NodeData, error) {
-	return bp.observersProvider.GetNodesByShardId(shardID)
+	return bp.skipOpenCircuits(bp.observersProvider.GetNodesByShardId(shardID))
+}
+
+// GetNodesForRequest returns the nodes of a shard which should serve a request of the given category, according to
+// the routing table. The key identifies the request (for example an address), so the requests with the same key can
+// be sent to the same node. The routing key carried by the context, if any, is used instead of the given key. If the
+// category's route requires some tags, only the nodes having all of them are returned
+func (bp *BaseProcessor) GetNodesForRequest(
+	ctx context.Context,
+	category string,
+	shardID uint32,
+	key string,
+) ([]*proxyData.NodeData, error) {
+	routingKey, ok := proxyData.GetRoutingKey(ctx)
+	if ok {
+		key = routingKey
+	}
+
+	route := bp.routingTable.GetRoute(category)
+	getNodes := func(provider observer.NodesProviderHandler) ([]*proxyData.NodeData, error) {
+		return provider.GetNodesByShardIdWithTags(shardID, key, route.RequiredTags)
+	}
+
+	return bp.getRouteNodes(route, getNodes)
+}
+
+// GetAllNodesForRequest returns the nodes of all the shards which should serve a request of the given category,
+// according to the routing table
+func (bp *BaseProcessor) GetAllNodesForRequest(category string) ([]*proxyData.NodeData, error) {
+	route := bp.routingTable.GetRoute(category)
+	getNodes := func(provider observer.NodesProviderHandler) ([]*proxyData.NodeData, error) {
+		return provider.GetAllNodesWithTags(route.RequiredTags)
+	}
+
+	return bp.getRouteNodes(route, getNodes)
+}
+
+// getRouteNodes returns the available nodes of the first pool of the route which has any or, if the route falls back
+// on failure, the available nodes of all its pools, in order and without duplicates
+func (bp *BaseProcessor) getRouteNodes(
+	route Route,
+	getNodes func(provider observer.NodesProviderHandler) ([]*proxyData.NodeData, error),
+) ([]*proxyData.NodeData, error) {
+	routeNodes := make([]*proxyData.NodeData, 0)
+	addedAddresses := make(map[string]struct{})
+	var lastErr error
+	for _, pool := range route.Pools {
+		nodes, err := bp.skipOpenCircuits(bp.getPoolNodes(pool, getNodes))
+		if err != nil || len(nodes) == 0 {
+			lastErr = err
+			continue
+		}
+		if route.Fallback != FallbackOnFailure {
+			return nodes, nil
+		}
+
+		for _, node := range nodes {
+			if _, added := addedAddresses[node.Address]; !added {
+				routeNodes = append(routeNodes, node)
+				addedAddresses[node.Address] = struct{}{}
+			}
+		}
+	}
+
+	if len(routeNodes) > 0 {
+		return routeNodes, nil
+	}
+	if lastErr == nil {
+		lastErr = ErrNoObserverAvailable
+	}
+
+	return nil, lastErr
+}
+
+func (bp *BaseProcessor) getPoolNodes(
+	pool string,
+	getNodes func(provider observer.NodesProviderHandler) ([]*proxyData.NodeData, error),
+) ([]*proxyData.NodeData, error) {
+	switch pool {
+	case PoolObservers:
+		return getNodes(bp.observersProvider)
+	case PoolFullHistoryNodes:
+		return getNodes(bp.fullHistoryNodesProvider)
+	default:
+		return bp.getCustomPoolNodes(pool, getNodes)
+	}
+}
+
+// getCustomPoolNodes returns the observers and the full history nodes which belong to the given custom pool
+func (bp *BaseProcessor) getCustomPoolNodes(
+	pool string,
+	getNodes func(provider observer.NodesProviderHandler) ([]*proxyData.NodeData, error),
+) ([]*proxyData.NodeData, error) {
+	poolNodes := make([]*proxyData.NodeData, 0)
+	addedAddresses := make(map[string]struct{})
+	for _, provider := range []observer.NodesProviderHandler{bp.observersProvider, bp.fullHistoryNodesProvider} {
+		nodes, err := getNodes(provider)
+		if err != nil {
+			continue
+		}
+
+		for _, node := range nodes {
+			_, added := addedAddresses[node.Address]
+			if added || !bp.routingTable.IsInCustomPool(pool, node.Address) {
+				continue
+			}
+
+			poolNodes = append(poolNodes, node)
+			addedAddresses[node.Address] = struct{}{}
+		}
+	}
+
+	if len(poolNodes) == 0 {
+		return nil, fmt.Errorf("%w in pool %s", ErrNoObserverAvailable, pool)
+	}
+
+	return poolNodes, nil
 }
 
 // GetAllObservers will return all the observers, regardless of shard ID
 func (bp *BaseProcessor) GetAllObservers() ([]*proxyData.NodeData, error) {
-	return bp.observersProvider.GetAllNodes()
+	return bp.skipOpenCircuits(bp.observersProvider.GetAllNodes())
 }
 
 // GetObserversOnePerShard will return a slice containing an observer for each shard
 func (bp *BaseProcessor) GetObserversOnePerShard() ([]*proxyData.NodeData, error) {
-	return bp.getNodesOnePerShard(bp.observersProvider.GetNodesByShardId)
+	return bp.getNodesOnePerShard(bp.GetObservers)
 }
 
 // GetFullHistoryNodes returns the registered full history nodes on a shard
 func (bp *BaseProcessor) GetFullHistoryNodes(shardID uint32) ([]*proxyData.NodeData, error) {
-	return bp.fullHistoryNodesProvider.GetNodesByShardId(shardID)
+	return bp.skipOpenCircuits(bp.fullHistoryNodesProvider.GetNodesByShardId(shardID))
 }
 
 // GetAllFullHistoryNodes will return all the full history nodes, regardless of shard ID
 func (bp *BaseProcessor) GetAllFullHistoryNodes() ([]*proxyData.NodeData, error) {
-	return bp.fullHistoryNodesProvider.GetAllNodes()
+	return bp.skipOpenCircuits(bp.fullHistoryNodesProvider.GetAllNodes())
 }
 
 // GetFullHistoryNodesOnePerShard will return a slice containing a full history node for each shard
 func (bp *BaseProcessor) GetFullHistoryNodesOnePerShard() ([]*proxyData.NodeData, error) {
-	return bp.getNodesOnePerShard(bp.fullHistoryNodesProvider.GetNodesByShardId)
+	return bp.getNodesOnePerShard(bp.GetFullHistoryNodes)
+}
+
+// skipOpenCircuits removes the nodes with an open circuit. If all the nodes have their circuits open, all of them
+// are returned, so the requests will fail fast instead of not being sent at all
+func (bp *BaseProcessor) skipOpenCircuits(nodes []*proxyData.NodeData, err error) ([]*proxyData.NodeData, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	availableNodes := make([]*proxyData.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if !bp.circuitBreaker.IsOpen(node.Address) {
+			availableNodes = append(availableNodes, node)
+		}
+	}
+	if len(availableNodes) == 0 {
+		return nodes, nil
+	}
+
+	return availableNodes, nil
 }
 
 func (bp *BaseProcessor) getNodesOnePerShard(
@@ -144,29 +333,114 @@ func (bp *BaseProcessor) ComputeShardId(addressBuff []byte) (uint32, error) {
 	return bp.shardCoordinator.ComputeId(addressBuff), nil
 }
 
-// CallGetRestEndPoint calls an external end point (sends a request on a node)
+// CallGetRestEndPoint calls an external end point (sends a request on a node). The identical requests (same node and
+// same path) which are in flight at the same time share a single call and its response body
 func (bp *BaseProcessor) CallGetRestEndPoint(
+	ctx context.Context,
 	address string,
 	path string,
 	value interface{},
 ) (int, error) {
+	sharedBody, responseCode, isShared, err := bp.requestsCoalescer.Do(ctx, address+path, func() (interface{}, int, error) {
+		code, body, errCall := bp.getRestEndPointResponse(ctx, address, path)
+		return body, code, errCall
+	})
+	if ctx.Err() != nil {
+		return http.StatusRequestTimeout, ctx.Err()
+	}
+	if isShared && isContextError(err) {
+		// the request which made the call was canceled, but this one was not, so it makes its own call
+		return bp.callGetRestEndPoint(ctx, address, path, value)
+	}
+	if err != nil {
+		return responseCode, err
+	}
 
-	req, err := http.NewRequest("GET", address+path, nil)
+	// each request decodes the shared body in its own value, so no maps, slices or pointers are shared between them
+	return decodeGetResponse(responseCode, sharedBody.([]byte), value)
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func (bp *BaseProcessor) callGetRestEndPoint(
+	ctx context.Context,
+	address string,
+	path string,
+	value interface{},
+) (int, error) {
+	responseCode, responseBody, err := bp.getRestEndPointResponse(ctx, address, path)
 	if err != nil {
-		return http.StatusInternalServerError, err
+		return responseCode, err
+	}
+
+	return decodeGetResponse(responseCode, responseBody, value)
+}
+
+func (bp *BaseProcessor) getRestEndPointResponse(ctx context.Context, address string, path string) (int, []byte, error) {
+	responseCode, err := bp.acquireRequestSlot(ctx, address)
+	if err != nil {
+		return responseCode, nil, err
+	}
+	defer bp.concurrencyLimiter.Release(address)
+	outcome := observer.RequestAborted
+	finishRequest := bp.trackRequest(address)
+	defer func() {
+		finishRequest(outcome)
+	}()
+
+	return readGetResponse(ctx, address, path, func(req *http.Request) (*http.Response, error) {
+		resp, errRequest := bp.doRequest(address, req)
+		outcome = getRequestOutcome(req, resp, errRequest)
+		return resp, errRequest
+	})
+}
+
+// sendGetRequest sends a GET request towards the given node endpoint through the given function and decodes the JSON
+// response in the given value. It returns the response's status code, or the status code matching the error
+func sendGetRequest(
+	ctx context.Context,
+	address string,
+	path string,
+	value interface{},
+	doRequest func(req *http.Request) (*http.Response, error),
+) (int, error) {
+	responseCode, responseBody, err := readGetResponse(ctx, address, path, doRequest)
+	if err != nil {
+		return responseCode, err
+	}
+
+	return decodeGetResponse(responseCode, responseBody, value)
+}
+
+// readGetResponse sends a GET request towards the given node endpoint through the given function and returns the
+// response's status code and body, or the status code matching the error
+func readGetResponse(
+	ctx context.Context,
+	address string,
+	path string,
+	doRequest func(req *http.Request) (*http.Response, error),
+) (int, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", address+path, nil)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
 	}
 
 	userAgent := "Elrond Proxy / 1.0.0 <Requesting data from nodes>"
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("User-Agent", userAgent)
 
-	resp, err := bp.httpClient.Do(req)
+	resp, err := doRequest(req)
 	if err != nil {
+		if err == ErrCircuitOpen {
+			return http.StatusServiceUnavailable, nil, err
+		}
 		if isTimeoutError(err) {
-			return http.StatusRequestTimeout, err
+			return http.StatusRequestTimeout, nil, err
 		}
 
-		return http.StatusBadRequest, err
+		return http.StatusBadRequest, nil, err
 	}
 
 	defer func() {
@@ -176,18 +450,29 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 		}
 	}()
 
-	err = json.NewDecoder(resp.Body).Decode(value)
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return resp.StatusCode, responseBody, nil
+}
+
+// decodeGetResponse decodes the JSON response body in the given value and returns the error of a response which is
+// not ok
+func decodeGetResponse(responseStatusCode int, responseBody []byte, value interface{}) (int, error) {
+	bodyReader := bytes.NewReader(responseBody)
+	err := json.NewDecoder(bodyReader).Decode(value)
 	if err != nil {
 		return 0, err
 	}
 
-	responseStatusCode := resp.StatusCode
 	if responseStatusCode == http.StatusOK { // everything ok, return status ok and the expected response
 		return responseStatusCode, nil
 	}
 
 	// status response not ok, return the error
-	responseBytes, err := ioutil.ReadAll(resp.Body)
+	responseBytes, err := ioutil.ReadAll(bodyReader)
 	if err != nil {
 		return responseStatusCode, err
 	}
@@ -197,18 +482,29 @@ func (bp *BaseProcessor) CallGetRestEndPoint(
 
 // CallPostRestEndPoint calls an external end point (sends a request on a node)
 func (bp *BaseProcessor) CallPostRestEndPoint(
+	ctx context.Context,
 	address string,
 	path string,
 	data interface{},
 	response interface{},
 ) (int, error) {
+	responseCode, err := bp.acquireRequestSlot(ctx, address)
+	if err != nil {
+		return responseCode, err
+	}
+	defer bp.concurrencyLimiter.Release(address)
+	outcome := observer.RequestAborted
+	finishRequest := bp.trackRequest(address)
+	defer func() {
+		finishRequest(outcome)
+	}()
 
 	buff, err := json.Marshal(data)
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
 
-	req, err := http.NewRequest("POST", address+path, bytes.NewReader(buff))
+	req, err := http.NewRequestWithContext(ctx, "POST", address+path, bytes.NewReader(buff))
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
@@ -218,8 +514,12 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", userAgent)
 
-	resp, err := bp.httpClient.Do(req)
+	resp, err := bp.doRequest(address, req)
+	outcome = getRequestOutcome(req, resp, err)
 	if err != nil {
+		if err == ErrCircuitOpen {
+			return http.StatusServiceUnavailable, err
+		}
 		if isTimeoutError(err) {
 			return http.StatusRequestTimeout, err
 		}
@@ -254,6 +554,379 @@ func (bp *BaseProcessor) CallPostRestEndPoint(
 	return responseStatusCode, errors.New(genericApiResponse.Error)
 }
 
+// isAnswerOrRequestError also accepts the reads for which the observer rejected the request itself, as another
+// observer would reject it as well
+func isAnswerOrRequestError(responseCode int, err error) bool {
+	return err == nil || responseCode == http.StatusBadRequest || responseCode == http.StatusInternalServerError
+}
+
+type readResult struct {
+	response *proxyData.ObserverResponse
+	duration time.Duration
+}
+
+// ReadFromObservers sends an idempotent read request to the given observers, one after another, until one of them
+// gives an answer accepted by isAnswer. If none does, the observers are tried again, after a backoff, as many times
+// as the retry policy allows. If hedging is enabled, a duplicate request is sent to the next observer whenever the
+// current one does not answer within the hedging delay and the first accepted answer is used. If all the observers
+// rejected the read because they were overloaded, ErrObserversOverloaded is returned
+func (bp *BaseProcessor) ReadFromObservers(
+	ctx context.Context,
+	observers []*proxyData.NodeData,
+	read func(ctx context.Context, observer *proxyData.NodeData) (interface{}, int, error),
+	isAnswer func(responseCode int, err error) bool,
+) (*proxyData.ObserverResponse, error) {
+	if len(observers) == 0 {
+		return nil, ErrMissingObserver
+	}
+
+	for retry := uint32(0); ; retry++ {
+		response, err := bp.readFromObserversOnce(ctx, observers, read, isAnswer)
+		if err == nil {
+			return response, nil
+		}
+		if retry >= bp.retryPolicy.MaxRetries() || ctx.Err() != nil {
+			return nil, err
+		}
+
+		backoff := bp.retryPolicy.Backoff(retry)
+		log.Debug("read failed on all observers, retrying", "retry", retry+1, "backoff", backoff)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (bp *BaseProcessor) readFromObserversOnce(
+	ctx context.Context,
+	observers []*proxyData.NodeData,
+	read func(ctx context.Context, observer *proxyData.NodeData) (interface{}, int, error),
+	isAnswer func(responseCode int, err error) bool,
+) (*proxyData.ObserverResponse, error) {
+	// canceling the context aborts the requests which lost the race against the accepted answer
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan readResult, len(observers))
+	numSent := 0
+	numInFlight := 0
+	sendNext := func() {
+		observer := observers[numSent]
+		numSent++
+		numInFlight++
+		go func() {
+			startTime := time.Now()
+			value, responseCode, err := read(ctx, observer)
+			results <- readResult{
+				response: &proxyData.ObserverResponse{
+					Observer:   observer,
+					StatusCode: responseCode,
+					Value:      value,
+					Err:        err,
+				},
+				duration: time.Since(startTime),
+			}
+		}()
+	}
+
+	isHedged := false
+	var hedgeTimer *time.Timer
+	var hedgeTimerChan <-chan time.Time
+	defer func() {
+		if hedgeTimer != nil {
+			hedgeTimer.Stop()
+		}
+	}()
+	armHedgeTimer := func() {
+		if hedgeTimer != nil {
+			hedgeTimer.Stop()
+		}
+		hedgeTimerChan = nil
+		if isHedged || !bp.hedgingPolicy.IsEnabled() || numSent >= len(observers) {
+			return
+		}
+
+		hedgeTimer = time.NewTimer(bp.hedgingPolicy.HedgeDelay())
+		hedgeTimerChan = hedgeTimer.C
+	}
+
+	areAllOverloaded := true
+	sendNext()
+	armHedgeTimer()
+	for numInFlight > 0 {
+		select {
+		case result := <-results:
+			numInFlight--
+			if isAnswer(result.response.StatusCode, result.response.Err) {
+				bp.hedgingPolicy.RecordLatency(result.duration)
+				return result.response, nil
+			}
+
+			log.Warn("read request failed", "observer", result.response.Observer.Address, "error", result.response.Err)
+			if !errors.Is(result.response.Err, ErrNodeOverloaded) {
+				areAllOverloaded = false
+			}
+			if numInFlight == 0 && numSent < len(observers) {
+				sendNext()
+				armHedgeTimer()
+			}
+		case <-hedgeTimerChan:
+			log.Debug("sending a hedged read request", "observer", observers[numSent].Address)
+			isHedged = true
+			sendNext()
+			armHedgeTimer()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if areAllOverloaded {
+		return nil, ErrObserversOverloaded
+	}
+
+	return nil, ErrSendingRequest
+}
+
+// ReadFromObserversWithQuorum sends an idempotent read request to several observers in parallel, if the quorum policy
+// is enabled for the given endpoint, and returns the answer given by enough of them. The answers are compared by their
+// digests. An observer which does not answer is replaced by the next one, if any, while the observers which give
+// another answer are logged. If not enough observers agree, ErrObserversDisagreement is returned. If the quorum policy
+// is not enabled for the endpoint, the read is done as by ReadFromObservers
+func (bp *BaseProcessor) ReadFromObserversWithQuorum(
+	ctx context.Context,
+	endpoint string,
+	observers []*proxyData.NodeData,
+	read func(ctx context.Context, observer *proxyData.NodeData) (interface{}, int, error),
+	isAnswer func(responseCode int, err error) bool,
+	digest func(value interface{}) (string, error),
+) (*proxyData.ObserverResponse, error) {
+	if !bp.quorumPolicy.IsEnabledFor(endpoint) {
+		return bp.ReadFromObservers(ctx, observers, read, isAnswer)
+	}
+
+	minAgreeing := int(bp.quorumPolicy.MinAgreeing())
+	if len(observers) < minAgreeing {
+		return nil, fmt.Errorf("%w: %d observers available, %d agreeing observers needed",
+			ErrObserversDisagreement, len(observers), minAgreeing)
+	}
+
+	// canceling the context aborts the requests which are not needed anymore once the quorum is reached
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan readResult, len(observers))
+	numSent := 0
+	numInFlight := 0
+	sendNext := func() {
+		observer := observers[numSent]
+		numSent++
+		numInFlight++
+		go func() {
+			value, responseCode, err := read(ctx, observer)
+			results <- readResult{
+				response: &proxyData.ObserverResponse{
+					Observer:   observer,
+					StatusCode: responseCode,
+					Value:      value,
+					Err:        err,
+				},
+			}
+		}()
+	}
+
+	for numSent < int(bp.quorumPolicy.NumObservers()) && numSent < len(observers) {
+		sendNext()
+	}
+
+	areAllOverloaded := true
+	answersByDigest := make(map[string][]*proxyData.ObserverResponse)
+	for numInFlight > 0 {
+		select {
+		case result := <-results:
+			numInFlight--
+			answerDigest, err := bp.computeQuorumAnswerDigest(result.response, isAnswer, digest)
+			if err != nil {
+				log.Warn("quorum read request failed", "observer", result.response.Observer.Address, "error", err.Error())
+				if !errors.Is(err, ErrNodeOverloaded) {
+					areAllOverloaded = false
+				}
+				if numSent < len(observers) {
+					sendNext()
+				}
+				continue
+			}
+
+			answers := append(answersByDigest[answerDigest], result.response)
+			answersByDigest[answerDigest] = answers
+			if len(answers) >= minAgreeing {
+				logDisagreeingObservers(endpoint, answersByDigest, answerDigest)
+				return answers[0], nil
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if len(answersByDigest) == 0 {
+		if areAllOverloaded {
+			return nil, ErrObserversOverloaded
+		}
+		return nil, ErrSendingRequest
+	}
+
+	logDisagreeingObservers(endpoint, answersByDigest, "")
+	return nil, fmt.Errorf("%w: %d different answers from %d observers, %d agreeing observers needed",
+		ErrObserversDisagreement, len(answersByDigest), countAnswers(answersByDigest), minAgreeing)
+}
+
+func (bp *BaseProcessor) computeQuorumAnswerDigest(
+	response *proxyData.ObserverResponse,
+	isAnswer func(responseCode int, err error) bool,
+	digest func(value interface{}) (string, error),
+) (string, error) {
+	if !isAnswer(response.StatusCode, response.Err) {
+		if response.Err != nil {
+			return "", response.Err
+		}
+
+		return "", fmt.Errorf("unexpected http status %d", response.StatusCode)
+	}
+
+	answerDigest, err := digest(response.Value)
+	if err != nil {
+		return "", err
+	}
+
+	// the same body with another status code is another answer
+	return fmt.Sprintf("%d:%s", response.StatusCode, answerDigest), nil
+}
+
+// logDisagreeingObservers logs the observers whose answers differ from the accepted one. If no answer was accepted,
+// all the observers are logged
+func logDisagreeingObservers(endpoint string, answersByDigest map[string][]*proxyData.ObserverResponse, acceptedDigest string) {
+	if len(answersByDigest) < 2 {
+		return
+	}
+
+	for answerDigest, answers := range answersByDigest {
+		if answerDigest == acceptedDigest {
+			continue
+		}
+
+		for _, answer := range answers {
+			log.Warn("observer disagrees on quorum read",
+				"endpoint", endpoint,
+				"observer", answer.Observer.Address,
+				"answer", answerDigest,
+				"accepted answer", acceptedDigest)
+		}
+	}
+}
+
+func countAnswers(answersByDigest map[string][]*proxyData.ObserverResponse) int {
+	numAnswers := 0
+	for _, answers := range answersByDigest {
+		numAnswers += len(answers)
+	}
+
+	return numAnswers
+}
+
+// doRequest sends the request if the node's circuit allows it and records the outcome. Transport errors, timeouts and
+// the responses telling the node is unavailable are counted as failures, while the other answers, including the 4xx
+// and 500 ones caused by the request itself, are counted as successes. The requests aborted by the caller are not
+// counted at all
+func (bp *BaseProcessor) doRequest(address string, req *http.Request) (*http.Response, error) {
+	if !bp.circuitBreaker.AllowRequest(address) {
+		return nil, ErrCircuitOpen
+	}
+
+	startTime := time.Now()
+	resp, err := bp.httpClients.GetHttpClient(address).Do(req)
+	endpointName, _ := proxyData.GetEndpointName(req.Context())
+	bp.metrics.RecordUpstreamRequest(address, endpointName, getUpstreamStatus(req, resp, err), time.Since(startTime))
+	if req.Context().Err() != nil {
+		// the node is not to blame, so the outcome is not recorded
+		return resp, err
+	}
+	if isNodeFailure(resp, err) {
+		bp.circuitBreaker.RecordFailure(address)
+	} else {
+		bp.circuitBreaker.RecordSuccess(address)
+	}
+
+	return resp, err
+}
+
+// isNodeFailure returns true if the request failed because of the node rather than because of the request itself. The
+// node answers with 500 when it rejects the request's input, so only the gateway statuses are counted
+func isNodeFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// getUpstreamStatus returns the status code of the response or, if there is no response, the kind of error
+func getUpstreamStatus(req *http.Request, resp *http.Response, err error) string {
+	switch {
+	case err == nil:
+		return strconv.Itoa(resp.StatusCode)
+	case req.Context().Err() != nil:
+		return "canceled"
+	case isTimeoutError(err):
+		return "timeout"
+	default:
+		return "error"
+	}
+}
+
+// acquireRequestSlot waits for a free in-flight request slot on the given node. A request rejected because the node
+// is overloaded gets http.StatusServiceUnavailable, so it can be sent to another node
+func (bp *BaseProcessor) acquireRequestSlot(ctx context.Context, address string) (int, error) {
+	err := bp.concurrencyLimiter.Acquire(ctx, address)
+	if err == nil {
+		return http.StatusOK, nil
+	}
+	if err == ErrNodeOverloaded {
+		log.Debug("node overloaded, request rejected", "address", address)
+		return http.StatusServiceUnavailable, err
+	}
+
+	return http.StatusRequestTimeout, err
+}
+
+// trackRequest marks the start of a request towards the given node and returns the function which marks its end
+func (bp *BaseProcessor) trackRequest(address string) func(outcome observer.RequestOutcome) {
+	bp.nodesStatistics.RequestStarted(address)
+	startTime := time.Now()
+
+	return func(outcome observer.RequestOutcome) {
+		bp.nodesStatistics.RequestFinished(address, time.Since(startTime), outcome)
+	}
+}
+
+// getRequestOutcome tells how a request sent through doRequest ended. The requests canceled by the proxy, such as the
+// losing hedged requests, are aborted, as the node is not to blame
+func getRequestOutcome(req *http.Request, resp *http.Response, err error) observer.RequestOutcome {
+	if req.Context().Err() != nil {
+		return observer.RequestAborted
+	}
+	if err != nil || resp.StatusCode >= http.StatusInternalServerError {
+		return observer.RequestFailed
+	}
+
+	return observer.RequestAnswered
+}
+
 func isTimeoutError(err error) bool {
 	if err, ok := err.(net.Error); ok && err.Timeout() {
 		return true
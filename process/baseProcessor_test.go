@@ -2,18 +2,22 @@ package process_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/observer"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/disabled"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,31 +47,47 @@ func createTestHttpServer(
 	}))
 }
 
-func TestNewBaseProcessor_WithInvalidRequestTimeoutShouldErr(t *testing.T) {
+func createNodesHttpClients(timeout time.Duration) process.NodesHttpClientsHandler {
+	nodesHttpClients, _ := process.NewNodesHttpClients(&http.Client{Timeout: timeout}, nil)
+	return nodesHttpClients
+}
+
+func createArgsBaseProcessor() process.ArgsBaseProcessor {
+	return process.ArgsBaseProcessor{
+		HttpClients:              createNodesHttpClients(5 * time.Second),
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		ObserversProvider:        &mock.ObserversProviderStub{},
+		FullHistoryNodesProvider: &mock.ObserversProviderStub{},
+		NodesStatistics:          &mock.NodesStatisticsStub{},
+		CircuitBreaker:           &mock.CircuitBreakerStub{},
+		ConcurrencyLimiter:       &disabled.ConcurrencyLimiter{},
+		RetryPolicy:              &disabled.RetryPolicy{},
+		HedgingPolicy:            &disabled.HedgingPolicy{},
+		QuorumPolicy:             &disabled.QuorumPolicy{},
+		RoutingTable:             createRoutingTable(nil, nil),
+		RequestsCoalescer:        &disabled.RequestsCoalescer{},
+		Metrics:                  &disabled.Metrics{},
+		PubKeyConverter:          &mock.PubKeyConverterMock{},
+	}
+}
+
+func TestNewBaseProcessor_WithNilNodesHttpClientsShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBaseProcessor(
-		-5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	args.HttpClients = nil
+	bp, err := process.NewBaseProcessor(args)
 
 	assert.Nil(t, bp)
-	assert.Equal(t, process.ErrInvalidRequestTimeout, err)
+	assert.Equal(t, process.ErrNilNodesHttpClients, err)
 }
 
 func TestNewBaseProcessor_WithNilShardCoordinatorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBaseProcessor(
-		5,
-		nil,
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	args.ShardCoordinator = nil
+	bp, err := process.NewBaseProcessor(args)
 
 	assert.Nil(t, bp)
 	assert.Equal(t, process.ErrNilShardCoordinator, err)
@@ -76,13 +96,9 @@ func TestNewBaseProcessor_WithNilShardCoordinatorShouldErr(t *testing.T) {
 func TestNewBaseProcessor_WithNilObserversProviderShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		nil,
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	args.FullHistoryNodesProvider = nil
+	bp, err := process.NewBaseProcessor(args)
 
 	assert.Nil(t, bp)
 	assert.True(t, errors.Is(err, process.ErrNilNodesProvider))
@@ -91,28 +107,107 @@ func TestNewBaseProcessor_WithNilObserversProviderShouldErr(t *testing.T) {
 func TestNewBaseProcessor_WithNilFullHistoryNodesProviderShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		nil,
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	args.ObserversProvider = nil
+	bp, err := process.NewBaseProcessor(args)
 
 	assert.Nil(t, bp)
 	assert.True(t, errors.Is(err, process.ErrNilNodesProvider))
 }
 
+func TestNewBaseProcessor_WithNilNodesStatisticsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsBaseProcessor()
+	args.NodesStatistics = nil
+	bp, err := process.NewBaseProcessor(args)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilNodesStatistics, err)
+}
+
+func TestNewBaseProcessor_WithNilCircuitBreakerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsBaseProcessor()
+	args.CircuitBreaker = nil
+	bp, err := process.NewBaseProcessor(args)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilCircuitBreaker, err)
+}
+
+func TestNewBaseProcessor_WithNilConcurrencyLimiterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsBaseProcessor()
+	args.ConcurrencyLimiter = nil
+	bp, err := process.NewBaseProcessor(args)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilConcurrencyLimiter, err)
+}
+
+func TestNewBaseProcessor_WithNilRoutingTableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsBaseProcessor()
+	args.RoutingTable = nil
+	bp, err := process.NewBaseProcessor(args)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilRoutingTable, err)
+}
+
+func TestNewBaseProcessor_WithNilRetryPolicyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsBaseProcessor()
+	args.RetryPolicy = nil
+	bp, err := process.NewBaseProcessor(args)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilRetryPolicy, err)
+}
+
+func TestNewBaseProcessor_WithNilHedgingPolicyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsBaseProcessor()
+	args.HedgingPolicy = nil
+	bp, err := process.NewBaseProcessor(args)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilHedgingPolicy, err)
+}
+
+func TestNewBaseProcessor_WithNilQuorumPolicyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsBaseProcessor()
+	args.QuorumPolicy = nil
+	bp, err := process.NewBaseProcessor(args)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilQuorumPolicy, err)
+}
+
+func TestNewBaseProcessor_WithNilMetricsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsBaseProcessor()
+	args.Metrics = nil
+	bp, err := process.NewBaseProcessor(args)
+
+	assert.Nil(t, bp)
+	assert.Equal(t, process.ErrNilMetricsHandler, err)
+}
+
 func TestNewBaseProcessor_WithOkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	bp, err := process.NewBaseProcessor(args)
 
 	assert.NotNil(t, bp)
 	assert.Nil(t, err)
@@ -124,23 +219,196 @@ func TestBaseProcessor_GetObserversEmptyListShouldWork(t *testing.T) {
 	t.Parallel()
 
 	observersSlice := []*data.NodeData{{Address: "addr1"}}
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{
-			GetNodesByShardIdCalled: func(_ uint32) ([]*data.NodeData, error) {
-				return observersSlice, nil
-			},
-		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdCalled: func(_ uint32) ([]*data.NodeData, error) {
+			return observersSlice, nil
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
 	observers, err := bp.GetObservers(0)
 
 	assert.Nil(t, err)
 	assert.Equal(t, observersSlice, observers)
 }
 
+func createRoutingTable(routes map[string]process.Route, customPools map[string][]string) process.RoutingTableHandler {
+	routingTable, _ := process.NewRoutingTable(process.ArgsRoutingTable{
+		Routes:      routes,
+		CustomPools: customPools,
+	})
+
+	return routingTable
+}
+
+func TestBaseProcessor_GetNodesForRequestShouldPreferTheRoutingKeyFromTheContext(t *testing.T) {
+	t.Parallel()
+
+	observersSlice := []*data.NodeData{{Address: "addr1"}}
+	usedKeys := make([]string, 0)
+	args := createArgsBaseProcessor()
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdForKeyCalled: func(_ uint32, key string) ([]*data.NodeData, error) {
+			usedKeys = append(usedKeys, key)
+			return observersSlice, nil
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
+
+	observers, err := bp.GetNodesForRequest(context.Background(), process.RequestCategoryAccount, 0, "address")
+	assert.Nil(t, err)
+	assert.Equal(t, observersSlice, observers)
+
+	ctx := data.WithRoutingKey(context.Background(), "session")
+	_, _ = bp.GetNodesForRequest(ctx, process.RequestCategoryAccount, 0, "address")
+	assert.Equal(t, []string{"address", "session"}, usedKeys)
+}
+
+func TestBaseProcessor_GetNodesForRequestShouldFollowTheDefaultRoutes(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{{Address: "observer"}}
+	fullHistoryNodes := []*data.NodeData{{Address: "full history node"}}
+	args := createArgsBaseProcessor()
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdForKeyCalled: func(_ uint32, _ string) ([]*data.NodeData, error) {
+			return observers, nil
+		},
+	}
+	args.FullHistoryNodesProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdForKeyCalled: func(shardId uint32, _ string) ([]*data.NodeData, error) {
+			if shardId == 0 {
+				return fullHistoryNodes, nil
+			}
+			return nil, errors.New("no full history node in shard")
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
+
+	nodes, err := bp.GetNodesForRequest(context.Background(), process.RequestCategoryAccount, 0, "")
+	assert.Nil(t, err)
+	assert.Equal(t, observers, nodes)
+
+	nodes, err = bp.GetNodesForRequest(context.Background(), process.RequestCategoryBlock, 0, "")
+	assert.Nil(t, err)
+	assert.Equal(t, fullHistoryNodes, nodes)
+
+	nodes, err = bp.GetNodesForRequest(context.Background(), process.RequestCategoryBlock, 1, "")
+	assert.Nil(t, err)
+	assert.Equal(t, observers, nodes)
+}
+
+func TestBaseProcessor_GetNodesForRequestWithFallbackOnFailureShouldReturnTheNodesOfAllPools(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{{Address: "addr1"}, {Address: "addr2"}}
+	fullHistoryNodes := []*data.NodeData{{Address: "addr3"}, {Address: "addr1"}}
+	args := createArgsBaseProcessor()
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetAllNodesCalled: func() ([]*data.NodeData, error) {
+			return observers, nil
+		},
+	}
+	args.FullHistoryNodesProvider = &mock.ObserversProviderStub{
+		GetAllNodesCalled: func() ([]*data.NodeData, error) {
+			return fullHistoryNodes, nil
+		},
+	}
+	args.RoutingTable = createRoutingTable(map[string]process.Route{
+		process.RequestCategoryNetwork: {
+			Pools:    []string{process.PoolFullHistoryNodes, process.PoolObservers},
+			Fallback: process.FallbackOnFailure,
+		},
+	}, nil)
+	bp, _ := process.NewBaseProcessor(args)
+
+	nodes, err := bp.GetAllNodesForRequest(process.RequestCategoryNetwork)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []*data.NodeData{fullHistoryNodes[0], fullHistoryNodes[1], observers[1]}, nodes)
+}
+
+func TestBaseProcessor_GetNodesForRequestShouldReturnOnlyTheNodesHavingTheRequiredTags(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "addr1", Tags: []string{"esdt-indexed"}},
+		{Address: "addr2", Tags: []string{"high-memory", "esdt-indexed"}},
+	}
+	fullHistoryNodes := []*data.NodeData{{Address: "addr3"}}
+	args := createArgsBaseProcessor()
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdForKeyCalled: func(_ uint32, _ string) ([]*data.NodeData, error) {
+			return observers, nil
+		},
+	}
+	args.FullHistoryNodesProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdForKeyCalled: func(_ uint32, _ string) ([]*data.NodeData, error) {
+			return fullHistoryNodes, nil
+		},
+	}
+	args.RoutingTable = createRoutingTable(map[string]process.Route{
+		process.RequestCategoryVmQuery: {
+			Pools:        []string{process.PoolObservers},
+			RequiredTags: []string{"high-memory"},
+		},
+		process.RequestCategoryBlock: {
+			Pools:        []string{process.PoolFullHistoryNodes, process.PoolObservers},
+			RequiredTags: []string{"esdt-indexed"},
+		},
+	}, nil)
+	bp, _ := process.NewBaseProcessor(args)
+
+	nodes, err := bp.GetNodesForRequest(context.Background(), process.RequestCategoryVmQuery, 0, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []*data.NodeData{observers[1]}, nodes)
+
+	nodes, err = bp.GetNodesForRequest(context.Background(), process.RequestCategoryBlock, 0, "")
+	assert.Nil(t, err)
+	assert.Equal(t, observers, nodes)
+
+	nodes, err = bp.GetNodesForRequest(context.Background(), process.RequestCategoryAccount, 0, "")
+	assert.Nil(t, err)
+	assert.Equal(t, observers, nodes)
+}
+
+func TestBaseProcessor_GetNodesForRequestShouldUseTheCustomPools(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{{Address: "addr1"}, {Address: "addr2"}}
+	fullHistoryNodes := []*data.NodeData{{Address: "addr3"}}
+	args := createArgsBaseProcessor()
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdForKeyCalled: func(_ uint32, _ string) ([]*data.NodeData, error) {
+			return observers, nil
+		},
+	}
+	args.FullHistoryNodesProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdForKeyCalled: func(_ uint32, _ string) ([]*data.NodeData, error) {
+			return fullHistoryNodes, nil
+		},
+	}
+	args.RoutingTable = createRoutingTable(
+		map[string]process.Route{
+			process.RequestCategoryVmQuery: {Pools: []string{"sc-queries", process.PoolObservers}},
+			process.RequestCategoryAccount: {Pools: []string{"unavailable", process.PoolObservers}},
+		},
+		map[string][]string{
+			"sc-queries":  {"addr3", "addr2"},
+			"unavailable": {"addr4"},
+		},
+	)
+	bp, _ := process.NewBaseProcessor(args)
+
+	nodes, err := bp.GetNodesForRequest(context.Background(), process.RequestCategoryVmQuery, 0, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []*data.NodeData{observers[1], fullHistoryNodes[0]}, nodes)
+
+	nodes, err = bp.GetNodesForRequest(context.Background(), process.RequestCategoryAccount, 0, "")
+	assert.Nil(t, err)
+	assert.Equal(t, observers, nodes)
+}
+
 //------- ComputeShardId
 
 func TestBaseProcessor_ComputeShardId(t *testing.T) {
@@ -158,17 +426,14 @@ func TestBaseProcessor_ComputeShardId(t *testing.T) {
 	}
 
 	msc, _ := sharding.NewMultiShardCoordinator(3, 0)
-	bp, _ := process.NewBaseProcessor(
-		5,
-		msc,
-		&mock.ObserversProviderStub{
-			GetNodesByShardIdCalled: func(_ uint32) ([]*data.NodeData, error) {
-				return observersList, nil
-			},
-		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	args.ShardCoordinator = msc
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdCalled: func(_ uint32) ([]*data.NodeData, error) {
+			return observersList, nil
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
 
 	//there are 2 shards, compute ID should correctly process
 	addressInShard0 := []byte{0}
@@ -196,19 +461,77 @@ func TestBaseProcessor_CallGetRestEndPoint(t *testing.T) {
 	defer server.Close()
 
 	tsRecovered := &testStruct{}
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
-	_, err := bp.CallGetRestEndPoint(server.URL, "/some/path", tsRecovered)
+	args := createArgsBaseProcessor()
+	bp, _ := process.NewBaseProcessor(args)
+	_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", tsRecovered)
 
 	assert.Nil(t, err)
 	assert.Equal(t, ts, tsRecovered)
 }
 
+func TestBaseProcessor_CallGetRestEndPointShouldUpdateNodesStatistics(t *testing.T) {
+	t.Parallel()
+
+	server := createTestHttpServer("/some/path", []byte("{}"))
+	defer server.Close()
+
+	startedAddresses := make([]string, 0)
+	finishedAddresses := make([]string, 0)
+	outcomes := make([]observer.RequestOutcome, 0)
+	args := createArgsBaseProcessor()
+	args.NodesStatistics = &mock.NodesStatisticsStub{
+		RequestStartedCalled: func(address string) {
+			startedAddresses = append(startedAddresses, address)
+		},
+		RequestFinishedCalled: func(address string, duration time.Duration, outcome observer.RequestOutcome) {
+			assert.Equal(t, len(finishedAddresses)+1, len(startedAddresses))
+			finishedAddresses = append(finishedAddresses, address)
+			outcomes = append(outcomes, outcome)
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
+	_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{})
+	assert.Nil(t, err)
+
+	server.Close()
+	_, err = bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{})
+	assert.NotNil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _ = bp.CallPostRestEndPoint(ctx, server.URL, "/some/path", "data", &testStruct{})
+
+	assert.Equal(t, []string{server.URL, server.URL, server.URL}, startedAddresses)
+	assert.Equal(t, []string{server.URL, server.URL, server.URL}, finishedAddresses)
+	assert.Equal(t, []observer.RequestOutcome{observer.RequestAnswered, observer.RequestFailed, observer.RequestAborted}, outcomes)
+}
+
+func TestBaseProcessor_CallGetRestEndPointShouldRecordTheUpstreamRequest(t *testing.T) {
+	t.Parallel()
+
+	server := createTestHttpServer("/some/path?withResults=true", []byte("{}"))
+	defer server.Close()
+
+	recordedRequests := make([]string, 0)
+	args := createArgsBaseProcessor()
+	args.Metrics = &mock.MetricsHandlerStub{
+		RecordUpstreamRequestCalled: func(address string, endpoint string, status string, duration time.Duration) {
+			assert.Equal(t, server.URL, address)
+			recordedRequests = append(recordedRequests, endpoint+" "+status)
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
+	ctx := data.WithEndpointName(context.Background(), "/v1.0/some/:param")
+	_, err := bp.CallGetRestEndPoint(ctx, server.URL, "/some/path?withResults=true", &testStruct{})
+	require.Nil(t, err)
+
+	server.Close()
+	_, err = bp.CallGetRestEndPoint(context.Background(), server.URL, "/other/path", &testStruct{})
+	require.NotNil(t, err)
+
+	assert.Equal(t, []string{"/v1.0/some/:param 200", " error"}, recordedRequests)
+}
+
 func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 	ts := &testStruct{
 		Nonce: 10000,
@@ -224,14 +547,10 @@ func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 	defer testServer.Close()
 
 	tsRecovered := &testStruct{}
-	bp, _ := process.NewBaseProcessor(
-		1,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
-	_, err := bp.CallGetRestEndPoint(testServer.URL, "/some/path", tsRecovered)
+	args := createArgsBaseProcessor()
+	args.HttpClients = createNodesHttpClients(time.Second)
+	bp, _ := process.NewBaseProcessor(args)
+	_, err := bp.CallGetRestEndPoint(context.Background(), testServer.URL, "/some/path", tsRecovered)
 
 	assert.NotEqual(t, ts.Name, tsRecovered.Name)
 	assert.NotNil(t, err)
@@ -248,14 +567,9 @@ func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 	fmt.Printf("Server: %s\n", server.URL)
 	defer server.Close()
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
-	rc, err := bp.CallPostRestEndPoint(server.URL, "/some/path", ts, tsRecv)
+	args := createArgsBaseProcessor()
+	bp, _ := process.NewBaseProcessor(args)
+	rc, err := bp.CallPostRestEndPoint(context.Background(), server.URL, "/some/path", ts, tsRecv)
 
 	assert.Nil(t, err)
 	assert.Equal(t, ts, tsRecv)
@@ -278,14 +592,10 @@ func TestBaseProcessor_CallPostRestEndPointShouldTimeout(t *testing.T) {
 	fmt.Printf("Server: %s\n", testServer.URL)
 	defer testServer.Close()
 
-	bp, _ := process.NewBaseProcessor(
-		1,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
-	rc, err := bp.CallPostRestEndPoint(testServer.URL, "/some/path", ts, tsRecv)
+	args := createArgsBaseProcessor()
+	args.HttpClients = createNodesHttpClients(time.Second)
+	bp, _ := process.NewBaseProcessor(args)
+	rc, err := bp.CallPostRestEndPoint(context.Background(), testServer.URL, "/some/path", ts, tsRecv)
 
 	assert.NotEqual(t, tsRecv.Name, ts.Name)
 	assert.NotNil(t, err)
@@ -314,17 +624,13 @@ func TestBaseProcessor_GetAllObserversWithOkValuesShouldPass(t *testing.T) {
 		Address: server.URL,
 	})
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{},
-		&mock.ObserversProviderStub{
-			GetAllNodesCalled: func() ([]*data.NodeData, error) {
-				return observersList, nil
-			},
+	args := createArgsBaseProcessor()
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetAllNodesCalled: func() ([]*data.NodeData, error) {
+			return observersList, nil
 		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
+	}
+	bp, _ := process.NewBaseProcessor(args)
 
 	assert.Nil(t, err)
 
@@ -355,26 +661,23 @@ func TestBaseProcessor_GetObserversOnePerShardShouldWork(t *testing.T) {
 		{Address: "shard meta - id 1"},
 	}
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{NumShards: 2},
-		&mock.ObserversProviderStub{
-			GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
-				switch shardId {
-				case 0:
-					return observersListShard0, nil
-				case 1:
-					return observersListShard1, nil
-				case core.MetachainShardId:
-					return observersListShardMeta, nil
-				}
-
-				return nil, nil
-			},
-		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{NumShards: 2}
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			switch shardId {
+			case 0:
+				return observersListShard0, nil
+			case 1:
+				return observersListShard1, nil
+			case core.MetachainShardId:
+				return observersListShardMeta, nil
+			}
+
+			return nil, nil
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
 
 	observers, err := bp.GetObserversOnePerShard()
 	assert.NoError(t, err)
@@ -403,26 +706,23 @@ func TestBaseProcessor_GetObserversOnePerShardOneShardHasNoObserverShouldWork(t
 		{Address: "shard meta - id 1"},
 	}
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{NumShards: 2},
-		&mock.ObserversProviderStub{
-			GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
-				switch shardId {
-				case 0:
-					return observersListShard0, nil
-				case 1:
-					return observersListShard1, nil
-				case core.MetachainShardId:
-					return observersListShardMeta, nil
-				}
-
-				return nil, nil
-			},
-		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{NumShards: 2}
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			switch shardId {
+			case 0:
+				return observersListShard0, nil
+			case 1:
+				return observersListShard1, nil
+			case core.MetachainShardId:
+				return observersListShardMeta, nil
+			}
+
+			return nil, nil
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
 
 	observers, err := bp.GetObserversOnePerShard()
 	assert.NoError(t, err)
@@ -451,26 +751,23 @@ func TestBaseProcessor_GetObserversOnePerShardMetachainHasNoObserverShouldWork(t
 	}
 	var observersListShardMeta []*data.NodeData
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{NumShards: 2},
-		&mock.ObserversProviderStub{
-			GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
-				switch shardId {
-				case 0:
-					return observersListShard0, nil
-				case 1:
-					return observersListShard1, nil
-				case core.MetachainShardId:
-					return observersListShardMeta, nil
-				}
-
-				return nil, nil
-			},
-		},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{NumShards: 2}
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			switch shardId {
+			case 0:
+				return observersListShard0, nil
+			case 1:
+				return observersListShard1, nil
+			case core.MetachainShardId:
+				return observersListShardMeta, nil
+			}
+
+			return nil, nil
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
 
 	observers, err := bp.GetObserversOnePerShard()
 	assert.NoError(t, err)
@@ -503,26 +800,23 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 		{Address: "shard meta - id 1"},
 	}
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{NumShards: 2},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{
-			GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
-				switch shardId {
-				case 0:
-					return observersListShard0, nil
-				case 1:
-					return observersListShard1, nil
-				case core.MetachainShardId:
-					return observersListShardMeta, nil
-				}
-
-				return nil, nil
-			},
-		},
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{NumShards: 2}
+	args.FullHistoryNodesProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			switch shardId {
+			case 0:
+				return observersListShard0, nil
+			case 1:
+				return observersListShard1, nil
+			case core.MetachainShardId:
+				return observersListShardMeta, nil
+			}
+
+			return nil, nil
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
 
 	observers, err := bp.GetFullHistoryNodesOnePerShard()
 	assert.NoError(t, err)
@@ -536,14 +830,574 @@ func TestBaseProcessor_GetFullHistoryNodesOnePerShardShouldWork(t *testing.T) {
 func TestBaseProcessor_GetShardIDs(t *testing.T) {
 	t.Parallel()
 
-	bp, _ := process.NewBaseProcessor(
-		5,
-		&mock.ShardCoordinatorMock{NumShards: 3},
-		&mock.ObserversProviderStub{},
-		&mock.ObserversProviderStub{},
-		&mock.PubKeyConverterMock{},
-	)
+	args := createArgsBaseProcessor()
+	args.ShardCoordinator = &mock.ShardCoordinatorMock{NumShards: 3}
+	bp, _ := process.NewBaseProcessor(args)
 
 	expected := []uint32{0, 1, 2, core.MetachainShardId}
 	require.Equal(t, expected, bp.GetShardIDs())
 }
+
+func TestBaseProcessor_CallGetRestEndPointWithOpenCircuitShouldNotSendRequest(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		wasCalled = true
+	}))
+	defer server.Close()
+
+	args := createArgsBaseProcessor()
+	args.CircuitBreaker = &mock.CircuitBreakerStub{
+		AllowRequestCalled: func(address string) bool {
+			return false
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
+
+	rc, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{})
+	assert.Equal(t, process.ErrCircuitOpen, err)
+	assert.Equal(t, http.StatusServiceUnavailable, rc)
+
+	rc, err = bp.CallPostRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{}, &testStruct{})
+	assert.Equal(t, process.ErrCircuitOpen, err)
+	assert.Equal(t, http.StatusServiceUnavailable, rc)
+	assert.False(t, wasCalled)
+}
+
+func TestBaseProcessor_CallGetRestEndPointShouldRecordTheOutcomeOnTheCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	okServer := createTestHttpServer("/some/path", []byte("{}"))
+	defer okServer.Close()
+	// the node answers with 500 when it rejects the request's input, which is not the node's fault
+	badRequestServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusInternalServerError)
+		_, _ = rw.Write([]byte("{}"))
+	}))
+	defer badRequestServer.Close()
+	failingServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = rw.Write([]byte("{}"))
+	}))
+	defer failingServer.Close()
+
+	successes := make([]string, 0)
+	failures := make([]string, 0)
+	args := createArgsBaseProcessor()
+	args.CircuitBreaker = &mock.CircuitBreakerStub{
+		RecordSuccessCalled: func(address string) {
+			successes = append(successes, address)
+		},
+		RecordFailureCalled: func(address string) {
+			failures = append(failures, address)
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
+
+	_, _ = bp.CallGetRestEndPoint(context.Background(), okServer.URL, "/some/path", &testStruct{})
+	_, _ = bp.CallGetRestEndPoint(context.Background(), badRequestServer.URL, "/some/path", &testStruct{})
+	_, _ = bp.CallGetRestEndPoint(context.Background(), failingServer.URL, "/some/path", &testStruct{})
+	_, _ = bp.CallGetRestEndPoint(context.Background(), "http://127.0.0.1:1", "/some/path", &testStruct{})
+
+	assert.Equal(t, []string{okServer.URL, badRequestServer.URL}, successes)
+	assert.Equal(t, []string{failingServer.URL, "http://127.0.0.1:1"}, failures)
+}
+
+func TestBaseProcessor_GetObserversShouldSkipOpenCircuits(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "addr1", ShardId: 0},
+		{Address: "addr2", ShardId: 0},
+	}
+	openCircuits := map[string]struct{}{"addr1": {}}
+	args := createArgsBaseProcessor()
+	args.ObserversProvider = &mock.ObserversProviderStub{
+		GetNodesByShardIdCalled: func(_ uint32) ([]*data.NodeData, error) {
+			return observers, nil
+		},
+		GetAllNodesCalled: func() ([]*data.NodeData, error) {
+			return observers, nil
+		},
+	}
+	args.CircuitBreaker = &mock.CircuitBreakerStub{
+		IsOpenCalled: func(address string) bool {
+			_, isOpen := openCircuits[address]
+			return isOpen
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
+
+	res, err := bp.GetObservers(0)
+	assert.Nil(t, err)
+	assert.Equal(t, []*data.NodeData{observers[1]}, res)
+
+	res, err = bp.GetAllObservers()
+	assert.Nil(t, err)
+	assert.Equal(t, []*data.NodeData{observers[1]}, res)
+
+	openCircuits["addr2"] = struct{}{}
+	res, err = bp.GetObservers(0)
+	assert.Nil(t, err)
+	assert.Equal(t, observers, res)
+}
+
+func TestBaseProcessor_CallGetRestEndPointWithCanceledContextShouldAbortTheRequest(t *testing.T) {
+	t.Parallel()
+
+	requestReceived := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		close(requestReceived)
+		<-release
+	}))
+	defer func() {
+		close(release)
+		server.Close()
+	}()
+
+	numRecorded := uint32(0)
+	args := createArgsBaseProcessor()
+	args.CircuitBreaker = &mock.CircuitBreakerStub{
+		RecordSuccessCalled: func(address string) {
+			atomic.AddUint32(&numRecorded, 1)
+		},
+		RecordFailureCalled: func(address string) {
+			atomic.AddUint32(&numRecorded, 1)
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-requestReceived
+		cancel()
+	}()
+
+	startTime := time.Now()
+	_, err := bp.CallGetRestEndPoint(ctx, server.URL, "/some/path", &testStruct{})
+
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.True(t, time.Since(startTime) < time.Second)
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&numRecorded))
+}
+
+func TestBaseProcessor_CallGetRestEndPointOnOverloadedNodeShouldNotSendTheRequest(t *testing.T) {
+	t.Parallel()
+
+	numRequests := uint32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddUint32(&numRequests, 1)
+	}))
+	defer server.Close()
+
+	numReleased := 0
+	args := createArgsBaseProcessor()
+	args.ConcurrencyLimiter = &mock.ConcurrencyLimiterStub{
+		AcquireCalled: func(ctx context.Context, address string) error {
+			return process.ErrNodeOverloaded
+		},
+		ReleaseCalled: func(address string) {
+			numReleased++
+		},
+	}
+	bp, _ := process.NewBaseProcessor(args)
+
+	responseCode, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{})
+	assert.Equal(t, http.StatusServiceUnavailable, responseCode)
+	assert.Equal(t, process.ErrNodeOverloaded, err)
+
+	responseCode, err = bp.CallPostRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{}, &testStruct{})
+	assert.Equal(t, http.StatusServiceUnavailable, responseCode)
+	assert.Equal(t, process.ErrNodeOverloaded, err)
+
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&numRequests))
+	assert.Equal(t, 0, numReleased)
+}
+
+func TestBaseProcessor_CallGetRestEndPointShouldReleaseTheRequestSlot(t *testing.T) {
+	t.Parallel()
+
+	response, _ := json.Marshal(testStruct{Nonce: 10, Name: "a name"})
+	server := createTestHttpServer("/some/path", response)
+	defer server.Close()
+
+	args := createArgsBaseProcessor()
+	args.ConcurrencyLimiter, _ = process.NewNodesConcurrencyLimiter(process.ArgsNodesConcurrencyLimiter{
+		MaxInFlightRequests: 1,
+	})
+	bp, _ := process.NewBaseProcessor(args)
+
+	for i := 0; i < 3; i++ {
+		_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{})
+		require.Nil(t, err)
+	}
+	assert.Equal(t, data.NodeLoadStatus{}, args.ConcurrencyLimiter.GetLoadStatus(server.URL))
+}
+
+func TestBaseProcessor_CallGetRestEndPointShouldCoalesceTheIdenticalConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	releaseResponse := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		atomic.AddUint32(&numCalls, 1)
+		<-releaseResponse
+		response, _ := json.Marshal(testStruct{Nonce: 10, Name: req.URL.Path})
+		_, _ = rw.Write(response)
+	}))
+	defer server.Close()
+
+	args := createArgsBaseProcessor()
+	args.RequestsCoalescer = process.NewRequestsCoalescer()
+	bp, _ := process.NewBaseProcessor(args)
+
+	numRequests := 5
+	values := make([]*testStruct, numRequests)
+	errs := make(chan error, numRequests)
+	for i := 0; i < numRequests; i++ {
+		values[i] = &testStruct{}
+		go func(value *testStruct) {
+			_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", value)
+			errs <- err
+		}(values[i])
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(releaseResponse)
+	for i := 0; i < numRequests; i++ {
+		require.Nil(t, <-errs)
+	}
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	for _, value := range values {
+		assert.Equal(t, testStruct{Nonce: 10, Name: "/some/path"}, *value)
+	}
+
+	value := &testStruct{}
+	_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/other/path", value)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
+	assert.Equal(t, "/other/path", value.Name)
+}
+
+func TestBaseProcessor_CallGetRestEndPointCoalescedRequestsShouldNotShareTheDecodedValues(t *testing.T) {
+	t.Parallel()
+
+	releaseResponse := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		<-releaseResponse
+		_, _ = rw.Write([]byte(`{"data":{"nonce":10,"hashes":["a","b"]},"code":"successful"}`))
+	}))
+	defer server.Close()
+
+	args := createArgsBaseProcessor()
+	args.RequestsCoalescer = process.NewRequestsCoalescer()
+	bp, _ := process.NewBaseProcessor(args)
+
+	numRequests := 2
+	values := make([]*data.GenericAPIResponse, numRequests)
+	errs := make(chan error, numRequests)
+	for i := 0; i < numRequests; i++ {
+		values[i] = &data.GenericAPIResponse{}
+		go func(value *data.GenericAPIResponse) {
+			_, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", value)
+			errs <- err
+		}(values[i])
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(releaseResponse)
+	for i := 0; i < numRequests; i++ {
+		require.Nil(t, <-errs)
+	}
+
+	firstData := values[0].Data.(map[string]interface{})
+	firstData["nonce"] = float64(0)
+	firstData["hashes"].([]interface{})[0] = "changed"
+
+	secondData := values[1].Data.(map[string]interface{})
+	assert.Equal(t, float64(10), secondData["nonce"])
+	assert.Equal(t, []interface{}{"a", "b"}, secondData["hashes"])
+}
+
+//------- ReadFromObservers
+
+func isNilError(_ int, err error) bool {
+	return err == nil
+}
+
+func TestBaseProcessor_ReadFromObserversShouldTryTheObserversInOrder(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := process.NewBaseProcessor(createArgsBaseProcessor())
+	observers := []*data.NodeData{{Address: "addr1"}, {Address: "addr2"}, {Address: "addr3"}}
+
+	readAddresses := make([]string, 0)
+	read := func(_ context.Context, observer *data.NodeData) (interface{}, int, error) {
+		readAddresses = append(readAddresses, observer.Address)
+		if observer.Address == "addr2" {
+			return "value", http.StatusOK, nil
+		}
+
+		return nil, http.StatusBadRequest, errors.New("read error")
+	}
+	response, err := bp.ReadFromObservers(context.Background(), observers, read, isNilError)
+
+	require.Nil(t, err)
+	assert.Equal(t, observers[1], response.Observer)
+	assert.Equal(t, "value", response.Value)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, []string{"addr1", "addr2"}, readAddresses)
+}
+
+func TestBaseProcessor_ReadFromObserversWithoutRetriesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := process.NewBaseProcessor(createArgsBaseProcessor())
+	observers := []*data.NodeData{{Address: "addr1"}, {Address: "addr2"}}
+
+	numReads := 0
+	read := func(_ context.Context, _ *data.NodeData) (interface{}, int, error) {
+		numReads++
+		return nil, http.StatusBadRequest, errors.New("read error")
+	}
+	response, err := bp.ReadFromObservers(context.Background(), observers, read, isNilError)
+
+	assert.Nil(t, response)
+	assert.Equal(t, process.ErrSendingRequest, err)
+	assert.Equal(t, 2, numReads)
+
+	response, err = bp.ReadFromObservers(context.Background(), nil, read, isNilError)
+	assert.Nil(t, response)
+	assert.Equal(t, process.ErrMissingObserver, err)
+}
+
+func TestBaseProcessor_ReadFromObserversOnOverloadedObserversShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := process.NewBaseProcessor(createArgsBaseProcessor())
+	observers := []*data.NodeData{{Address: "addr1"}, {Address: "addr2"}}
+
+	readAddresses := make([]string, 0)
+	read := func(_ context.Context, observer *data.NodeData) (interface{}, int, error) {
+		readAddresses = append(readAddresses, observer.Address)
+		return nil, http.StatusServiceUnavailable, process.ErrNodeOverloaded
+	}
+	response, err := bp.ReadFromObservers(context.Background(), observers, read, isNilError)
+
+	assert.Nil(t, response)
+	assert.Equal(t, process.ErrObserversOverloaded, err)
+	assert.Equal(t, []string{"addr1", "addr2"}, readAddresses)
+}
+
+func TestBaseProcessor_ReadFromObserversShouldRetryWithBackoff(t *testing.T) {
+	t.Parallel()
+
+	initialBackoff := 50 * time.Millisecond
+	args := createArgsBaseProcessor()
+	args.RetryPolicy, _ = process.NewRetryPolicy(process.ArgsRetryPolicy{
+		MaxRetries:     2,
+		InitialBackoff: initialBackoff,
+		MaxBackoff:     initialBackoff,
+	})
+	bp, _ := process.NewBaseProcessor(args)
+	observers := []*data.NodeData{{Address: "addr1"}, {Address: "addr2"}}
+
+	numReads := 0
+	read := func(_ context.Context, _ *data.NodeData) (interface{}, int, error) {
+		numReads++
+		if numReads == 5 {
+			return "value", http.StatusOK, nil
+		}
+
+		return nil, http.StatusServiceUnavailable, errors.New("read error")
+	}
+	startTime := time.Now()
+	response, err := bp.ReadFromObservers(context.Background(), observers, read, isNilError)
+
+	require.Nil(t, err)
+	assert.Equal(t, observers[0], response.Observer)
+	assert.Equal(t, 5, numReads)
+	assert.True(t, time.Since(startTime) >= initialBackoff)
+
+	numReads = -10
+	response, err = bp.ReadFromObservers(context.Background(), observers, read, isNilError)
+	assert.Nil(t, response)
+	assert.Equal(t, process.ErrSendingRequest, err)
+	assert.Equal(t, -4, numReads)
+}
+
+func TestBaseProcessor_ReadFromObserversShouldStopRetryingWhenTheContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsBaseProcessor()
+	args.RetryPolicy, _ = process.NewRetryPolicy(process.ArgsRetryPolicy{
+		MaxRetries:     10,
+		InitialBackoff: time.Minute,
+		MaxBackoff:     time.Minute,
+	})
+	bp, _ := process.NewBaseProcessor(args)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	read := func(_ context.Context, _ *data.NodeData) (interface{}, int, error) {
+		return nil, http.StatusServiceUnavailable, errors.New("read error")
+	}
+	response, err := bp.ReadFromObservers(ctx, []*data.NodeData{{Address: "addr1"}}, read, isNilError)
+
+	assert.Nil(t, response)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestBaseProcessor_ReadFromObserversShouldHedgeSlowReads(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsBaseProcessor()
+	args.HedgingPolicy, _ = process.NewHedgingPolicy(process.ArgsHedgingPolicy{
+		Percentile: 90,
+		NumSamples: 10,
+		MinDelay:   10 * time.Millisecond,
+		MaxDelay:   20 * time.Millisecond,
+	})
+	bp, _ := process.NewBaseProcessor(args)
+	observers := []*data.NodeData{{Address: "slow"}, {Address: "fast"}, {Address: "unused"}}
+
+	slowReadAborted := make(chan struct{})
+	numReads := uint32(0)
+	read := func(ctx context.Context, observer *data.NodeData) (interface{}, int, error) {
+		atomic.AddUint32(&numReads, 1)
+		if observer.Address == "slow" {
+			<-ctx.Done()
+			close(slowReadAborted)
+			return nil, http.StatusRequestTimeout, ctx.Err()
+		}
+
+		return observer.Address, http.StatusOK, nil
+	}
+	response, err := bp.ReadFromObservers(context.Background(), observers, read, isNilError)
+
+	require.Nil(t, err)
+	assert.Equal(t, "fast", response.Value)
+	select {
+	case <-slowReadAborted:
+	case <-time.After(time.Second):
+		assert.Fail(t, "the slow read should have been aborted")
+	}
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numReads))
+}
+
+func TestBaseProcessor_CallGetRestEndPointShouldSendTheNodeCredentials(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer token" {
+			rw.WriteHeader(http.StatusUnauthorized)
+		}
+		_, _ = rw.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	args := createArgsBaseProcessor()
+	args.HttpClients, _ = process.NewNodesHttpClients(
+		&http.Client{Timeout: time.Second},
+		[]*data.NodeData{{Address: server.URL, BearerToken: "token"}},
+	)
+	bp, _ := process.NewBaseProcessor(args)
+
+	responseCode, err := bp.CallGetRestEndPoint(context.Background(), server.URL, "/some/path", &testStruct{})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, responseCode)
+}
+
+//------- ReadFromObserversWithQuorum
+
+func createBaseProcessorWithQuorum(numObservers uint32) *process.BaseProcessor {
+	args := createArgsBaseProcessor()
+	args.QuorumPolicy, _ = process.NewQuorumPolicy(process.ArgsQuorumPolicy{
+		NumObservers: numObservers,
+		Endpoints:    []string{process.QuorumEndpointAccounts},
+	})
+	bp, _ := process.NewBaseProcessor(args)
+
+	return bp
+}
+
+func stringDigest(value interface{}) (string, error) {
+	return value.(string), nil
+}
+
+func TestBaseProcessor_ReadFromObserversWithQuorumShouldReturnTheMajorityAnswer(t *testing.T) {
+	t.Parallel()
+
+	bp := createBaseProcessorWithQuorum(3)
+	observers := []*data.NodeData{{Address: "addr1"}, {Address: "addr2"}, {Address: "addr3"}, {Address: "addr4"}}
+	answers := map[string]string{"addr1": "balance 10", "addr2": "balance 9", "addr3": "balance 10"}
+
+	read := func(_ context.Context, observer *data.NodeData) (interface{}, int, error) {
+		if observer.Address == "addr4" {
+			assert.Fail(t, "only the first 3 observers should be queried")
+		}
+		return answers[observer.Address], http.StatusOK, nil
+	}
+	response, err := bp.ReadFromObserversWithQuorum(context.Background(), process.QuorumEndpointAccounts, observers, read, isNilError, stringDigest)
+
+	require.Nil(t, err)
+	assert.Equal(t, "balance 10", response.Value)
+}
+
+func TestBaseProcessor_ReadFromObserversWithQuorumShouldReplaceTheFailingObservers(t *testing.T) {
+	t.Parallel()
+
+	bp := createBaseProcessorWithQuorum(2)
+	observers := []*data.NodeData{{Address: "addr1"}, {Address: "addr2"}, {Address: "addr3"}}
+
+	read := func(_ context.Context, observer *data.NodeData) (interface{}, int, error) {
+		if observer.Address == "addr1" {
+			return nil, 0, errors.New("connection refused")
+		}
+
+		return "balance 10", http.StatusOK, nil
+	}
+	response, err := bp.ReadFromObserversWithQuorum(context.Background(), process.QuorumEndpointAccounts, observers, read, isNilError, stringDigest)
+
+	require.Nil(t, err)
+	assert.Equal(t, "balance 10", response.Value)
+}
+
+func TestBaseProcessor_ReadFromObserversWithQuorumShouldErrOnDisagreement(t *testing.T) {
+	t.Parallel()
+
+	bp := createBaseProcessorWithQuorum(3)
+	observers := []*data.NodeData{{Address: "addr1"}, {Address: "addr2"}, {Address: "addr3"}}
+
+	read := func(_ context.Context, observer *data.NodeData) (interface{}, int, error) {
+		return "balance of " + observer.Address, http.StatusOK, nil
+	}
+	response, err := bp.ReadFromObserversWithQuorum(context.Background(), process.QuorumEndpointAccounts, observers, read, isNilError, stringDigest)
+	assert.Nil(t, response)
+	assert.True(t, errors.Is(err, process.ErrObserversDisagreement))
+
+	response, err = bp.ReadFromObserversWithQuorum(context.Background(), process.QuorumEndpointAccounts, observers[:1], read, isNilError, stringDigest)
+	assert.Nil(t, response)
+	assert.True(t, errors.Is(err, process.ErrObserversDisagreement))
+}
+
+func TestBaseProcessor_ReadFromObserversWithQuorumForAnotherEndpointShouldReadFromOneObserver(t *testing.T) {
+	t.Parallel()
+
+	bp := createBaseProcessorWithQuorum(3)
+	observers := []*data.NodeData{{Address: "addr1"}, {Address: "addr2"}, {Address: "addr3"}}
+
+	numReads := uint32(0)
+	read := func(_ context.Context, observer *data.NodeData) (interface{}, int, error) {
+		atomic.AddUint32(&numReads, 1)
+		return "balance of " + observer.Address, http.StatusOK, nil
+	}
+	response, err := bp.ReadFromObserversWithQuorum(context.Background(), process.QuorumEndpointVmValues, observers, read, isNilError, stringDigest)
+
+	require.Nil(t, err)
+	assert.Equal(t, "balance of addr1", response.Value)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numReads))
+}
@@ -1,6 +1,8 @@
 package process
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -14,24 +16,40 @@ const (
 	withTxsParamTrue = "?withTxs=true"
 )
 
-// BlockProcessor handles blocks retrieving
+// BlockProcessor handles blocks retrieving. The blocks and hyperblocks read by hash never change, so they are kept in
+// the responses cache. The ones read by nonce are kept as well, but only if they are final
 type BlockProcessor struct {
-	proc     Processor
-	dbReader ExternalStorageConnector
+	proc           Processor
+	dbReader       ExternalStorageConnector
+	responsesCache ResponsesCacheHandler
+	finalNonces    FinalNoncesHandler
 }
 
 // NewBlockProcessor will create a new block processor
-func NewBlockProcessor(dbReader ExternalStorageConnector, proc Processor) (*BlockProcessor, error) {
+func NewBlockProcessor(
+	dbReader ExternalStorageConnector,
+	proc Processor,
+	responsesCache ResponsesCacheHandler,
+	finalNonces FinalNoncesHandler,
+) (*BlockProcessor, error) {
 	if check.IfNil(dbReader) {
 		return nil, ErrNilDatabaseConnector
 	}
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
 	}
+	if check.IfNil(responsesCache) {
+		return nil, ErrNilResponsesCache
+	}
+	if check.IfNil(finalNonces) {
+		return nil, ErrNilFinalNoncesHandler
+	}
 
 	return &BlockProcessor{
-		dbReader: dbReader,
-		proc:     proc,
+		dbReader:       dbReader,
+		proc:           proc,
+		responsesCache: responsesCache,
+		finalNonces:    finalNonces,
 	}, nil
 }
 
@@ -41,8 +59,24 @@ func (bp *BlockProcessor) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce u
 }
 
 // GetBlockByHash will return the block based on its hash
-func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error) {
-	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
+func (bp *BlockProcessor) GetBlockByHash(ctx context.Context, shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error) {
+	return bp.getBlockByHash(ctx, RequestCategoryBlock, shardID, hash, withTxs)
+}
+
+func (bp *BlockProcessor) getBlockByHash(
+	ctx context.Context,
+	category string,
+	shardID uint32,
+	hash string,
+	withTxs bool,
+) (*data.BlockApiResponse, error) {
+	cacheKey := fmt.Sprintf("block/%d/hash/%s/%t", shardID, hash, withTxs)
+	cachedBlock := &data.BlockApiResponse{}
+	if bp.responsesCache.Get(cacheKey, cachedBlock) {
+		return cachedBlock, nil
+	}
+
+	observers, err := bp.proc.GetNodesForRequest(ctx, category, shardID, "")
 	if err != nil {
 		return nil, err
 	}
@@ -52,26 +86,46 @@ func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, withTxs bo
 		path += withTxsParamTrue
 	}
 
-	for _, observer := range observers {
-		var response data.BlockApiResponse
-
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
-		if err != nil {
-			log.Error("block request", "observer", observer.Address, "error", err.Error())
-			continue
+	response, err := bp.proc.ReadFromObservers(ctx, observers, bp.readBlock(path), isAnswerOrRequestError)
+	if err != nil {
+		log.Error("block request", "shard id", shardID, "hash", hash, "error", err.Error())
+		if errors.Is(err, ErrObserversOverloaded) {
+			return nil, err
 		}
-
-		log.Info("block request", "shard id", observer.ShardId, "hash", hash, "observer", observer.Address)
-		return &response, nil
-
+		return nil, ErrSendingRequest
+	}
+	if response.Err != nil {
+		log.Info("block request rejected", "shard id", shardID, "hash", hash, "http code", response.StatusCode,
+			"error", response.Err.Error())
+		return nil, response.Err
 	}
 
-	return nil, ErrSendingRequest
+	log.Info("block request", "shard id", response.Observer.ShardId, "hash", hash, "observer", response.Observer.Address)
+	block := response.Value.(*data.BlockApiResponse)
+	bp.responsesCache.Put(cacheKey, block)
+
+	return block, nil
 }
 
 // GetBlockByNonce will return the block based on the nonce
-func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
-	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
+func (bp *BlockProcessor) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
+	return bp.getBlockByNonce(ctx, RequestCategoryBlock, shardID, nonce, withTxs)
+}
+
+func (bp *BlockProcessor) getBlockByNonce(
+	ctx context.Context,
+	category string,
+	shardID uint32,
+	nonce uint64,
+	withTxs bool,
+) (*data.BlockApiResponse, error) {
+	cacheKey := fmt.Sprintf("block/%d/nonce/%d/%t", shardID, nonce, withTxs)
+	cachedBlock := &data.BlockApiResponse{}
+	if bp.responsesCache.Get(cacheKey, cachedBlock) {
+		return cachedBlock, nil
+	}
+
+	observers, err := bp.proc.GetNodesForRequest(ctx, category, shardID, "")
 	if err != nil {
 		return nil, err
 	}
@@ -81,37 +135,56 @@ func (bp *BlockProcessor) GetBlockByNonce(shardID uint32, nonce uint64, withTxs
 		path += withTxsParamTrue
 	}
 
-	for _, observer := range observers {
-		var response data.BlockApiResponse
-
-		_, err := bp.proc.CallGetRestEndPoint(observer.Address, path, &response)
-		if err != nil {
-			log.Error("block request", "observer", observer.Address, "error", err.Error())
-			continue
+	response, err := bp.proc.ReadFromObservers(ctx, observers, bp.readBlock(path), isAnswerOrRequestError)
+	if err != nil {
+		log.Error("block request", "shard id", shardID, "nonce", nonce, "error", err.Error())
+		if errors.Is(err, ErrObserversOverloaded) {
+			return nil, err
 		}
+		return nil, ErrSendingRequest
+	}
+	if response.Err != nil {
+		log.Info("block request rejected", "shard id", shardID, "nonce", nonce, "http code", response.StatusCode,
+			"error", response.Err.Error())
+		return nil, response.Err
+	}
 
-		log.Info("block request", "shard id", observer.ShardId, "nonce", nonce, "observer", observer.Address)
-		return &response, nil
-
+	log.Info("block request", "shard id", response.Observer.ShardId, "nonce", nonce, "observer", response.Observer.Address)
+	block := response.Value.(*data.BlockApiResponse)
+	if bp.isNonceFinal(shardID, nonce) {
+		bp.responsesCache.Put(cacheKey, block)
 	}
 
-	return nil, ErrSendingRequest
+	return block, nil
 }
 
-func (bp *BlockProcessor) getObserversOrFullHistoryNodes(shardID uint32) ([]*data.NodeData, error) {
-	fullHistoryNodes, err := bp.proc.GetFullHistoryNodes(shardID)
-	if err == nil {
-		return fullHistoryNodes, nil
-	}
+// isNonceFinal returns true if the block with the given nonce is final, so it cannot be replaced anymore by another
+// block with the same nonce
+func (bp *BlockProcessor) isNonceFinal(shardID uint32, nonce uint64) bool {
+	highestFinalNonce, ok := bp.finalNonces.GetHighestFinalNonce(shardID)
 
-	return bp.proc.GetObservers(shardID)
+	return ok && nonce <= highestFinalNonce
+}
+
+func (bp *BlockProcessor) readBlock(path string) func(ctx context.Context, observer *data.NodeData) (interface{}, int, error) {
+	return func(ctx context.Context, observer *data.NodeData) (interface{}, int, error) {
+		response := &data.BlockApiResponse{}
+		respCode, err := bp.proc.CallGetRestEndPoint(ctx, observer.Address, path, response)
+		return response, respCode, err
+	}
 }
 
 // GetHyperBlockByHash returns the hyperblock by hash
-func (bp *BlockProcessor) GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error) {
+func (bp *BlockProcessor) GetHyperBlockByHash(ctx context.Context, hash string) (*data.HyperblockApiResponse, error) {
+	cacheKey := fmt.Sprintf("hyperblock/hash/%s", hash)
+	cachedHyperblock := &data.HyperblockApiResponse{}
+	if bp.responsesCache.Get(cacheKey, cachedHyperblock) {
+		return cachedHyperblock, nil
+	}
+
 	builder := &HyperblockBuilder{}
 
-	metaBlockResponse, err := bp.GetBlockByHash(core.MetachainShardId, hash, true)
+	metaBlockResponse, err := bp.getBlockByHash(ctx, RequestCategoryHyperblock, core.MetachainShardId, hash, true)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +193,7 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string) (*data.HyperblockApiR
 	builder.addMetaBlock(&metaBlock)
 
 	for _, notarizedBlock := range metaBlock.NotarizedBlocks {
-		shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, true)
+		shardBlockResponse, err := bp.getBlockByHash(ctx, RequestCategoryHyperblock, notarizedBlock.Shard, notarizedBlock.Hash, true)
 		if err != nil {
 			return nil, err
 		}
@@ -128,15 +201,23 @@ func (bp *BlockProcessor) GetHyperBlockByHash(hash string) (*data.HyperblockApiR
 		builder.addShardBlock(&shardBlockResponse.Data.Block)
 	}
 
-	hyperblock := builder.build()
-	return data.NewHyperblockApiResponse(hyperblock), nil
+	hyperblockResponse := data.NewHyperblockApiResponse(builder.build())
+	bp.responsesCache.Put(cacheKey, hyperblockResponse)
+
+	return hyperblockResponse, nil
 }
 
 // GetHyperBlockByNonce returns the hyperblock by nonce
-func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error) {
+func (bp *BlockProcessor) GetHyperBlockByNonce(ctx context.Context, nonce uint64) (*data.HyperblockApiResponse, error) {
+	cacheKey := fmt.Sprintf("hyperblock/nonce/%d", nonce)
+	cachedHyperblock := &data.HyperblockApiResponse{}
+	if bp.responsesCache.Get(cacheKey, cachedHyperblock) {
+		return cachedHyperblock, nil
+	}
+
 	builder := &HyperblockBuilder{}
 
-	metaBlockResponse, err := bp.GetBlockByNonce(core.MetachainShardId, nonce, true)
+	metaBlockResponse, err := bp.getBlockByNonce(ctx, RequestCategoryHyperblock, core.MetachainShardId, nonce, true)
 	if err != nil {
 		return nil, err
 	}
@@ -145,7 +226,7 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64) (*data.HyperblockAp
 	builder.addMetaBlock(&metaBlock)
 
 	for _, notarizedBlock := range metaBlock.NotarizedBlocks {
-		shardBlockResponse, err := bp.GetBlockByHash(notarizedBlock.Shard, notarizedBlock.Hash, true)
+		shardBlockResponse, err := bp.getBlockByHash(ctx, RequestCategoryHyperblock, notarizedBlock.Shard, notarizedBlock.Hash, true)
 		if err != nil {
 			return nil, err
 		}
@@ -153,6 +234,15 @@ func (bp *BlockProcessor) GetHyperBlockByNonce(nonce uint64) (*data.HyperblockAp
 		builder.addShardBlock(&shardBlockResponse.Data.Block)
 	}
 
-	hyperblock := builder.build()
-	return data.NewHyperblockApiResponse(hyperblock), nil
+	hyperblockResponse := data.NewHyperblockApiResponse(builder.build())
+	if bp.isNonceFinal(core.MetachainShardId, nonce) {
+		bp.responsesCache.Put(cacheKey, hyperblockResponse)
+	}
+
+	return hyperblockResponse, nil
+}
+
+// GetResponsesCacheStats returns the usage counters of the cache holding the blocks and the hyperblocks
+func (bp *BlockProcessor) GetResponsesCacheStats() data.ResponsesCacheStats {
+	return bp.responsesCache.GetStats()
 }
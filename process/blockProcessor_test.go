@@ -1,13 +1,16 @@
 package process_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/disabled"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -15,7 +18,7 @@ import (
 func TestNewBlockProcessor_NilExternalStorageConnectorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(nil, &mock.ProcessorStub{})
+	bp, err := process.NewBlockProcessor(nil, &mock.ProcessorStub{}, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.Nil(t, bp)
 	require.Equal(t, process.ErrNilDatabaseConnector, err)
 }
@@ -23,15 +26,31 @@ func TestNewBlockProcessor_NilExternalStorageConnectorShouldErr(t *testing.T) {
 func TestNewBlockProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, nil)
+	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, nil, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.Nil(t, bp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
 }
 
+func TestNewBlockProcessor_NilResponsesCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{}, nil, &mock.NodesHealthHandlerStub{})
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrNilResponsesCache, err)
+}
+
+func TestNewBlockProcessor_NilFinalNoncesHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{}, &disabled.ResponsesCache{}, nil)
+	require.Nil(t, bp)
+	require.Equal(t, process.ErrNilFinalNoncesHandler, err)
+}
+
 func TestNewBlockProcessor_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{})
+	bp, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{}, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 	require.NoError(t, err)
 }
@@ -39,7 +58,7 @@ func TestNewBlockProcessor_ShouldWork(t *testing.T) {
 func TestBlockProcessor_GetAtlasBlockByShardIDAndNonce(t *testing.T) {
 	t.Parallel()
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{})
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, &mock.ProcessorStub{}, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
 	res, err := bp.GetAtlasBlockByShardIDAndNonce(0, 1)
@@ -47,30 +66,23 @@ func TestBlockProcessor_GetAtlasBlockByShardIDAndNonce(t *testing.T) {
 	require.NotNil(t, res)
 }
 
-func TestBlockProcessor_GetBlockByHashShouldGetFullHistoryNodes(t *testing.T) {
+func TestBlockProcessor_GetBlockByHashShouldUseTheBlockRoute(t *testing.T) {
 	t.Parallel()
 
-	getFullHistoryNodesCalled := false
-	getObserversCalled := false
-
+	requestedCategories := make([]string, 0)
 	proc := &mock.ProcessorStub{
-		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
-			getFullHistoryNodesCalled = true
-			return nil, nil
-		},
-		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
-			getObserversCalled = true
+		GetNodesForRequestCalled: func(_ context.Context, category string, _ uint32, _ string) ([]*data.NodeData, error) {
+			requestedCategories = append(requestedCategories, category)
 			return nil, nil
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", false)
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", false)
 
-	require.True(t, getFullHistoryNodesCalled)
-	require.False(t, getObserversCalled)
+	require.Equal(t, []string{process.RequestCategoryBlock}, requestedCategories)
 }
 
 func TestBlockProcessor_GetBlockByHashShouldGetObservers(t *testing.T) {
@@ -90,10 +102,10 @@ func TestBlockProcessor_GetBlockByHashShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByHash(0, "hash", false)
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", false)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -112,10 +124,10 @@ func TestBlockProcessor_GetBlockByHashNoFullNodesOrObserversShouldErr(t *testing
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", false)
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", false)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -128,19 +140,43 @@ func TestBlockProcessor_GetBlockByHashCallGetFailsShouldErr(t *testing.T) {
 		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
 			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
-			return 500, localErr
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
+			return 0, localErr
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", false)
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", false)
 	require.Equal(t, process.ErrSendingRequest, err)
 	require.Nil(t, res)
 }
 
+func TestBlockProcessor_GetBlockByHashRejectedByTheNodeShouldNotRetryNorCache(t *testing.T) {
+	t.Parallel()
+
+	localErr := errors.New("block not found")
+	numCalls := 0
+	proc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr0"}, {ShardId: shardId, Address: "addr1"}}, nil
+		},
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
+			numCalls++
+			return http.StatusInternalServerError, localErr
+		},
+	}
+	responsesCache, _ := process.NewImmutableResponsesCache(10)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, responsesCache, &mock.NodesHealthHandlerStub{})
+
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", false)
+	require.Equal(t, localErr, err)
+	require.Nil(t, res)
+	require.Equal(t, 1, numCalls)
+	require.Equal(t, 0, bp.GetResponsesCacheStats().NumEntries)
+}
+
 func TestBlockProcessor_GetBlockByHashShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -149,17 +185,17 @@ func TestBlockProcessor_GetBlockByHashShouldWork(t *testing.T) {
 		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
 			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			valResp := value.(*data.BlockApiResponse)
 			valResp.Data.Block = data.Block{Nonce: nonce}
 			return 200, nil
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", false)
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", false)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -176,7 +212,7 @@ func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
 			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			isAddressCorrect = strings.Contains(path, "withTxs=true")
 			valResp := value.(*data.BlockApiResponse)
 			valResp.Data = data.BlockApiResponsePayload{Block: data.Block{Nonce: nonce}}
@@ -184,10 +220,10 @@ func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByHash(0, "hash", true)
+	res, err := bp.GetBlockByHash(context.Background(), 0, "hash", true)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -196,30 +232,23 @@ func TestBlockProcessor_GetBlockByHashShouldWorkAndIncludeAlsoTxs(t *testing.T)
 	require.True(t, isAddressCorrect)
 }
 
-func TestBlockProcessor_GetBlockByNonceShouldGetFullHistoryNodes(t *testing.T) {
+func TestBlockProcessor_GetBlockByNonceShouldUseTheBlockRoute(t *testing.T) {
 	t.Parallel()
 
-	getFullHistoryNodesCalled := false
-	getObserversCalled := false
-
+	requestedCategories := make([]string, 0)
 	proc := &mock.ProcessorStub{
-		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
-			getFullHistoryNodesCalled = true
-			return nil, nil
-		},
-		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
-			getObserversCalled = true
+		GetNodesForRequestCalled: func(_ context.Context, category string, _ uint32, _ string) ([]*data.NodeData, error) {
+			requestedCategories = append(requestedCategories, category)
 			return nil, nil
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 0, false)
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 0, false)
 
-	require.True(t, getFullHistoryNodesCalled)
-	require.False(t, getObserversCalled)
+	require.Equal(t, []string{process.RequestCategoryBlock}, requestedCategories)
 }
 
 func TestBlockProcessor_GetBlockByNonceShouldGetObservers(t *testing.T) {
@@ -239,10 +268,10 @@ func TestBlockProcessor_GetBlockByNonceShouldGetObservers(t *testing.T) {
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	_, _ = bp.GetBlockByNonce(0, 1, false)
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 1, false)
 
 	require.True(t, getFullHistoryNodesCalled)
 	require.True(t, getObserversCalled)
@@ -261,10 +290,10 @@ func TestBlockProcessor_GetBlockByNonceNoFullNodesOrObserversShouldErr(t *testin
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 1, false)
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 1, false)
 	require.Nil(t, res)
 	require.Equal(t, localErr, err)
 }
@@ -277,19 +306,42 @@ func TestBlockProcessor_GetBlockByNonceCallGetFailsShouldErr(t *testing.T) {
 		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
 			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
-			return 500, localErr
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
+			return 0, localErr
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 0, false)
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 0, false)
 	require.Equal(t, process.ErrSendingRequest, err)
 	require.Nil(t, res)
 }
 
+func TestBlockProcessor_GetBlockByNonceRejectedByTheNodeShouldNotRetry(t *testing.T) {
+	t.Parallel()
+
+	localErr := errors.New("invalid nonce")
+	numCalls := 0
+	proc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr0"}, {ShardId: shardId, Address: "addr1"}}, nil
+		},
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
+			numCalls++
+			return http.StatusBadRequest, localErr
+		},
+	}
+
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
+
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 0, false)
+	require.Equal(t, localErr, err)
+	require.Nil(t, res)
+	require.Equal(t, 1, numCalls)
+}
+
 func TestBlockProcessor_GetBlockByNonceShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -298,17 +350,17 @@ func TestBlockProcessor_GetBlockByNonceShouldWork(t *testing.T) {
 		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
 			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			valResp := value.(*data.BlockApiResponse)
 			valResp.Data = data.BlockApiResponsePayload{Block: data.Block{Nonce: nonce}}
 			return 200, nil
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, nonce, false)
+	res, err := bp.GetBlockByNonce(context.Background(), 0, nonce, false)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -325,7 +377,7 @@ func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
 			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			isAddressCorrect = strings.Contains(path, "withTxs=true")
 			valResp := value.(*data.BlockApiResponse)
 			valResp.Data = data.BlockApiResponsePayload{Block: data.Block{Nonce: nonce}}
@@ -333,10 +385,10 @@ func TestBlockProcessor_GetBlockByNonceShouldWorkAndIncludeAlsoTxs(t *testing.T)
 		},
 	}
 
-	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.NotNil(t, bp)
 
-	res, err := bp.GetBlockByNonce(0, 3, true)
+	res, err := bp.GetBlockByNonce(context.Background(), 0, 3, true)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
@@ -353,7 +405,7 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 		GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
 			return []*data.NodeData{{ShardId: shardId, Address: fmt.Sprintf("http://observer-%d", shardId)}}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			numGetBlockCalled++
 
 			response := value.(*data.BlockApiResponse)
@@ -372,12 +424,12 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 		},
 	}
 
-	processor, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc)
+	processor, err := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 	require.Nil(t, err)
 	require.NotNil(t, processor)
 
 	numGetBlockCalled = 0
-	response, err := processor.GetHyperBlockByHash("abcd")
+	response, err := processor.GetHyperBlockByHash(context.Background(), "abcd")
 	require.Nil(t, err)
 	require.NotNil(t, response)
 	require.Equal(t, 4, numGetBlockCalled, "get block should be called for metablock and for all notarized shard blocks")
@@ -385,10 +437,102 @@ func TestBlockProcessor_GetHyperBlock(t *testing.T) {
 	require.Equal(t, "abcd", response.Data.Hyperblock.Hash)
 
 	numGetBlockCalled = 0
-	response, err = processor.GetHyperBlockByNonce(42)
+	response, err = processor.GetHyperBlockByNonce(context.Background(), 42)
 	require.Nil(t, err)
 	require.NotNil(t, response)
 	require.Equal(t, 4, numGetBlockCalled, "get block should be called for metablock and for all notarized shard blocks")
 	require.Equal(t, 42, int(response.Data.Hyperblock.Nonce))
 	require.Equal(t, "abcd", response.Data.Hyperblock.Hash)
 }
+
+func TestBlockProcessor_GetBlockByHashShouldServeTheCachedBlock(t *testing.T) {
+	t.Parallel()
+
+	numGetBlockCalled := 0
+	proc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+		},
+		CallGetRestEndPointCalled: func(_ context.Context, _ string, _ string, value interface{}) (int, error) {
+			numGetBlockCalled++
+			value.(*data.BlockApiResponse).Data.Block = data.Block{Nonce: 37}
+			return 200, nil
+		},
+	}
+	responsesCache, _ := process.NewImmutableResponsesCache(10)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, responsesCache, &mock.NodesHealthHandlerStub{})
+
+	firstResponse, err := bp.GetBlockByHash(context.Background(), 0, "hash", false)
+	require.Nil(t, err)
+	secondResponse, err := bp.GetBlockByHash(context.Background(), 0, "hash", false)
+	require.Nil(t, err)
+	require.Equal(t, firstResponse, secondResponse)
+	require.Equal(t, 1, numGetBlockCalled)
+
+	_, _ = bp.GetBlockByHash(context.Background(), 0, "hash", true)
+	_, _ = bp.GetBlockByHash(context.Background(), 1, "hash", false)
+	require.Equal(t, 3, numGetBlockCalled)
+	require.Equal(t, data.ResponsesCacheStats{Hits: 1, Misses: 3, NumEntries: 3, Capacity: 10}, bp.GetResponsesCacheStats())
+}
+
+func TestBlockProcessor_GetBlockByNonceShouldCacheOnlyTheFinalBlocks(t *testing.T) {
+	t.Parallel()
+
+	numGetBlockCalled := 0
+	proc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+		},
+		CallGetRestEndPointCalled: func(_ context.Context, _ string, _ string, value interface{}) (int, error) {
+			numGetBlockCalled++
+			return 200, nil
+		},
+	}
+	finalNonces := &mock.NodesHealthHandlerStub{
+		GetHighestFinalNonceCalled: func(shardID uint32) (uint64, bool) {
+			return 10, shardID == 0
+		},
+	}
+	responsesCache, _ := process.NewImmutableResponsesCache(10)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, responsesCache, finalNonces)
+
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 10, false)
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 10, false)
+	require.Equal(t, 1, numGetBlockCalled)
+
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 11, false)
+	_, _ = bp.GetBlockByNonce(context.Background(), 0, 11, false)
+	require.Equal(t, 3, numGetBlockCalled)
+
+	_, _ = bp.GetBlockByNonce(context.Background(), 1, 5, false)
+	_, _ = bp.GetBlockByNonce(context.Background(), 1, 5, false)
+	require.Equal(t, 5, numGetBlockCalled)
+}
+
+func TestBlockProcessor_GetHyperBlockByHashShouldServeTheCachedHyperblock(t *testing.T) {
+	t.Parallel()
+
+	numGetBlockCalled := 0
+	proc := &mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+		},
+		CallGetRestEndPointCalled: func(_ context.Context, _ string, _ string, value interface{}) (int, error) {
+			numGetBlockCalled++
+			value.(*data.BlockApiResponse).Data.Block = data.Block{Nonce: 42, Hash: "abcd"}
+			return 200, nil
+		},
+	}
+	responsesCache, _ := process.NewImmutableResponsesCache(10)
+	bp, _ := process.NewBlockProcessor(&mock.ExternalStorageConnectorStub{}, proc, responsesCache, &mock.NodesHealthHandlerStub{})
+
+	firstResponse, err := bp.GetHyperBlockByHash(context.Background(), "abcd")
+	require.Nil(t, err)
+	secondResponse, err := bp.GetHyperBlockByHash(context.Background(), "abcd")
+	require.Nil(t, err)
+	require.Equal(t, firstResponse, secondResponse)
+	require.Equal(t, 1, numGetBlockCalled)
+
+	_, _ = bp.GetHyperBlockByNonce(context.Background(), 42)
+	require.Equal(t, 2, numGetBlockCalled)
+}
@@ -13,3 +13,30 @@ var ErrNilValidatorStatsInCache = errors.New("nil validator statistics response
 
 // ErrNilValidatorStatsToStoreInCache signals that the provided validator statistics is nil
 var ErrNilValidatorStatsToStoreInCache = errors.New("nil validator statistics to store in cache")
+
+// ErrEmptySnapshotFilePath signals that an empty path has been provided for the snapshot file
+var ErrEmptySnapshotFilePath = errors.New("empty snapshot file path")
+
+// ErrEmptyRedisAddress signals that an empty address has been provided for the redis server
+var ErrEmptyRedisAddress = errors.New("empty redis address")
+
+// ErrInvalidRedisOperationTimeout signals that an invalid operation timeout has been provided for the redis client
+var ErrInvalidRedisOperationTimeout = errors.New("invalid redis operation timeout")
+
+// ErrInvalidRedisMaxIdleConnections signals that an invalid maximum number of idle connections has been provided
+var ErrInvalidRedisMaxIdleConnections = errors.New("invalid redis max idle connections")
+
+// ErrUnexpectedRedisReply signals that the redis server sent an unexpected reply
+var ErrUnexpectedRedisReply = errors.New("unexpected redis reply")
+
+// ErrRedisErrorReply signals that the redis server replied with an error
+var ErrRedisErrorReply = errors.New("redis error reply")
+
+// ErrNilSharedStore signals that a nil shared store has been provided
+var ErrNilSharedStore = errors.New("nil shared store")
+
+// ErrEmptySharedStoreKey signals that an empty key has been provided for the shared store
+var ErrEmptySharedStoreKey = errors.New("empty shared store key")
+
+// ErrInvalidLockDuration signals that an invalid lock duration has been provided
+var ErrInvalidLockDuration = errors.New("invalid lock duration")
@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("process/cache")
+
+type snapshot struct {
+	Timestamp int64           `json:"timestamp"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// fileSnapshot persists a value, along with the moment it was stored, in a JSON file
+type fileSnapshot struct {
+	filePath string
+}
+
+// load reads the value stored in the snapshot file and returns the moment it was stored
+func (fs *fileSnapshot) load(value interface{}) (time.Time, error) {
+	fileBytes, err := ioutil.ReadFile(fs.filePath)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return unmarshalSnapshot(fileBytes, value)
+}
+
+// save replaces the snapshot file with the given value. The value is first written in a temporary file which is then
+// renamed, so the snapshot file is never left half written
+func (fs *fileSnapshot) save(value interface{}, timestamp time.Time) error {
+	snapshotBytes, err := marshalSnapshot(value, timestamp)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(fs.filePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	tempFilePath := fs.filePath + ".tmp"
+	err = ioutil.WriteFile(tempFilePath, snapshotBytes, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, fs.filePath)
+}
+
+func marshalSnapshot(value interface{}, timestamp time.Time) ([]byte, error) {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(snapshot{
+		Timestamp: timestamp.Unix(),
+		Value:     valueBytes,
+	})
+}
+
+func unmarshalSnapshot(snapshotBytes []byte, value interface{}) (time.Time, error) {
+	var storedSnapshot snapshot
+	err := json.Unmarshal(snapshotBytes, &storedSnapshot)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	err = json.Unmarshal(storedSnapshot.Value, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(storedSnapshot.Timestamp, 0), nil
+}
+
+func computeSnapshotAge(timestamp time.Time) uint64 {
+	age := time.Since(timestamp)
+	if age < 0 {
+		return 0
+	}
+
+	return uint64(age / time.Second)
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// HeartbeatDiskCacher will handle caching the heartbeats response both in memory and in a snapshot file, so the
+// last heartbeats are available right after a restart, even if no observer can be reached. The age of the snapshot
+// is reported in the loaded response
+type HeartbeatDiskCacher struct {
+	snapshot          *fileSnapshot
+	storedHeartbeats  []data.PubKeyHeartbeat
+	snapshotTimestamp time.Time
+	mutHeartbeats     sync.RWMutex
+}
+
+// NewHeartbeatDiskCacher will return a new instance of HeartbeatDiskCacher, holding the heartbeats found in the
+// given snapshot file, if any
+func NewHeartbeatDiskCacher(filePath string) (*HeartbeatDiskCacher, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptySnapshotFilePath
+	}
+
+	hdc := &HeartbeatDiskCacher{
+		snapshot: &fileSnapshot{filePath: filePath},
+	}
+
+	var heartbeats []data.PubKeyHeartbeat
+	timestamp, err := hdc.snapshot.load(&heartbeats)
+	switch {
+	case err == nil:
+		hdc.storedHeartbeats = heartbeats
+		hdc.snapshotTimestamp = timestamp
+		log.Info("heartbeats loaded from snapshot", "file", filePath, "snapshot age", time.Since(timestamp))
+	case os.IsNotExist(err):
+	default:
+		log.Warn("cannot load the heartbeats snapshot", "file", filePath, "error", err.Error())
+	}
+
+	return hdc, nil
+}
+
+// LoadHeartbeats will return the heartbeats response stored in cache (if found), along with the snapshot's age
+func (hdc *HeartbeatDiskCacher) LoadHeartbeats() (*data.HeartbeatResponse, error) {
+	hdc.mutHeartbeats.RLock()
+	defer hdc.mutHeartbeats.RUnlock()
+
+	if hdc.storedHeartbeats == nil {
+		return nil, ErrNilHeartbeatsInCache
+	}
+
+	return &data.HeartbeatResponse{
+		Heartbeats:     hdc.storedHeartbeats,
+		SnapshotAgeSec: computeSnapshotAge(hdc.snapshotTimestamp),
+	}, nil
+}
+
+// StoreHeartbeats will update the stored heartbeats response in cache and in the snapshot file. The response is kept
+// in memory even if the snapshot file cannot be written
+func (hdc *HeartbeatDiskCacher) StoreHeartbeats(hbts *data.HeartbeatResponse) error {
+	if hbts == nil {
+		return ErrNilHeartbeatsToStoreInCache
+	}
+
+	timestamp := time.Now()
+
+	hdc.mutHeartbeats.Lock()
+	hdc.storedHeartbeats = hbts.Heartbeats
+	hdc.snapshotTimestamp = timestamp
+	hdc.mutHeartbeats.Unlock()
+
+	return hdc.snapshot.save(hbts.Heartbeats, timestamp)
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (hdc *HeartbeatDiskCacher) IsInterfaceNil() bool {
+	return hdc == nil
+}
@@ -0,0 +1,110 @@
+package cache_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSnapshotsDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "snapshots")
+	require.Nil(t, err)
+
+	return dir
+}
+
+func TestNewHeartbeatDiskCacher_EmptyFilePathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hdc, err := cache.NewHeartbeatDiskCacher("")
+	assert.Nil(t, hdc)
+	assert.Equal(t, cache.ErrEmptySnapshotFilePath, err)
+}
+
+func TestNewHeartbeatDiskCacher_MissingFileShouldStartEmpty(t *testing.T) {
+	t.Parallel()
+
+	dir := createSnapshotsDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	hdc, err := cache.NewHeartbeatDiskCacher(filepath.Join(dir, "heartbeats.json"))
+	require.Nil(t, err)
+	assert.False(t, hdc.IsInterfaceNil())
+
+	hbts, err := hdc.LoadHeartbeats()
+	assert.Nil(t, hbts)
+	assert.Equal(t, cache.ErrNilHeartbeatsInCache, err)
+}
+
+func TestNewHeartbeatDiskCacher_CorruptFileShouldStartEmpty(t *testing.T) {
+	t.Parallel()
+
+	dir := createSnapshotsDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	filePath := filepath.Join(dir, "heartbeats.json")
+	require.Nil(t, ioutil.WriteFile(filePath, []byte("not a snapshot"), 0644))
+
+	hdc, err := cache.NewHeartbeatDiskCacher(filePath)
+	require.Nil(t, err)
+
+	_, err = hdc.LoadHeartbeats()
+	assert.Equal(t, cache.ErrNilHeartbeatsInCache, err)
+}
+
+func TestHeartbeatDiskCacher_StoreNilHeartbeatsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createSnapshotsDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	hdc, _ := cache.NewHeartbeatDiskCacher(filepath.Join(dir, "heartbeats.json"))
+
+	err := hdc.StoreHeartbeats(nil)
+	assert.Equal(t, cache.ErrNilHeartbeatsToStoreInCache, err)
+}
+
+func TestHeartbeatDiskCacher_StoredHeartbeatsShouldBeLoadedAfterARestart(t *testing.T) {
+	t.Parallel()
+
+	dir := createSnapshotsDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	filePath := filepath.Join(dir, "snapshots", "heartbeats.json")
+	hbts := &data.HeartbeatResponse{
+		Heartbeats: []data.PubKeyHeartbeat{
+			{PublicKey: "pk1", NodeDisplayName: "node1"},
+			{PublicKey: "pk2", NodeDisplayName: "node2"},
+		},
+	}
+
+	hdc, _ := cache.NewHeartbeatDiskCacher(filePath)
+	err := hdc.StoreHeartbeats(hbts)
+	require.Nil(t, err)
+
+	loadedHbts, err := hdc.LoadHeartbeats()
+	require.Nil(t, err)
+	assert.Equal(t, hbts.Heartbeats, loadedHbts.Heartbeats)
+	assert.Equal(t, uint64(0), loadedHbts.SnapshotAgeSec)
+
+	restartedHdc, err := cache.NewHeartbeatDiskCacher(filePath)
+	require.Nil(t, err)
+
+	loadedHbts, err = restartedHdc.LoadHeartbeats()
+	require.Nil(t, err)
+	assert.Equal(t, hbts.Heartbeats, loadedHbts.Heartbeats)
+}
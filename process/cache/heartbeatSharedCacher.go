@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// HeartbeatSharedCacher will handle caching the heartbeats response in a store shared by several proxies, so only
+// one of them has to fetch the heartbeats from the observers. The age of the stored response is reported in the
+// loaded response
+type HeartbeatSharedCacher struct {
+	store SharedStoreHandler
+	key   string
+}
+
+// NewHeartbeatSharedCacher will return a new instance of HeartbeatSharedCacher, storing the heartbeats under the
+// given key
+func NewHeartbeatSharedCacher(store SharedStoreHandler, key string) (*HeartbeatSharedCacher, error) {
+	if check.IfNil(store) {
+		return nil, ErrNilSharedStore
+	}
+	if len(key) == 0 {
+		return nil, ErrEmptySharedStoreKey
+	}
+
+	return &HeartbeatSharedCacher{
+		store: store,
+		key:   key,
+	}, nil
+}
+
+// LoadHeartbeats will return the heartbeats response stored in the shared store (if found), along with its age
+func (hsc *HeartbeatSharedCacher) LoadHeartbeats() (*data.HeartbeatResponse, error) {
+	snapshotBytes, found, err := hsc.store.Get(hsc.key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNilHeartbeatsInCache
+	}
+
+	var heartbeats []data.PubKeyHeartbeat
+	timestamp, err := unmarshalSnapshot(snapshotBytes, &heartbeats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.HeartbeatResponse{
+		Heartbeats:     heartbeats,
+		SnapshotAgeSec: computeSnapshotAge(timestamp),
+	}, nil
+}
+
+// StoreHeartbeats will update the heartbeats response stored in the shared store
+func (hsc *HeartbeatSharedCacher) StoreHeartbeats(hbts *data.HeartbeatResponse) error {
+	if hbts == nil {
+		return ErrNilHeartbeatsToStoreInCache
+	}
+
+	snapshotBytes, err := marshalSnapshot(hbts.Heartbeats, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return hsc.store.Set(hsc.key, snapshotBytes, 0)
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (hsc *HeartbeatSharedCacher) IsInterfaceNil() bool {
+	return hsc == nil
+}
@@ -0,0 +1,67 @@
+package cache_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHeartbeatSharedCacher_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hsc, err := cache.NewHeartbeatSharedCacher(nil, "heartbeats")
+	assert.True(t, check.IfNil(hsc))
+	assert.Equal(t, cache.ErrNilSharedStore, err)
+
+	hsc, err = cache.NewHeartbeatSharedCacher(&mock.SharedStoreStub{}, "")
+	assert.True(t, check.IfNil(hsc))
+	assert.Equal(t, cache.ErrEmptySharedStoreKey, err)
+}
+
+func TestHeartbeatSharedCacher_LoadShouldReturnTheStoreErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("connection refused")
+	hsc, _ := cache.NewHeartbeatSharedCacher(&mock.SharedStoreStub{
+		GetCalled: func(_ string) ([]byte, bool, error) {
+			return nil, false, expectedErr
+		},
+	}, "heartbeats")
+
+	hbts, err := hsc.LoadHeartbeats()
+	assert.Nil(t, hbts)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestHeartbeatSharedCacher_StoredHeartbeatsShouldBeSharedWithTheOtherProxies(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeRedisServer(t, "")
+	defer server.close()
+
+	rc, _ := cache.NewRedisClient(createArgsRedisClient(server.address()))
+	leaderCacher, _ := cache.NewHeartbeatSharedCacher(rc, "heartbeats")
+	followerCacher, _ := cache.NewHeartbeatSharedCacher(rc, "heartbeats")
+
+	_, err := followerCacher.LoadHeartbeats()
+	assert.Equal(t, cache.ErrNilHeartbeatsInCache, err)
+
+	err = leaderCacher.StoreHeartbeats(nil)
+	assert.Equal(t, cache.ErrNilHeartbeatsToStoreInCache, err)
+
+	hbts := &data.HeartbeatResponse{
+		Heartbeats: []data.PubKeyHeartbeat{{PublicKey: "pk1", NodeDisplayName: "node1"}},
+	}
+	err = leaderCacher.StoreHeartbeats(hbts)
+	require.Nil(t, err)
+
+	loadedHbts, err := followerCacher.LoadHeartbeats()
+	require.Nil(t, err)
+	assert.Equal(t, hbts.Heartbeats, loadedHbts.Heartbeats)
+}
@@ -0,0 +1,12 @@
+package cache
+
+import "time"
+
+// SharedStoreHandler defines what a key-value store shared by several proxies should be able to do
+type SharedStoreHandler interface {
+	Get(key string) ([]byte, bool, error)
+	Set(key string, value []byte, ttl time.Duration) error
+	SetIfNotExists(key string, value []byte, ttl time.Duration) (bool, error)
+	ExpireIfEqual(key string, value []byte, ttl time.Duration) (bool, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const identifierRandomBytes = 8
+
+// ArgsLeaderLock holds the arguments needed for creating a new LeaderLock
+type ArgsLeaderLock struct {
+	Store        SharedStoreHandler
+	Key          string
+	LockDuration time.Duration
+}
+
+// LeaderLock elects a single leader among the proxies sharing a store, by holding a lock key which expires after
+// LockDuration unless its holder renews it. The leader renews the lock several times during LockDuration, so another
+// proxy takes over only if the leader stops or cannot reach the store anymore
+type LeaderLock struct {
+	isLeader     uint32
+	store        SharedStoreHandler
+	key          string
+	identifier   []byte
+	lockDuration time.Duration
+}
+
+// NewLeaderLock creates a new instance of LeaderLock
+func NewLeaderLock(args ArgsLeaderLock) (*LeaderLock, error) {
+	if check.IfNil(args.Store) {
+		return nil, ErrNilSharedStore
+	}
+	if len(args.Key) == 0 {
+		return nil, ErrEmptySharedStoreKey
+	}
+	if args.LockDuration <= 0 {
+		return nil, ErrInvalidLockDuration
+	}
+
+	identifier, err := generateIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaderLock{
+		store:        args.Store,
+		key:          args.Key,
+		identifier:   identifier,
+		lockDuration: args.LockDuration,
+	}, nil
+}
+
+func generateIdentifier() ([]byte, error) {
+	randomBytes := make([]byte, identifierRandomBytes)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname, _ := os.Hostname()
+
+	return []byte(fmt.Sprintf("%s-%s", hostname, hex.EncodeToString(randomBytes))), nil
+}
+
+// StartElection starts trying to acquire, or to renew, the lock a few times during each lock duration
+func (ll *LeaderLock) StartElection() {
+	go func() {
+		for {
+			ll.TryAcquireLeadership()
+			time.Sleep(ll.lockDuration / 3)
+		}
+	}()
+}
+
+// TryAcquireLeadership renews the lock if this proxy is the leader, or tries to acquire it otherwise
+func (ll *LeaderLock) TryAcquireLeadership() {
+	if ll.IsLeader() {
+		renewed, err := ll.store.ExpireIfEqual(ll.key, ll.identifier, ll.lockDuration)
+		if err != nil || !renewed {
+			atomic.StoreUint32(&ll.isLeader, 0)
+			log.Info("leader lock: leadership lost", "key", ll.key, "error", err)
+		}
+		return
+	}
+
+	acquired, err := ll.store.SetIfNotExists(ll.key, ll.identifier, ll.lockDuration)
+	if err != nil {
+		log.Debug("leader lock: cannot acquire", "key", ll.key, "error", err.Error())
+		return
+	}
+	if acquired {
+		atomic.StoreUint32(&ll.isLeader, 1)
+		log.Info("leader lock: leadership acquired", "key", ll.key, "identifier", string(ll.identifier))
+	}
+}
+
+// IsLeader returns true if this proxy holds the lock
+func (ll *LeaderLock) IsLeader() bool {
+	return atomic.LoadUint32(&ll.isLeader) == 1
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ll *LeaderLock) IsInterfaceNil() bool {
+	return ll == nil
+}
@@ -0,0 +1,108 @@
+package cache_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLeaderLock(t *testing.T, address string, lockDuration time.Duration) *cache.LeaderLock {
+	rc, err := cache.NewRedisClient(createArgsRedisClient(address))
+	require.Nil(t, err)
+
+	ll, err := cache.NewLeaderLock(cache.ArgsLeaderLock{
+		Store:        rc,
+		Key:          "leader",
+		LockDuration: lockDuration,
+	})
+	require.Nil(t, err)
+
+	return ll
+}
+
+func TestNewLeaderLock_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rc, _ := cache.NewRedisClient(createArgsRedisClient("127.0.0.1:6379"))
+
+	ll, err := cache.NewLeaderLock(cache.ArgsLeaderLock{Key: "leader", LockDuration: time.Second})
+	assert.True(t, check.IfNil(ll))
+	assert.Equal(t, cache.ErrNilSharedStore, err)
+
+	ll, err = cache.NewLeaderLock(cache.ArgsLeaderLock{Store: rc, LockDuration: time.Second})
+	assert.True(t, check.IfNil(ll))
+	assert.Equal(t, cache.ErrEmptySharedStoreKey, err)
+
+	ll, err = cache.NewLeaderLock(cache.ArgsLeaderLock{Store: rc, Key: "leader"})
+	assert.True(t, check.IfNil(ll))
+	assert.Equal(t, cache.ErrInvalidLockDuration, err)
+}
+
+func TestLeaderLock_OnlyOneProxyShouldBeLeader(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeRedisServer(t, "")
+	defer server.close()
+
+	lockDuration := 50 * time.Millisecond
+	firstLock := createLeaderLock(t, server.address(), lockDuration)
+	secondLock := createLeaderLock(t, server.address(), lockDuration)
+
+	firstLock.TryAcquireLeadership()
+	secondLock.TryAcquireLeadership()
+	assert.True(t, firstLock.IsLeader())
+	assert.False(t, secondLock.IsLeader())
+
+	// the leader keeps the lock as long as it renews it
+	time.Sleep(lockDuration / 2)
+	firstLock.TryAcquireLeadership()
+	time.Sleep(lockDuration / 2)
+	secondLock.TryAcquireLeadership()
+	assert.True(t, firstLock.IsLeader())
+	assert.False(t, secondLock.IsLeader())
+
+	// the leader stopped renewing the lock, so the other proxy takes over once it expires
+	time.Sleep(lockDuration + 10*time.Millisecond)
+	secondLock.TryAcquireLeadership()
+	assert.True(t, secondLock.IsLeader())
+
+	firstLock.TryAcquireLeadership()
+	assert.False(t, firstLock.IsLeader())
+}
+
+func TestLeaderLock_UnreachableStoreShouldLoseTheLeadership(t *testing.T) {
+	t.Parallel()
+
+	isStoreReachable := true
+	ll, _ := cache.NewLeaderLock(cache.ArgsLeaderLock{
+		Store: &mock.SharedStoreStub{
+			SetIfNotExistsCalled: func(_ string, _ []byte, _ time.Duration) (bool, error) {
+				return true, nil
+			},
+			ExpireIfEqualCalled: func(_ string, _ []byte, _ time.Duration) (bool, error) {
+				if !isStoreReachable {
+					return false, errors.New("connection refused")
+				}
+				return true, nil
+			},
+		},
+		Key:          "leader",
+		LockDuration: time.Second,
+	})
+
+	ll.TryAcquireLeadership()
+	assert.True(t, ll.IsLeader())
+
+	ll.TryAcquireLeadership()
+	assert.True(t, ll.IsLeader())
+
+	isStoreReachable = false
+	ll.TryAcquireLeadership()
+	assert.False(t, ll.IsLeader())
+}
@@ -0,0 +1,341 @@
+package cache
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+const (
+	// expireIfEqualScript extends the expiry of a key only if it still holds the given value
+	expireIfEqualScript = `if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("PEXPIRE", KEYS[1], ARGV[2]) else return 0 end`
+	okReply             = "OK"
+)
+
+// ArgsRedisClient holds the arguments needed for creating a new RedisClient
+type ArgsRedisClient struct {
+	Address            string
+	Password           string
+	Database           int
+	OperationTimeout   time.Duration
+	MaxIdleConnections int
+}
+
+type redisConnection struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// RedisClient is a minimal client of a key-value store speaking the Redis protocol (RESP), which can be shared by
+// several proxies. The connections are dialed on demand and at most MaxIdleConnections of them are kept for reuse
+type RedisClient struct {
+	address          string
+	password         string
+	database         int
+	operationTimeout time.Duration
+	idleConnections  chan *redisConnection
+}
+
+// NewRedisClient creates a new instance of RedisClient
+func NewRedisClient(args ArgsRedisClient) (*RedisClient, error) {
+	if len(args.Address) == 0 {
+		return nil, ErrEmptyRedisAddress
+	}
+	if args.OperationTimeout <= 0 {
+		return nil, ErrInvalidRedisOperationTimeout
+	}
+	if args.MaxIdleConnections < 0 {
+		return nil, ErrInvalidRedisMaxIdleConnections
+	}
+
+	return &RedisClient{
+		address:          args.Address,
+		password:         args.Password,
+		database:         args.Database,
+		operationTimeout: args.OperationTimeout,
+		idleConnections:  make(chan *redisConnection, args.MaxIdleConnections),
+	}, nil
+}
+
+// Get returns the value stored under the given key. It returns false if the key does not exist
+func (rc *RedisClient) Get(key string) ([]byte, bool, error) {
+	reply, err := rc.do("GET", key)
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("%w for GET: %v", ErrUnexpectedRedisReply, reply)
+	}
+
+	return value, true, nil
+}
+
+// Set stores the value under the given key. A ttl of 0 means the key never expires
+func (rc *RedisClient) Set(key string, value []byte, ttl time.Duration) error {
+	args := []interface{}{"SET", key, value}
+	if ttl > 0 {
+		args = append(args, "PX", int64(ttl/time.Millisecond))
+	}
+
+	reply, err := rc.do(args...)
+	if err != nil {
+		return err
+	}
+	if reply != okReply {
+		return fmt.Errorf("%w for SET: %v", ErrUnexpectedRedisReply, reply)
+	}
+
+	return nil
+}
+
+// SetIfNotExists stores the value under the given key, expiring after ttl, only if the key does not exist yet. It
+// returns true if the value was stored
+func (rc *RedisClient) SetIfNotExists(key string, value []byte, ttl time.Duration) (bool, error) {
+	reply, err := rc.do("SET", key, value, "NX", "PX", int64(ttl/time.Millisecond))
+	if err != nil {
+		return false, err
+	}
+
+	switch reply {
+	case nil:
+		return false, nil
+	case okReply:
+		return true, nil
+	default:
+		return false, fmt.Errorf("%w for SET NX: %v", ErrUnexpectedRedisReply, reply)
+	}
+}
+
+// ExpireIfEqual makes the given key expire after ttl, only if it still holds the given value. It returns true if the
+// expiry was changed
+func (rc *RedisClient) ExpireIfEqual(key string, value []byte, ttl time.Duration) (bool, error) {
+	reply, err := rc.do("EVAL", expireIfEqualScript, 1, key, value, int64(ttl/time.Millisecond))
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := reply.(int64)
+	if !ok {
+		return false, fmt.Errorf("%w for EVAL: %v", ErrUnexpectedRedisReply, reply)
+	}
+
+	return result == 1, nil
+}
+
+func (rc *RedisClient) do(args ...interface{}) (interface{}, error) {
+	connection, err := rc.getConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := rc.execute(connection, args)
+	if err != nil {
+		// the connection might be left in an unknown state, so it is not reused
+		_ = connection.conn.Close()
+		return nil, err
+	}
+
+	rc.putConnection(connection)
+	if replyErr, isReplyErr := reply.(redisError); isReplyErr {
+		return nil, fmt.Errorf("%w: %s", ErrRedisErrorReply, string(replyErr))
+	}
+
+	return reply, nil
+}
+
+func (rc *RedisClient) getConnection() (*redisConnection, error) {
+	select {
+	case connection := <-rc.idleConnections:
+		return connection, nil
+	default:
+	}
+
+	conn, err := net.DialTimeout("tcp", rc.address, rc.operationTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	connection := &redisConnection{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+	}
+	err = rc.initConnection(connection)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return connection, nil
+}
+
+func (rc *RedisClient) initConnection(connection *redisConnection) error {
+	if len(rc.password) > 0 {
+		err := rc.executeExpectingOk(connection, "AUTH", rc.password)
+		if err != nil {
+			return err
+		}
+	}
+	if rc.database != 0 {
+		return rc.executeExpectingOk(connection, "SELECT", rc.database)
+	}
+
+	return nil
+}
+
+func (rc *RedisClient) executeExpectingOk(connection *redisConnection, args ...interface{}) error {
+	reply, err := rc.execute(connection, args)
+	if err != nil {
+		return err
+	}
+	if replyErr, isReplyErr := reply.(redisError); isReplyErr {
+		return fmt.Errorf("%w: %s", ErrRedisErrorReply, string(replyErr))
+	}
+	if reply != okReply {
+		return fmt.Errorf("%w for %v: %v", ErrUnexpectedRedisReply, args[0], reply)
+	}
+
+	return nil
+}
+
+func (rc *RedisClient) putConnection(connection *redisConnection) {
+	select {
+	case rc.idleConnections <- connection:
+	default:
+		_ = connection.conn.Close()
+	}
+}
+
+func (rc *RedisClient) execute(connection *redisConnection, args []interface{}) (interface{}, error) {
+	err := connection.conn.SetDeadline(time.Now().Add(rc.operationTimeout))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = connection.conn.Write(encodeCommand(args))
+	if err != nil {
+		return nil, err
+	}
+
+	return readReply(connection.reader)
+}
+
+// redisError is an error reply sent by the server, which does not affect the connection
+type redisError string
+
+// encodeCommand encodes the command as a RESP array of bulk strings
+func encodeCommand(args []interface{}) []byte {
+	buff := make([]byte, 0, 64)
+	buff = append(buff, '*')
+	buff = strconv.AppendInt(buff, int64(len(args)), 10)
+	buff = append(buff, '\r', '\n')
+
+	for _, arg := range args {
+		var argBytes []byte
+		switch value := arg.(type) {
+		case []byte:
+			argBytes = value
+		case string:
+			argBytes = []byte(value)
+		default:
+			argBytes = []byte(fmt.Sprint(value))
+		}
+
+		buff = append(buff, '$')
+		buff = strconv.AppendInt(buff, int64(len(argBytes)), 10)
+		buff = append(buff, '\r', '\n')
+		buff = append(buff, argBytes...)
+		buff = append(buff, '\r', '\n')
+	}
+
+	return buff
+}
+
+// readReply decodes a RESP reply: the simple strings are returned as string, the bulk strings as []byte, the integers
+// as int64, the arrays as []interface{} and the null bulk strings or arrays as nil
+func readReply(reader *bufio.Reader) (interface{}, error) {
+	line, err := readLine(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, fmt.Errorf("%w: empty line", ErrUnexpectedRedisReply)
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return redisError(line[1:]), nil
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		return readBulkString(reader, line[1:])
+	case '*':
+		return readArray(reader, line[1:])
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedRedisReply, line)
+	}
+}
+
+func readBulkString(reader *bufio.Reader, lengthString string) (interface{}, error) {
+	length, err := strconv.Atoi(lengthString)
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 {
+		return nil, nil
+	}
+
+	value := make([]byte, length+2)
+	_, err = io.ReadFull(reader, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value[:length], nil
+}
+
+func readArray(reader *bufio.Reader, lengthString string) (interface{}, error) {
+	length, err := strconv.Atoi(lengthString)
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 {
+		return nil, nil
+	}
+
+	values := make([]interface{}, length)
+	for i := range values {
+		values[i], err = readReply(reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return "", fmt.Errorf("%w: malformed line", ErrUnexpectedRedisReply)
+	}
+
+	return line[:len(line)-2], nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *RedisClient) IsInterfaceNil() bool {
+	return rc == nil
+}
@@ -0,0 +1,305 @@
+package cache_test
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRedisValue struct {
+	value  string
+	expiry time.Time
+}
+
+// fakeRedisServer implements the few commands used by the redis client, keeping the values in memory
+type fakeRedisServer struct {
+	listener  net.Listener
+	password  string
+	mutValues sync.Mutex
+	values    map[string]fakeRedisValue
+}
+
+func startFakeRedisServer(t *testing.T, password string) *fakeRedisServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+
+	frs := &fakeRedisServer{
+		listener: listener,
+		password: password,
+		values:   make(map[string]fakeRedisValue),
+	}
+	go frs.serve()
+
+	return frs
+}
+
+func (frs *fakeRedisServer) address() string {
+	return frs.listener.Addr().String()
+}
+
+func (frs *fakeRedisServer) close() {
+	_ = frs.listener.Close()
+}
+
+func (frs *fakeRedisServer) serve() {
+	for {
+		conn, err := frs.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go frs.handleConnection(conn)
+	}
+}
+
+func (frs *fakeRedisServer) handleConnection(conn net.Conn) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	reader := bufio.NewReader(conn)
+	isAuthenticated := len(frs.password) == 0
+	for {
+		args, err := readFakeRedisCommand(reader)
+		if err != nil {
+			return
+		}
+
+		_, err = conn.Write([]byte(frs.execute(args, &isAuthenticated)))
+		if err != nil {
+			return
+		}
+	}
+}
+
+func readFakeRedisCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	numArgs, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, numArgs)
+	for i := range args {
+		line, err = reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		length, errConv := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if errConv != nil {
+			return nil, errConv
+		}
+
+		arg := make([]byte, length+2)
+		_, err = io.ReadFull(reader, arg)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = string(arg[:length])
+	}
+
+	return args, nil
+}
+
+func (frs *fakeRedisServer) execute(args []string, isAuthenticated *bool) string {
+	command := strings.ToUpper(args[0])
+	if command == "AUTH" {
+		if args[1] != frs.password {
+			return "-WRONGPASS invalid password\r\n"
+		}
+		*isAuthenticated = true
+		return "+OK\r\n"
+	}
+	if !*isAuthenticated {
+		return "-NOAUTH Authentication required.\r\n"
+	}
+
+	frs.mutValues.Lock()
+	defer frs.mutValues.Unlock()
+
+	switch command {
+	case "SELECT":
+		return "+OK\r\n"
+	case "GET":
+		value, found := frs.getValue(args[1])
+		if !found {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(value.value), value.value)
+	case "SET":
+		return frs.set(args[1:])
+	case "EVAL":
+		value, found := frs.getValue(args[3])
+		if !found || value.value != args[4] {
+			return ":0\r\n"
+		}
+		ttlMs, _ := strconv.Atoi(args[5])
+		value.expiry = time.Now().Add(time.Duration(ttlMs) * time.Millisecond)
+		frs.values[args[3]] = value
+		return ":1\r\n"
+	default:
+		return "-ERR unknown command\r\n"
+	}
+}
+
+func (frs *fakeRedisServer) set(args []string) string {
+	value := fakeRedisValue{value: args[1]}
+	onlyIfNotExists := false
+	for i := 2; i < len(args); i++ {
+		switch strings.ToUpper(args[i]) {
+		case "NX":
+			onlyIfNotExists = true
+		case "PX":
+			ttlMs, _ := strconv.Atoi(args[i+1])
+			value.expiry = time.Now().Add(time.Duration(ttlMs) * time.Millisecond)
+			i++
+		}
+	}
+
+	_, found := frs.getValue(args[0])
+	if onlyIfNotExists && found {
+		return "$-1\r\n"
+	}
+
+	frs.values[args[0]] = value
+	return "+OK\r\n"
+}
+
+func (frs *fakeRedisServer) getValue(key string) (fakeRedisValue, bool) {
+	value, found := frs.values[key]
+	if !found {
+		return fakeRedisValue{}, false
+	}
+	if !value.expiry.IsZero() && time.Now().After(value.expiry) {
+		delete(frs.values, key)
+		return fakeRedisValue{}, false
+	}
+
+	return value, true
+}
+
+func createArgsRedisClient(address string) cache.ArgsRedisClient {
+	return cache.ArgsRedisClient{
+		Address:            address,
+		OperationTimeout:   time.Second,
+		MaxIdleConnections: 2,
+	}
+}
+
+func TestNewRedisClient_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsRedisClient("")
+	rc, err := cache.NewRedisClient(args)
+	assert.True(t, check.IfNil(rc))
+	assert.Equal(t, cache.ErrEmptyRedisAddress, err)
+
+	args = createArgsRedisClient("127.0.0.1:6379")
+	args.OperationTimeout = 0
+	rc, err = cache.NewRedisClient(args)
+	assert.True(t, check.IfNil(rc))
+	assert.Equal(t, cache.ErrInvalidRedisOperationTimeout, err)
+
+	args = createArgsRedisClient("127.0.0.1:6379")
+	args.MaxIdleConnections = -1
+	rc, err = cache.NewRedisClient(args)
+	assert.True(t, check.IfNil(rc))
+	assert.Equal(t, cache.ErrInvalidRedisMaxIdleConnections, err)
+}
+
+func TestRedisClient_SetAndGetShouldWork(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeRedisServer(t, "secret")
+	defer server.close()
+
+	args := createArgsRedisClient(server.address())
+	args.Password = "secret"
+	args.Database = 2
+	rc, err := cache.NewRedisClient(args)
+	require.Nil(t, err)
+
+	value, found, err := rc.Get("key")
+	assert.Nil(t, err)
+	assert.False(t, found)
+	assert.Nil(t, value)
+
+	err = rc.Set("key", []byte("value\r\nwith a new line"), 0)
+	assert.Nil(t, err)
+
+	value, found, err = rc.Get("key")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []byte("value\r\nwith a new line"), value)
+}
+
+func TestRedisClient_WrongPasswordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeRedisServer(t, "secret")
+	defer server.close()
+
+	args := createArgsRedisClient(server.address())
+	args.Password = "wrong"
+	rc, _ := cache.NewRedisClient(args)
+
+	_, _, err := rc.Get("key")
+	assert.True(t, errors.Is(err, cache.ErrRedisErrorReply))
+}
+
+func TestRedisClient_UnreachableServerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeRedisServer(t, "")
+	server.close()
+
+	rc, _ := cache.NewRedisClient(createArgsRedisClient(server.address()))
+
+	err := rc.Set("key", []byte("value"), 0)
+	assert.NotNil(t, err)
+}
+
+func TestRedisClient_SetIfNotExistsAndExpireIfEqual(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeRedisServer(t, "")
+	defer server.close()
+
+	rc, _ := cache.NewRedisClient(createArgsRedisClient(server.address()))
+
+	isSet, err := rc.SetIfNotExists("lock", []byte("owner1"), time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, isSet)
+
+	isSet, err = rc.SetIfNotExists("lock", []byte("owner2"), time.Minute)
+	assert.Nil(t, err)
+	assert.False(t, isSet)
+
+	isExpireSet, err := rc.ExpireIfEqual("lock", []byte("owner2"), time.Minute)
+	assert.Nil(t, err)
+	assert.False(t, isExpireSet)
+
+	isExpireSet, err = rc.ExpireIfEqual("lock", []byte("owner1"), time.Millisecond)
+	assert.Nil(t, err)
+	assert.True(t, isExpireSet)
+
+	time.Sleep(5 * time.Millisecond)
+	_, found, err := rc.Get("lock")
+	assert.Nil(t, err)
+	assert.False(t, found)
+}
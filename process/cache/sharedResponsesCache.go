@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// SharedResponsesCache holds the responses which never change in a store shared by several proxies, encoded as JSON.
+// The responses expire after the configured ttl, so the store does not grow without bounds
+type SharedResponsesCache struct {
+	numHits   uint64
+	numMisses uint64
+	store     SharedStoreHandler
+	keyPrefix string
+	ttl       time.Duration
+}
+
+// NewSharedResponsesCache creates a new instance of SharedResponsesCache, storing the responses under keys starting
+// with keyPrefix. A ttl of 0 means the responses never expire
+func NewSharedResponsesCache(store SharedStoreHandler, keyPrefix string, ttl time.Duration) (*SharedResponsesCache, error) {
+	if check.IfNil(store) {
+		return nil, ErrNilSharedStore
+	}
+	if len(keyPrefix) == 0 {
+		return nil, ErrEmptySharedStoreKey
+	}
+
+	return &SharedResponsesCache{
+		store:     store,
+		keyPrefix: keyPrefix,
+		ttl:       ttl,
+	}, nil
+}
+
+// Get decodes the response stored under the given key, if any, in the value pointed to by value. The errors of the
+// shared store are reported as misses, so the response is fetched from the nodes instead
+func (src *SharedResponsesCache) Get(key string, value interface{}) bool {
+	valueBytes, found, err := src.store.Get(src.keyPrefix + key)
+	if err != nil {
+		log.Debug("shared responses cache: get", "key", key, "error", err.Error())
+	}
+	if err == nil && found {
+		err = json.Unmarshal(valueBytes, value)
+		if err == nil {
+			atomic.AddUint64(&src.numHits, 1)
+			return true
+		}
+
+		log.Debug("shared responses cache: decode", "key", key, "error", err.Error())
+	}
+
+	atomic.AddUint64(&src.numMisses, 1)
+	return false
+}
+
+// Put stores the given response under the given key
+func (src *SharedResponsesCache) Put(key string, value interface{}) {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		log.Debug("shared responses cache: encode", "key", key, "error", err.Error())
+		return
+	}
+
+	err = src.store.Set(src.keyPrefix+key, valueBytes, src.ttl)
+	if err != nil {
+		log.Debug("shared responses cache: put", "key", key, "error", err.Error())
+	}
+}
+
+// GetStats returns the number of hits and misses of this proxy since it started. The number of stored responses is
+// not tracked, as the store is shared with other proxies
+func (src *SharedResponsesCache) GetStats() data.ResponsesCacheStats {
+	return data.ResponsesCacheStats{
+		Hits:   atomic.LoadUint64(&src.numHits),
+		Misses: atomic.LoadUint64(&src.numMisses),
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (src *SharedResponsesCache) IsInterfaceNil() bool {
+	return src == nil
+}
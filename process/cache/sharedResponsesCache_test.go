@@ -0,0 +1,60 @@
+package cache_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSharedResponsesCache_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	src, err := cache.NewSharedResponsesCache(nil, "responses:", time.Minute)
+	assert.True(t, check.IfNil(src))
+	assert.Equal(t, cache.ErrNilSharedStore, err)
+
+	src, err = cache.NewSharedResponsesCache(&mock.SharedStoreStub{}, "", time.Minute)
+	assert.True(t, check.IfNil(src))
+	assert.Equal(t, cache.ErrEmptySharedStoreKey, err)
+}
+
+func TestSharedResponsesCache_GetAndPutShouldCountTheHitsAndMisses(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeRedisServer(t, "")
+	defer server.close()
+
+	rc, _ := cache.NewRedisClient(createArgsRedisClient(server.address()))
+	src, _ := cache.NewSharedResponsesCache(rc, "responses:", time.Minute)
+
+	block := &data.BlockApiResponse{}
+	assert.False(t, src.Get("block/0/hash/aa/false", block))
+
+	expectedBlock := &data.BlockApiResponse{Code: data.ReturnCodeSuccess}
+	expectedBlock.Data.Block.Nonce = 37
+	expectedBlock.Data.Block.Hash = "aa"
+	src.Put("block/0/hash/aa/false", expectedBlock)
+
+	assert.True(t, src.Get("block/0/hash/aa/false", block))
+	assert.Equal(t, expectedBlock, block)
+	assert.Equal(t, data.ResponsesCacheStats{Hits: 1, Misses: 1}, src.GetStats())
+}
+
+func TestSharedResponsesCache_StoreErrorsShouldBeMisses(t *testing.T) {
+	t.Parallel()
+
+	src, _ := cache.NewSharedResponsesCache(&mock.SharedStoreStub{
+		GetCalled: func(_ string) ([]byte, bool, error) {
+			return nil, false, errors.New("connection refused")
+		},
+	}, "responses:", time.Minute)
+
+	assert.False(t, src.Get("key", &data.BlockApiResponse{}))
+	assert.Equal(t, uint64(1), src.GetStats().Misses)
+}
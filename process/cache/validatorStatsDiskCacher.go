@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ValidatorsStatsDiskCacher will handle caching the validator statistics both in memory and in a snapshot file, so
+// the last statistics are available right after a restart, even if the metachain observers cannot be reached. The age
+// of the snapshot is reported in the loaded response
+type ValidatorsStatsDiskCacher struct {
+	snapshot              *fileSnapshot
+	storedValidatorsStats map[string]*data.ValidatorApiResponse
+	snapshotTimestamp     time.Time
+	mutValidatorsStats    sync.RWMutex
+}
+
+// NewValidatorsStatsDiskCacher will return a new instance of ValidatorsStatsDiskCacher, holding the validator
+// statistics found in the given snapshot file, if any
+func NewValidatorsStatsDiskCacher(filePath string) (*ValidatorsStatsDiskCacher, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptySnapshotFilePath
+	}
+
+	vsdc := &ValidatorsStatsDiskCacher{
+		snapshot: &fileSnapshot{filePath: filePath},
+	}
+
+	var valStats map[string]*data.ValidatorApiResponse
+	timestamp, err := vsdc.snapshot.load(&valStats)
+	switch {
+	case err == nil:
+		vsdc.storedValidatorsStats = valStats
+		vsdc.snapshotTimestamp = timestamp
+		log.Info("validator statistics loaded from snapshot", "file", filePath, "snapshot age", time.Since(timestamp))
+	case os.IsNotExist(err):
+	default:
+		log.Warn("cannot load the validator statistics snapshot", "file", filePath, "error", err.Error())
+	}
+
+	return vsdc, nil
+}
+
+// LoadValStats will return the validator statistics stored in cache (if found), along with the snapshot's age
+func (vsdc *ValidatorsStatsDiskCacher) LoadValStats() (*data.ValidatorStatisticsResponse, error) {
+	vsdc.mutValidatorsStats.RLock()
+	defer vsdc.mutValidatorsStats.RUnlock()
+
+	if vsdc.storedValidatorsStats == nil {
+		return nil, ErrNilValidatorStatsInCache
+	}
+
+	return &data.ValidatorStatisticsResponse{
+		Statistics:     vsdc.storedValidatorsStats,
+		SnapshotAgeSec: computeSnapshotAge(vsdc.snapshotTimestamp),
+	}, nil
+}
+
+// StoreValStats will update the stored validator statistics in cache and in the snapshot file. The statistics are
+// kept in memory even if the snapshot file cannot be written
+func (vsdc *ValidatorsStatsDiskCacher) StoreValStats(valStats map[string]*data.ValidatorApiResponse) error {
+	if valStats == nil {
+		return ErrNilValidatorStatsToStoreInCache
+	}
+
+	timestamp := time.Now()
+
+	vsdc.mutValidatorsStats.Lock()
+	vsdc.storedValidatorsStats = valStats
+	vsdc.snapshotTimestamp = timestamp
+	vsdc.mutValidatorsStats.Unlock()
+
+	return vsdc.snapshot.save(valStats, timestamp)
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (vsdc *ValidatorsStatsDiskCacher) IsInterfaceNil() bool {
+	return vsdc == nil
+}
@@ -0,0 +1,81 @@
+package cache_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValidatorsStatsDiskCacher_EmptyFilePathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vsdc, err := cache.NewValidatorsStatsDiskCacher("")
+	assert.Nil(t, vsdc)
+	assert.Equal(t, cache.ErrEmptySnapshotFilePath, err)
+}
+
+func TestValidatorsStatsDiskCacher_StoreNilValStatsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createSnapshotsDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	vsdc, _ := cache.NewValidatorsStatsDiskCacher(filepath.Join(dir, "validatorStatistics.json"))
+
+	err := vsdc.StoreValStats(nil)
+	assert.Equal(t, cache.ErrNilValidatorStatsToStoreInCache, err)
+}
+
+func TestValidatorsStatsDiskCacher_StoredValStatsShouldBeLoadedAfterARestart(t *testing.T) {
+	t.Parallel()
+
+	dir := createSnapshotsDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	filePath := filepath.Join(dir, "validatorStatistics.json")
+	valStats := map[string]*data.ValidatorApiResponse{
+		"pubk1": {TempRating: 0.5, ShardID: 1},
+	}
+
+	vsdc, _ := cache.NewValidatorsStatsDiskCacher(filePath)
+	_, err := vsdc.LoadValStats()
+	assert.Equal(t, cache.ErrNilValidatorStatsInCache, err)
+
+	err = vsdc.StoreValStats(valStats)
+	require.Nil(t, err)
+
+	restartedVsdc, err := cache.NewValidatorsStatsDiskCacher(filePath)
+	require.Nil(t, err)
+
+	loadedValStats, err := restartedVsdc.LoadValStats()
+	require.Nil(t, err)
+	assert.Equal(t, valStats, loadedValStats.Statistics)
+}
+
+func TestValidatorsStatsDiskCacher_CorruptFileShouldStartEmpty(t *testing.T) {
+	t.Parallel()
+
+	dir := createSnapshotsDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	filePath := filepath.Join(dir, "validatorStatistics.json")
+	require.Nil(t, ioutil.WriteFile(filePath, []byte("{"), 0644))
+
+	vsdc, err := cache.NewValidatorsStatsDiskCacher(filePath)
+	require.Nil(t, err)
+
+	_, err = vsdc.LoadValStats()
+	assert.Equal(t, cache.ErrNilValidatorStatsInCache, err)
+}
@@ -21,7 +21,7 @@ func NewValidatorsStatsMemoryCacher() *validatorsStatsMemoryCacher {
 }
 
 // LoadValStats will return the ValidatorsStats response stored in cache (if found)
-func (vsmc *validatorsStatsMemoryCacher) LoadValStats() (map[string]*data.ValidatorApiResponse, error) {
+func (vsmc *validatorsStatsMemoryCacher) LoadValStats() (*data.ValidatorStatisticsResponse, error) {
 	vsmc.mutValidatorsStatss.RLock()
 	defer vsmc.mutValidatorsStatss.RUnlock()
 
@@ -29,7 +29,7 @@ func (vsmc *validatorsStatsMemoryCacher) LoadValStats() (map[string]*data.Valida
 		return nil, ErrNilValidatorStatsInCache
 	}
 
-	return vsmc.storedValidatorsStats, nil
+	return &data.ValidatorStatisticsResponse{Statistics: vsmc.storedValidatorsStats}, nil
 }
 
 // StoreValStats will update the stored ValidatorsStatss response in cache
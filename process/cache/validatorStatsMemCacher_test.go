@@ -63,7 +63,7 @@ func TestValidatorsStatsMemoryCacher_LoadShouldWork(t *testing.T) {
 
 	restoredValStatsResp, err := mc.LoadValStats()
 	assert.NoError(t, err)
-	assert.Equal(t, valStats, restoredValStatsResp)
+	assert.Equal(t, valStats, restoredValStatsResp.Statistics)
 }
 
 func TestValidatorsStatsMemoryCacher_ConcurrencySafe(t *testing.T) {
@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ValidatorsStatsSharedCacher will handle caching the validator statistics in a store shared by several proxies, so
+// only one of them has to fetch the statistics from the observers. The age of the stored statistics is reported in
+// the loaded response
+type ValidatorsStatsSharedCacher struct {
+	store SharedStoreHandler
+	key   string
+}
+
+// NewValidatorsStatsSharedCacher will return a new instance of ValidatorsStatsSharedCacher, storing the validator
+// statistics under the given key
+func NewValidatorsStatsSharedCacher(store SharedStoreHandler, key string) (*ValidatorsStatsSharedCacher, error) {
+	if check.IfNil(store) {
+		return nil, ErrNilSharedStore
+	}
+	if len(key) == 0 {
+		return nil, ErrEmptySharedStoreKey
+	}
+
+	return &ValidatorsStatsSharedCacher{
+		store: store,
+		key:   key,
+	}, nil
+}
+
+// LoadValStats will return the validator statistics stored in the shared store (if found), along with their age
+func (vssc *ValidatorsStatsSharedCacher) LoadValStats() (*data.ValidatorStatisticsResponse, error) {
+	snapshotBytes, found, err := vssc.store.Get(vssc.key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrNilValidatorStatsInCache
+	}
+
+	var valStats map[string]*data.ValidatorApiResponse
+	timestamp, err := unmarshalSnapshot(snapshotBytes, &valStats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.ValidatorStatisticsResponse{
+		Statistics:     valStats,
+		SnapshotAgeSec: computeSnapshotAge(timestamp),
+	}, nil
+}
+
+// StoreValStats will update the validator statistics stored in the shared store
+func (vssc *ValidatorsStatsSharedCacher) StoreValStats(valStats map[string]*data.ValidatorApiResponse) error {
+	if valStats == nil {
+		return ErrNilValidatorStatsToStoreInCache
+	}
+
+	snapshotBytes, err := marshalSnapshot(valStats, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return vssc.store.Set(vssc.key, snapshotBytes, 0)
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (vssc *ValidatorsStatsSharedCacher) IsInterfaceNil() bool {
+	return vssc == nil
+}
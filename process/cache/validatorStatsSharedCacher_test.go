@@ -0,0 +1,51 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewValidatorsStatsSharedCacher_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vssc, err := cache.NewValidatorsStatsSharedCacher(nil, "validatorStatistics")
+	assert.True(t, check.IfNil(vssc))
+	assert.Equal(t, cache.ErrNilSharedStore, err)
+
+	vssc, err = cache.NewValidatorsStatsSharedCacher(&mock.SharedStoreStub{}, "")
+	assert.True(t, check.IfNil(vssc))
+	assert.Equal(t, cache.ErrEmptySharedStoreKey, err)
+}
+
+func TestValidatorsStatsSharedCacher_StoredValStatsShouldBeSharedWithTheOtherProxies(t *testing.T) {
+	t.Parallel()
+
+	server := startFakeRedisServer(t, "")
+	defer server.close()
+
+	rc, _ := cache.NewRedisClient(createArgsRedisClient(server.address()))
+	leaderCacher, _ := cache.NewValidatorsStatsSharedCacher(rc, "validatorStatistics")
+	followerCacher, _ := cache.NewValidatorsStatsSharedCacher(rc, "validatorStatistics")
+
+	_, err := followerCacher.LoadValStats()
+	assert.Equal(t, cache.ErrNilValidatorStatsInCache, err)
+
+	err = leaderCacher.StoreValStats(nil)
+	assert.Equal(t, cache.ErrNilValidatorStatsToStoreInCache, err)
+
+	valStats := map[string]*data.ValidatorApiResponse{
+		"pubk1": {TempRating: 0.5, ShardID: 1},
+	}
+	err = leaderCacher.StoreValStats(valStats)
+	require.Nil(t, err)
+
+	loadedValStats, err := followerCacher.LoadValStats()
+	require.Nil(t, err)
+	assert.Equal(t, valStats, loadedValStats.Statistics)
+}
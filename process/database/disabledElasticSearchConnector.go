@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"errors"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
@@ -24,6 +25,11 @@ func (desc *disabledElasticSearchConnector) GetAtlasBlockByShardIDAndNonce(_ uin
 	return data.AtlasBlock{}, errDatabaseConnectionIsDisabled
 }
 
+// Ping will return error because database connection is disabled
+func (desc *disabledElasticSearchConnector) Ping(_ context.Context) error {
+	return errDatabaseConnectionIsDisabled
+}
+
 // IsInterfaceNil -
 func (desc *disabledElasticSearchConnector) IsInterfaceNil() bool {
 	return desc == nil
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -177,6 +178,23 @@ func (esc *elasticSearchConnector) doSearchRequestTx(address string, index strin
 	return decodedBody, nil
 }
 
+// Ping checks whether the database cluster is reachable
+func (esc *elasticSearchConnector) Ping(ctx context.Context) error {
+	res, err := esc.client.Ping(esc.client.Ping.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("cannot reach database: %w", err)
+	}
+
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.IsError() {
+		return fmt.Errorf("cannot reach database: %v", res)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (esc *elasticSearchConnector) IsInterfaceNil() bool {
 	return esc == nil
@@ -0,0 +1,39 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// ApiKeysRegistry represents a disabled struct that implements the ApiKeysRegistryHandler interface
+type ApiKeysRegistry struct {
+}
+
+// GetApiKeyTier returns false as this is a disabled component, so no key is known
+func (akr *ApiKeysRegistry) GetApiKeyTier(_ string) (*data.ApiKeyTier, bool) {
+	return nil, false
+}
+
+// GetTier returns false as this is a disabled component, so no tier is known
+func (akr *ApiKeysRegistry) GetTier(_ string) (*data.ApiKeyTier, bool) {
+	return nil, false
+}
+
+// TryConsumeQuota returns true as this is a disabled component, so no quota is enforced
+func (akr *ApiKeysRegistry) TryConsumeQuota(_ string) bool {
+	return true
+}
+
+// GetApiKeysUsage returns an empty usage report as this is a disabled component
+func (akr *ApiKeysRegistry) GetApiKeysUsage() *data.ApiKeysUsageResponse {
+	return &data.ApiKeysUsageResponse{
+		Keys: make([]*data.ApiKeyUsage, 0),
+	}
+}
+
+// SaveUsage does nothing as this is a disabled component
+func (akr *ApiKeysRegistry) SaveUsage() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (akr *ApiKeysRegistry) IsInterfaceNil() bool {
+	return akr == nil
+}
@@ -0,0 +1,35 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// CircuitBreaker represents a disabled struct that implements the CircuitBreakerHandler interface
+type CircuitBreaker struct {
+}
+
+// AllowRequest returns true as this is a disabled component
+func (cb *CircuitBreaker) AllowRequest(_ string) bool {
+	return true
+}
+
+// RecordSuccess does nothing as this is a disabled component
+func (cb *CircuitBreaker) RecordSuccess(_ string) {
+}
+
+// RecordFailure does nothing as this is a disabled component
+func (cb *CircuitBreaker) RecordFailure(_ string) {
+}
+
+// IsOpen returns false as this is a disabled component
+func (cb *CircuitBreaker) IsOpen(_ string) bool {
+	return false
+}
+
+// GetCircuitStatus returns an empty status as this is a disabled component
+func (cb *CircuitBreaker) GetCircuitStatus(_ string) data.CircuitStatus {
+	return data.CircuitStatus{}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cb *CircuitBreaker) IsInterfaceNil() bool {
+	return cb == nil
+}
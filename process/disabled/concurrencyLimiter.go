@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ConcurrencyLimiter represents a disabled struct that implements the ConcurrencyLimiterHandler interface
+type ConcurrencyLimiter struct {
+}
+
+// Acquire returns nil as this is a disabled component
+func (cl *ConcurrencyLimiter) Acquire(_ context.Context, _ string) error {
+	return nil
+}
+
+// Release does nothing as this is a disabled component
+func (cl *ConcurrencyLimiter) Release(_ string) {
+}
+
+// GetLoadStatus returns an empty status as this is a disabled component
+func (cl *ConcurrencyLimiter) GetLoadStatus(_ string) data.NodeLoadStatus {
+	return data.NodeLoadStatus{}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cl *ConcurrencyLimiter) IsInterfaceNil() bool {
+	return cl == nil
+}
@@ -0,0 +1,26 @@
+package disabled
+
+import "time"
+
+// HedgingPolicy represents a disabled struct that implements the HedgingPolicyHandler interface
+type HedgingPolicy struct {
+}
+
+// RecordLatency does nothing as this is a disabled component
+func (hp *HedgingPolicy) RecordLatency(_ time.Duration) {
+}
+
+// IsEnabled returns false as this is a disabled component
+func (hp *HedgingPolicy) IsEnabled() bool {
+	return false
+}
+
+// HedgeDelay returns 0 as this is a disabled component
+func (hp *HedgingPolicy) HedgeDelay() time.Duration {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hp *HedgingPolicy) IsInterfaceNil() bool {
+	return hp == nil
+}
@@ -0,0 +1,15 @@
+package disabled
+
+// LeaderElection represents a disabled struct that implements the LeaderElectionHandler interface
+type LeaderElection struct {
+}
+
+// IsLeader returns true as this is a disabled component, so the proxy updates its own caches
+func (le *LeaderElection) IsLeader() bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (le *LeaderElection) IsInterfaceNil() bool {
+	return le == nil
+}
@@ -0,0 +1,24 @@
+package disabled
+
+import "time"
+
+// Metrics represents a disabled struct that implements the MetricsHandler interface
+type Metrics struct {
+}
+
+// RecordUpstreamRequest does nothing as this is a disabled component
+func (m *Metrics) RecordUpstreamRequest(_ string, _ string, _ string, _ time.Duration) {
+}
+
+// RecordCacheAccess does nothing as this is a disabled component
+func (m *Metrics) RecordCacheAccess(_ string, _ bool) {
+}
+
+// SetHealthyObservers does nothing as this is a disabled component
+func (m *Metrics) SetHealthyObservers(_ uint32, _ int) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (m *Metrics) IsInterfaceNil() bool {
+	return m == nil
+}
@@ -0,0 +1,21 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// NetworkConfigGuard represents a disabled struct that implements the NetworkConfigGuardHandler interface
+type NetworkConfigGuard struct {
+}
+
+// CheckNodes does nothing as this is a disabled component
+func (ncg *NetworkConfigGuard) CheckNodes(_ []*data.NodeData) {
+}
+
+// GetQuarantineReason returns false as this is a disabled component
+func (ncg *NetworkConfigGuard) GetQuarantineReason(_ string) (string, bool) {
+	return "", false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncg *NetworkConfigGuard) IsInterfaceNil() bool {
+	return ncg == nil
+}
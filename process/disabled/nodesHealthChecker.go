@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+var errNodesHealthChecksDisabled = errors.New("nodes health checks are disabled")
+
+// NodesHealthChecker represents a disabled struct that implements the NodesHealthHandler interface
+type NodesHealthChecker struct {
+}
+
+// GetNodesHealth returns an error as this is a disabled component
+func (nhc *NodesHealthChecker) GetNodesHealth() (*data.NodesHealthResponse, error) {
+	return nil, errNodesHealthChecksDisabled
+}
+
+// GetHighestFinalNonce returns false as this is a disabled component
+func (nhc *NodesHealthChecker) GetHighestFinalNonce(_ uint32) (uint64, bool) {
+	return 0, false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nhc *NodesHealthChecker) IsInterfaceNil() bool {
+	return nhc == nil
+}
@@ -0,0 +1,25 @@
+package disabled
+
+// QuorumPolicy represents a disabled struct that implements the QuorumPolicyHandler interface
+type QuorumPolicy struct {
+}
+
+// IsEnabledFor returns false as this is a disabled component
+func (qp *QuorumPolicy) IsEnabledFor(_ string) bool {
+	return false
+}
+
+// NumObservers returns 1 as this is a disabled component
+func (qp *QuorumPolicy) NumObservers() uint32 {
+	return 1
+}
+
+// MinAgreeing returns 1 as this is a disabled component
+func (qp *QuorumPolicy) MinAgreeing() uint32 {
+	return 1
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qp *QuorumPolicy) IsInterfaceNil() bool {
+	return qp == nil
+}
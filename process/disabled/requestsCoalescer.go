@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"context"
+)
+
+// RequestsCoalescer represents a disabled struct that implements the RequestsCoalescerHandler interface
+type RequestsCoalescer struct {
+}
+
+// Do makes the given call right away as this is a disabled component
+func (rc *RequestsCoalescer) Do(
+	_ context.Context,
+	_ string,
+	call func() (interface{}, int, error),
+) (interface{}, int, bool, error) {
+	value, statusCode, err := call()
+	return value, statusCode, false, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *RequestsCoalescer) IsInterfaceNil() bool {
+	return rc == nil
+}
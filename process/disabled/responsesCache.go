@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ResponsesCache represents a disabled struct that implements the ResponsesCacheHandler interface
+type ResponsesCache struct {
+}
+
+// Get returns false as this is a disabled component
+func (rc *ResponsesCache) Get(_ string, _ interface{}) bool {
+	return false
+}
+
+// Put does nothing as this is a disabled component
+func (rc *ResponsesCache) Put(_ string, _ interface{}) {
+}
+
+// GetStats returns empty stats as this is a disabled component
+func (rc *ResponsesCache) GetStats() data.ResponsesCacheStats {
+	return data.ResponsesCacheStats{}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *ResponsesCache) IsInterfaceNil() bool {
+	return rc == nil
+}
@@ -0,0 +1,22 @@
+package disabled
+
+import "time"
+
+// RetryPolicy represents a disabled struct that implements the RetryPolicyHandler interface
+type RetryPolicy struct {
+}
+
+// MaxRetries returns 0 as this is a disabled component
+func (rp *RetryPolicy) MaxRetries() uint32 {
+	return 0
+}
+
+// Backoff returns 0 as this is a disabled component
+func (rp *RetryPolicy) Backoff(_ uint32) time.Duration {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rp *RetryPolicy) IsInterfaceNil() bool {
+	return rp == nil
+}
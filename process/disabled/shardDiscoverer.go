@@ -0,0 +1,20 @@
+package disabled
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// ShardDiscoverer represents a disabled struct that implements the ShardDiscovererHandler interface
+type ShardDiscoverer struct {
+}
+
+// DiscoverNodesShards returns the given nodes unchanged and 0 as the number of shards, as this is a disabled component
+func (sd *ShardDiscoverer) DiscoverNodesShards(
+	observers []*data.NodeData,
+	fullHistoryNodes []*data.NodeData,
+) ([]*data.NodeData, []*data.NodeData, uint32) {
+	return observers, fullHistoryNodes, 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *ShardDiscoverer) IsInterfaceNil() bool {
+	return sd == nil
+}
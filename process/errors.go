@@ -85,3 +85,143 @@ var ErrInvalidSignatureBytes = errors.New("invalid signatures bytes")
 
 // ErrNoObserverAvailable signals that no observer could be found
 var ErrNoObserverAvailable = errors.New("no observer available")
+
+// ErrInvalidHealthCheckInterval signals that the provided interval between two nodes health checks is invalid
+var ErrInvalidHealthCheckInterval = errors.New("invalid health check interval")
+
+// ErrInvalidMaxConsecutiveFailures signals that the provided maximum number of consecutive failures is invalid
+var ErrInvalidMaxConsecutiveFailures = errors.New("invalid maximum number of consecutive failures")
+
+// ErrNilNodesHealthHandler signals that a nil nodes health handler has been provided
+var ErrNilNodesHealthHandler = errors.New("nil nodes health handler")
+
+// ErrNilNodesStatistics signals that a nil nodes statistics handler has been provided
+var ErrNilNodesStatistics = errors.New("nil nodes statistics handler")
+
+// ErrNilCircuitBreaker signals that a nil circuit breaker has been provided
+var ErrNilCircuitBreaker = errors.New("nil circuit breaker")
+
+// ErrCircuitOpen signals that the request was not sent because the node's circuit is open
+var ErrCircuitOpen = errors.New("circuit is open for the requested node")
+
+// ErrInvalidCircuitBreakerFailureThreshold signals that the provided circuit breaker failure threshold is invalid
+var ErrInvalidCircuitBreakerFailureThreshold = errors.New("invalid circuit breaker failure threshold")
+
+// ErrInvalidCircuitBreakerCoolDown signals that the provided circuit breaker cool-down period is invalid
+var ErrInvalidCircuitBreakerCoolDown = errors.New("invalid circuit breaker cool-down period")
+
+// ErrNilHttpClient signals that a nil http client has been provided
+var ErrNilHttpClient = errors.New("nil http client")
+
+// ErrInvalidHttpClientSetting signals that an invalid value has been provided for one of the http client's settings
+var ErrInvalidHttpClientSetting = errors.New("invalid http client setting")
+
+// ErrNilRetryPolicy signals that a nil retry policy has been provided
+var ErrNilRetryPolicy = errors.New("nil retry policy")
+
+// ErrInvalidRetryBackoff signals that an invalid initial or maximum backoff has been provided for the retry policy
+var ErrInvalidRetryBackoff = errors.New("invalid retry backoff")
+
+// ErrNilHedgingPolicy signals that a nil hedging policy has been provided
+var ErrNilHedgingPolicy = errors.New("nil hedging policy")
+
+// ErrInvalidHedgingPercentile signals that an invalid latency percentile has been provided for the hedging policy
+var ErrInvalidHedgingPercentile = errors.New("invalid hedging latency percentile")
+
+// ErrInvalidHedgingNumSamples signals that a too small number of latency samples has been provided for the hedging policy
+var ErrInvalidHedgingNumSamples = errors.New("invalid number of latency samples for hedging")
+
+// ErrInvalidHedgingDelay signals that an invalid minimum or maximum delay has been provided for the hedging policy
+var ErrInvalidHedgingDelay = errors.New("invalid hedging delay")
+
+// ErrConflictingNodeCredentials signals that both basic auth and a bearer token have been provided for a node, or
+// that the same node address has been defined with different credentials
+var ErrConflictingNodeCredentials = errors.New("conflicting node credentials")
+
+// ErrIncompleteClientCertificate signals that only one of the client certificate and key files has been provided for a node
+var ErrIncompleteClientCertificate = errors.New("both the client certificate and the client key files should be provided")
+
+// ErrInvalidCACertificate signals that no certificate could be read from the provided CA certificate file
+var ErrInvalidCACertificate = errors.New("invalid CA certificate")
+
+// ErrNilNodesHttpClients signals that a nil holder of the nodes' http clients has been provided
+var ErrNilNodesHttpClients = errors.New("nil nodes http clients")
+
+// ErrShardIDNotReported signals that a node did not report its shard ID
+var ErrShardIDNotReported = errors.New("the node did not report its shard ID")
+
+// ErrShardMismatch signals that a node reported another shard, or another number of shards, than expected
+var ErrShardMismatch = errors.New("shard mismatch")
+
+// ErrNilNetworkConfigGuard signals that a nil network config guard has been provided
+var ErrNilNetworkConfigGuard = errors.New("nil network config guard")
+
+// ErrNetworkConfigNotReported signals that a node did not report its chain ID in its network config
+var ErrNetworkConfigNotReported = errors.New("the node did not report its network config")
+
+// ErrInconsistentNetworkConfig signals that a node reported another chain ID, number of shards or minimum
+// transaction version than the other nodes
+var ErrInconsistentNetworkConfig = errors.New("inconsistent network config")
+
+// ErrNilQuorumPolicy signals that a nil quorum policy has been provided
+var ErrNilQuorumPolicy = errors.New("nil quorum policy")
+
+// ErrInvalidQuorum signals that an invalid number of observers, of agreeing observers or an unknown endpoint has been
+// provided for the quorum policy
+var ErrInvalidQuorum = errors.New("invalid quorum")
+
+// ErrObserversDisagreement signals that not enough observers gave the same answer to a quorum read
+var ErrObserversDisagreement = errors.New("the observers do not agree on the answer")
+
+// ErrNilConcurrencyLimiter signals that a nil concurrency limiter has been provided
+var ErrNilConcurrencyLimiter = errors.New("nil concurrency limiter")
+
+// ErrInvalidMaxInFlightRequests signals that an invalid maximum number of in-flight requests per node has been provided
+var ErrInvalidMaxInFlightRequests = errors.New("invalid maximum number of in-flight requests per node")
+
+// ErrInvalidQueueTimeout signals that an invalid queue timeout has been provided
+var ErrInvalidQueueTimeout = errors.New("invalid queue timeout")
+
+// ErrNodeOverloaded signals that the request was not sent because the node had too many requests in flight and
+// either its queue was full or the request waited too long in the queue
+var ErrNodeOverloaded = errors.New("the node has too many requests in flight")
+
+// ErrObserversOverloaded signals that the request was rejected by all the observers because they were overloaded
+var ErrObserversOverloaded = errors.New("all the observers are overloaded, try again later")
+
+// ErrNilRoutingTable signals that a nil routing table has been provided
+var ErrNilRoutingTable = errors.New("nil routing table")
+
+// ErrInvalidRoute signals that an invalid route or custom pool has been provided for the routing table
+var ErrInvalidRoute = errors.New("invalid route")
+
+// ErrNilResponsesCache signals that a nil responses cache has been provided
+var ErrNilResponsesCache = errors.New("nil responses cache")
+
+// ErrInvalidResponsesCacheCapacity signals that an invalid capacity has been provided for the responses cache
+var ErrInvalidResponsesCacheCapacity = errors.New("invalid responses cache capacity")
+
+// ErrNilFinalNoncesHandler signals that a nil final nonces handler has been provided
+var ErrNilFinalNoncesHandler = errors.New("nil final nonces handler")
+
+// ErrNilRequestsCoalescer signals that a nil requests coalescer has been provided
+var ErrNilRequestsCoalescer = errors.New("nil requests coalescer")
+
+// ErrNilLeaderElection signals that a nil leader election component has been provided
+var ErrNilLeaderElection = errors.New("nil leader election")
+
+// ErrInvalidApiKeysConfig signals that an invalid configuration of the API keys or of their tiers has been provided
+var ErrInvalidApiKeysConfig = errors.New("invalid api keys config")
+
+// ErrNilApiKeysUsageHandler signals that a nil API keys usage handler has been provided
+var ErrNilApiKeysUsageHandler = errors.New("nil api keys usage handler")
+
+// ErrInvalidUsageSaveInterval signals that an invalid interval for saving the usage counters of the API keys has been
+// provided
+var ErrInvalidUsageSaveInterval = errors.New("invalid usage save interval")
+
+// ErrNilMetricsHandler signals that a nil metrics handler has been provided
+var ErrNilMetricsHandler = errors.New("nil metrics handler")
+
+// ErrInvalidReadinessProbeTimeout signals that the provided timeout of the readiness probes is invalid
+var ErrInvalidReadinessProbeTimeout = errors.New("invalid readiness probe timeout")
@@ -1,6 +1,8 @@
 package factory
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -11,8 +13,22 @@ import (
 // Processor defines what a processor should be able to do
 type Processor interface {
 	ComputeShardId(addressBuff []byte) (uint32, error)
-	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
-	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
+	CallGetRestEndPoint(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallPostRestEndPoint(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
+	ReadFromObservers(
+		ctx context.Context,
+		observers []*data.NodeData,
+		read func(ctx context.Context, observer *data.NodeData) (interface{}, int, error),
+		isAnswer func(responseCode int, err error) bool,
+	) (*data.ObserverResponse, error)
+	ReadFromObserversWithQuorum(
+		ctx context.Context,
+		endpoint string,
+		observers []*data.NodeData,
+		read func(ctx context.Context, observer *data.NodeData) (interface{}, int, error),
+		isAnswer func(responseCode int, err error) bool,
+		digest func(value interface{}) (string, error),
+	) (*data.ObserverResponse, error)
 	GetObserversOnePerShard() ([]*data.NodeData, error)
 	GetShardIDs() []uint32
 	GetFullHistoryNodesOnePerShard() ([]*data.NodeData, error)
@@ -20,6 +36,8 @@ type Processor interface {
 	GetAllObservers() ([]*data.NodeData, error)
 	GetFullHistoryNodes(shardID uint32) ([]*data.NodeData, error)
 	GetAllFullHistoryNodes() ([]*data.NodeData, error)
+	GetNodesForRequest(ctx context.Context, category string, shardID uint32, key string) ([]*data.NodeData, error)
+	GetAllNodesForRequest(category string) ([]*data.NodeData, error)
 	GetShardCoordinator() sharding.Coordinator
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -10,10 +11,14 @@ import (
 // HeartBeatPath represents the path where an observer exposes his heartbeat status
 const HeartBeatPath = "/node/heartbeatstatus"
 
+// heartbeatsCacheUpdateEndpoint is the endpoint name of the requests sent for updating the heartbeats cache
+const heartbeatsCacheUpdateEndpoint = "heartbeats-cache-update"
+
 // HeartbeatProcessor is able to process transaction requests
 type HeartbeatProcessor struct {
 	proc                  Processor
 	cacher                HeartbeatCacheHandler
+	leaderElection        LeaderElectionHandler
 	cacheValidityDuration time.Duration
 }
 
@@ -21,6 +26,7 @@ type HeartbeatProcessor struct {
 func NewHeartbeatProcessor(
 	proc Processor,
 	cacher HeartbeatCacheHandler,
+	leaderElection LeaderElectionHandler,
 	cacheValidityDuration time.Duration,
 ) (*HeartbeatProcessor, error) {
 	if check.IfNil(proc) {
@@ -29,12 +35,16 @@ func NewHeartbeatProcessor(
 	if check.IfNil(cacher) {
 		return nil, ErrNilHeartbeatCacher
 	}
+	if check.IfNil(leaderElection) {
+		return nil, ErrNilLeaderElection
+	}
 	if cacheValidityDuration <= 0 {
 		return nil, ErrInvalidCacheValidityDuration
 	}
 	hbp := &HeartbeatProcessor{
 		proc:                  proc,
 		cacher:                cacher,
+		leaderElection:        leaderElection,
 		cacheValidityDuration: cacheValidityDuration,
 	}
 
@@ -42,7 +52,7 @@ func NewHeartbeatProcessor(
 }
 
 // GetHeartbeatData will simply forward the heartbeat status from an observer
-func (hbp *HeartbeatProcessor) GetHeartbeatData() (*data.HeartbeatResponse, error) {
+func (hbp *HeartbeatProcessor) GetHeartbeatData(ctx context.Context) (*data.HeartbeatResponse, error) {
 	heartbeatsToReturn, err := hbp.cacher.LoadHeartbeats()
 	if err == nil {
 		return heartbeatsToReturn, nil
@@ -50,18 +60,18 @@ func (hbp *HeartbeatProcessor) GetHeartbeatData() (*data.HeartbeatResponse, erro
 
 	log.Info("heartbeat: cannot get from cache. Will fetch from API", "error", err.Error())
 
-	return hbp.getHeartbeatsFromApi()
+	return hbp.getHeartbeatsFromApi(context.Background())
 }
 
-func (hbp *HeartbeatProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse, error) {
-	observers, err := hbp.proc.GetAllObservers()
+func (hbp *HeartbeatProcessor) getHeartbeatsFromApi(ctx context.Context) (*data.HeartbeatResponse, error) {
+	observers, err := hbp.proc.GetAllNodesForRequest(RequestCategoryNetwork)
 	if err != nil {
 		return nil, err
 	}
 
 	var response data.HeartbeatApiResponse
 	for _, observer := range observers {
-		_, err = hbp.proc.CallGetRestEndPoint(observer.Address, HeartBeatPath, &response)
+		_, err = hbp.proc.CallGetRestEndPoint(ctx, observer.Address, HeartBeatPath, &response)
 		if err == nil {
 			log.Info("heartbeat fetched from API", "observer", observer.Address)
 			return &response.Data, nil
@@ -71,11 +81,17 @@ func (hbp *HeartbeatProcessor) getHeartbeatsFromApi() (*data.HeartbeatResponse,
 	return nil, ErrHeartbeatNotAvailable
 }
 
-// StartCacheUpdate will start the updating of the cache from the API at a given period
+// StartCacheUpdate will start the updating of the cache from the API at a given period. When the cache is shared by
+// several proxies, only the elected leader updates it
 func (hbp *HeartbeatProcessor) StartCacheUpdate() {
 	go func() {
 		for {
-			hbts, err := hbp.getHeartbeatsFromApi()
+			if !hbp.leaderElection.IsLeader() {
+				time.Sleep(hbp.cacheValidityDuration)
+				continue
+			}
+
+			hbts, err := hbp.getHeartbeatsFromApi(data.WithEndpointName(context.Background(), heartbeatsCacheUpdateEndpoint))
 			if err != nil {
 				log.Warn("heartbeat: get from API", "error", err.Error())
 			}
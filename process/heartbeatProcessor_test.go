@@ -1,12 +1,14 @@
 package process_test
 
 import (
+	"context"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/disabled"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 )
@@ -14,7 +16,7 @@ import (
 func TestNewHeartbeatProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewHeartbeatProcessor(nil, &mock.HeartbeatCacherMock{}, time.Second)
+	hp, err := process.NewHeartbeatProcessor(nil, &mock.HeartbeatCacherMock{}, &disabled.LeaderElection{}, time.Second)
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -23,16 +25,25 @@ func TestNewHeartbeatProcessor_NilProcessorShouldErr(t *testing.T) {
 func TestNewHeartbeatProcessor_NilCacherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{}, nil, time.Second)
+	hp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{}, nil, &disabled.LeaderElection{}, time.Second)
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilHeartbeatCacher, err)
 }
 
+func TestNewHeartbeatProcessor_NilLeaderElectionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, nil, time.Second)
+
+	assert.Nil(t, hp)
+	assert.Equal(t, process.ErrNilLeaderElection, err)
+}
+
 func TestNewHeartbeatProcessor_InvalidCacheValidityDurationShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, -time.Second)
+	hp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, &disabled.LeaderElection{}, -time.Second)
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrInvalidCacheValidityDuration, err)
@@ -41,7 +52,7 @@ func TestNewHeartbeatProcessor_InvalidCacheValidityDurationShouldErr(t *testing.
 func TestNewHeartbeatProcessor_WithOkProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hbp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, time.Second)
+	hbp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, &disabled.LeaderElection{}, time.Second)
 
 	assert.NotNil(t, hbp)
 	assert.Nil(t, err)
@@ -50,10 +61,10 @@ func TestNewHeartbeatProcessor_WithOkProcessorShouldErr(t *testing.T) {
 func TestHeartbeatProcessor_GetHeartbeatDataWrongValuesShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, time.Second)
+	hp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{}, &mock.HeartbeatCacherMock{}, &disabled.LeaderElection{}, time.Second)
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -71,17 +82,18 @@ func TestHeartbeatProcessor_GetHeartbeatDataOkValuesShouldPass(t *testing.T) {
 			})
 			return obs, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			return 0, nil
 		},
 	},
 		&mock.HeartbeatCacherMock{},
+		&disabled.LeaderElection{},
 		time.Second,
 	)
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 	assert.NotNil(t, res)
 	assert.Nil(t, err)
 }
@@ -97,17 +109,18 @@ func TestHeartbeatProcessor_GetHeartbeatDataShouldReturnDataFromApiBecauseCacheD
 			GetAllObserversCalled: func() ([]*data.NodeData, error) {
 				return []*data.NodeData{{Address: "obs1"}}, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 				httpWasCalled = true
 				return 0, nil
 			},
 		},
 		cacher,
+		&disabled.LeaderElection{},
 		time.Second,
 	)
 	assert.Nil(t, err)
 
-	_, err = hp.GetHeartbeatData()
+	_, err = hp.GetHeartbeatData(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 }
@@ -126,10 +139,10 @@ func TestHeartbeatProcessor_GetHeartbeatDataShouldReturnDataFromCacher(t *testin
 		},
 	}
 	cacher := &mock.HeartbeatCacherMock{Data: &hbtsResp}
-	hp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+	hp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{}, cacher, &disabled.LeaderElection{}, time.Millisecond)
 	assert.Nil(t, err)
 
-	res, err := hp.GetHeartbeatData()
+	res, err := hp.GetHeartbeatData(context.Background())
 
 	assert.Nil(t, err)
 	assert.Equal(t, *res, hbtsResp)
@@ -144,12 +157,13 @@ func TestHeartbeatProcessor_CacheShouldUpdate(t *testing.T) {
 		GetAllObserversCalled: func() ([]*data.NodeData, error) {
 			return []*data.NodeData{{Address: "obs1"}}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
 			return 0, nil
 		},
 	},
 		cacher,
+		&disabled.LeaderElection{},
 		25*time.Millisecond)
 
 	assert.Nil(t, err)
@@ -169,3 +183,36 @@ func TestHeartbeatProcessor_CacheShouldUpdate(t *testing.T) {
 	time.Sleep(5 * time.Millisecond)
 	assert.Equal(t, int32(3), atomic.LoadInt32(&numOfTimesHttpWasCalled))
 }
+
+func TestHeartbeatProcessor_CacheShouldNotUpdateIfNotLeader(t *testing.T) {
+	t.Parallel()
+
+	numOfTimesHttpWasCalled := int32(0)
+	isLeader := int32(0)
+	hp, err := process.NewHeartbeatProcessor(&mock.ProcessorStub{
+		GetAllObserversCalled: func() ([]*data.NodeData, error) {
+			return []*data.NodeData{{Address: "obs1"}}, nil
+		},
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
+			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
+			return 0, nil
+		},
+	},
+		&mock.HeartbeatCacherMock{},
+		&mock.LeaderElectionStub{
+			IsLeaderCalled: func() bool {
+				return atomic.LoadInt32(&isLeader) == 1
+			},
+		},
+		5*time.Millisecond)
+	assert.Nil(t, err)
+
+	hp.StartCacheUpdate()
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numOfTimesHttpWasCalled))
+
+	atomic.StoreInt32(&isLeader, 1)
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&numOfTimesHttpWasCalled) > 0
+	}, time.Second, time.Millisecond)
+}
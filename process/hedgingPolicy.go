@@ -0,0 +1,120 @@
+package process
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+const minSamplesForHedgeDelay = 10
+
+// ArgsHedgingPolicy holds the arguments needed for creating a new HedgingPolicy
+type ArgsHedgingPolicy struct {
+	Percentile float64
+	NumSamples uint32
+	MinDelay   time.Duration
+	MaxDelay   time.Duration
+}
+
+// HedgingPolicy keeps the latencies of the most recent reads and computes the delay after which a duplicate read is
+// sent to another observer as the given percentile of those latencies. Until enough latencies are recorded, MaxDelay
+// is used
+type HedgingPolicy struct {
+	percentile float64
+	minDelay   time.Duration
+	maxDelay   time.Duration
+
+	mutSamples        sync.Mutex
+	samples           []time.Duration
+	nextSampleIndex   int
+	numNewSamples     int
+	recomputeInterval int
+	delay             time.Duration
+}
+
+// NewHedgingPolicy creates a new instance of HedgingPolicy
+func NewHedgingPolicy(args ArgsHedgingPolicy) (*HedgingPolicy, error) {
+	if args.Percentile <= 0 || args.Percentile > 100 {
+		return nil, ErrInvalidHedgingPercentile
+	}
+	if args.NumSamples < minSamplesForHedgeDelay {
+		return nil, ErrInvalidHedgingNumSamples
+	}
+	if args.MinDelay <= 0 || args.MaxDelay < args.MinDelay {
+		return nil, ErrInvalidHedgingDelay
+	}
+
+	return &HedgingPolicy{
+		percentile:        args.Percentile,
+		minDelay:          args.MinDelay,
+		maxDelay:          args.MaxDelay,
+		samples:           make([]time.Duration, 0, args.NumSamples),
+		recomputeInterval: int(args.NumSamples) / minSamplesForHedgeDelay,
+		delay:             args.MaxDelay,
+	}, nil
+}
+
+// RecordLatency records the latency of a successful read
+func (hp *HedgingPolicy) RecordLatency(latency time.Duration) {
+	hp.mutSamples.Lock()
+	defer hp.mutSamples.Unlock()
+
+	if len(hp.samples) < cap(hp.samples) {
+		hp.samples = append(hp.samples, latency)
+	} else {
+		hp.samples[hp.nextSampleIndex] = latency
+		hp.nextSampleIndex = (hp.nextSampleIndex + 1) % len(hp.samples)
+	}
+
+	hp.numNewSamples++
+	if len(hp.samples) < minSamplesForHedgeDelay || hp.numNewSamples < hp.recomputeInterval {
+		return
+	}
+
+	hp.numNewSamples = 0
+	hp.delay = hp.computeDelay()
+}
+
+func (hp *HedgingPolicy) computeDelay() time.Duration {
+	sorted := make([]time.Duration, len(hp.samples))
+	copy(sorted, hp.samples)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	index := int(hp.percentile/100*float64(len(sorted))+0.5) - 1
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(sorted) {
+		index = len(sorted) - 1
+	}
+
+	delay := sorted[index]
+	if delay < hp.minDelay {
+		return hp.minDelay
+	}
+	if delay > hp.maxDelay {
+		return hp.maxDelay
+	}
+
+	return delay
+}
+
+// IsEnabled returns true as the duplicate reads are sent
+func (hp *HedgingPolicy) IsEnabled() bool {
+	return true
+}
+
+// HedgeDelay returns the duration after which a duplicate read is sent to another observer
+func (hp *HedgingPolicy) HedgeDelay() time.Duration {
+	hp.mutSamples.Lock()
+	defer hp.mutSamples.Unlock()
+
+	return hp.delay
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hp *HedgingPolicy) IsInterfaceNil() bool {
+	return hp == nil
+}
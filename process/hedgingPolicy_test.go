@@ -0,0 +1,81 @@
+package process_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func createArgsHedgingPolicy() process.ArgsHedgingPolicy {
+	return process.ArgsHedgingPolicy{
+		Percentile: 90,
+		NumSamples: 100,
+		MinDelay:   5 * time.Millisecond,
+		MaxDelay:   time.Second,
+	}
+}
+
+func TestNewHedgingPolicy_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHedgingPolicy()
+	args.Percentile = 0
+	hp, err := process.NewHedgingPolicy(args)
+	assert.True(t, check.IfNil(hp))
+	assert.Equal(t, process.ErrInvalidHedgingPercentile, err)
+
+	args = createArgsHedgingPolicy()
+	args.Percentile = 100.5
+	hp, err = process.NewHedgingPolicy(args)
+	assert.True(t, check.IfNil(hp))
+	assert.Equal(t, process.ErrInvalidHedgingPercentile, err)
+
+	args = createArgsHedgingPolicy()
+	args.NumSamples = 5
+	hp, err = process.NewHedgingPolicy(args)
+	assert.True(t, check.IfNil(hp))
+	assert.Equal(t, process.ErrInvalidHedgingNumSamples, err)
+
+	args = createArgsHedgingPolicy()
+	args.MaxDelay = args.MinDelay / 2
+	hp, err = process.NewHedgingPolicy(args)
+	assert.True(t, check.IfNil(hp))
+	assert.Equal(t, process.ErrInvalidHedgingDelay, err)
+}
+
+func TestHedgingPolicy_HedgeDelayShouldBeTheMaxDelayUntilEnoughSamples(t *testing.T) {
+	t.Parallel()
+
+	hp, err := process.NewHedgingPolicy(createArgsHedgingPolicy())
+	assert.Nil(t, err)
+	assert.True(t, hp.IsEnabled())
+
+	for i := 0; i < 9; i++ {
+		hp.RecordLatency(10 * time.Millisecond)
+	}
+	assert.Equal(t, time.Second, hp.HedgeDelay())
+}
+
+func TestHedgingPolicy_HedgeDelayShouldBeTheLatencyPercentile(t *testing.T) {
+	t.Parallel()
+
+	hp, _ := process.NewHedgingPolicy(createArgsHedgingPolicy())
+	for i := 1; i <= 100; i++ {
+		hp.RecordLatency(time.Duration(i) * time.Millisecond)
+	}
+	assert.Equal(t, 90*time.Millisecond, hp.HedgeDelay())
+
+	// the oldest samples are replaced by the newest ones
+	for i := 0; i < 100; i++ {
+		hp.RecordLatency(time.Millisecond)
+	}
+	assert.Equal(t, 5*time.Millisecond, hp.HedgeDelay())
+
+	for i := 0; i < 100; i++ {
+		hp.RecordLatency(time.Minute)
+	}
+	assert.Equal(t, time.Second, hp.HedgeDelay())
+}
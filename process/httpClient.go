@@ -0,0 +1,90 @@
+package process
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// ArgsHttpClient holds the arguments needed for creating the http client used for the requests towards the nodes.
+// A zero value for a limit or for a timeout, other than RequestTimeout, means no limit, while a zero KeepAlive means
+// the default TCP keep-alive period
+type ArgsHttpClient struct {
+	RequestTimeout        time.Duration
+	DialTimeout           time.Duration
+	KeepAlive             time.Duration
+	TLSHandshakeTimeout   time.Duration
+	ResponseHeaderTimeout time.Duration
+	IdleConnTimeout       time.Duration
+	MaxIdleConns          int
+	MaxIdleConnsPerHost   int
+	MaxConnsPerHost       int
+	DisableKeepAlives     bool
+	EnableHTTP2           bool
+	EnableGzip            bool
+}
+
+// NewHttpClient creates a new http client with its own transport, so the connection pool towards the nodes is
+// not shared with any other user of the http.DefaultClient
+func NewHttpClient(args ArgsHttpClient) (*http.Client, error) {
+	err := checkArgsHttpClient(args)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   args.DialTimeout,
+		KeepAlive: args.KeepAlive,
+	}
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     args.EnableHTTP2,
+		TLSHandshakeTimeout:   args.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: args.ResponseHeaderTimeout,
+		IdleConnTimeout:       args.IdleConnTimeout,
+		MaxIdleConns:          args.MaxIdleConns,
+		MaxIdleConnsPerHost:   args.MaxIdleConnsPerHost,
+		MaxConnsPerHost:       args.MaxConnsPerHost,
+		DisableKeepAlives:     args.DisableKeepAlives,
+		DisableCompression:    !args.EnableGzip,
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   args.RequestTimeout,
+	}, nil
+}
+
+func checkArgsHttpClient(args ArgsHttpClient) error {
+	if args.RequestTimeout <= 0 {
+		return ErrInvalidRequestTimeout
+	}
+
+	durations := map[string]time.Duration{
+		"dial timeout":            args.DialTimeout,
+		"keep-alive period":       args.KeepAlive,
+		"TLS handshake timeout":   args.TLSHandshakeTimeout,
+		"response header timeout": args.ResponseHeaderTimeout,
+		"idle connection timeout": args.IdleConnTimeout,
+	}
+	for name, duration := range durations {
+		if duration < 0 {
+			return fmt.Errorf("%w: negative %s", ErrInvalidHttpClientSetting, name)
+		}
+	}
+
+	limits := map[string]int{
+		"max idle connections":          args.MaxIdleConns,
+		"max idle connections per host": args.MaxIdleConnsPerHost,
+		"max connections per host":      args.MaxConnsPerHost,
+	}
+	for name, limit := range limits {
+		if limit < 0 {
+			return fmt.Errorf("%w: negative %s", ErrInvalidHttpClientSetting, name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package process_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsHttpClient() process.ArgsHttpClient {
+	return process.ArgsHttpClient{
+		RequestTimeout:      5 * time.Second,
+		DialTimeout:         time.Second,
+		IdleConnTimeout:     time.Minute,
+		MaxIdleConns:        10,
+		MaxIdleConnsPerHost: 5,
+		EnableHTTP2:         true,
+		EnableGzip:          true,
+	}
+}
+
+func TestNewHttpClient_InvalidRequestTimeoutShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHttpClient()
+	args.RequestTimeout = 0
+	client, err := process.NewHttpClient(args)
+
+	assert.Nil(t, client)
+	assert.Equal(t, process.ErrInvalidRequestTimeout, err)
+}
+
+func TestNewHttpClient_NegativeSettingShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHttpClient()
+	args.MaxIdleConnsPerHost = -1
+	client, err := process.NewHttpClient(args)
+
+	assert.Nil(t, client)
+	assert.True(t, errors.Is(err, process.ErrInvalidHttpClientSetting))
+
+	args = createArgsHttpClient()
+	args.DialTimeout = -time.Second
+	client, err = process.NewHttpClient(args)
+
+	assert.Nil(t, client)
+	assert.True(t, errors.Is(err, process.ErrInvalidHttpClientSetting))
+}
+
+func TestNewHttpClient_ShouldNotUseTheDefaultClient(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHttpClient()
+	args.MaxConnsPerHost = 20
+	args.DisableKeepAlives = true
+	args.EnableGzip = false
+	client, err := process.NewHttpClient(args)
+	require.Nil(t, err)
+
+	assert.False(t, client == http.DefaultClient)
+	assert.Equal(t, args.RequestTimeout, client.Timeout)
+
+	transport, ok := client.Transport.(*http.Transport)
+	require.True(t, ok)
+	assert.False(t, transport == http.DefaultTransport)
+	assert.Equal(t, 10, transport.MaxIdleConns)
+	assert.Equal(t, 5, transport.MaxIdleConnsPerHost)
+	assert.Equal(t, 20, transport.MaxConnsPerHost)
+	assert.Equal(t, time.Minute, transport.IdleConnTimeout)
+	assert.True(t, transport.ForceAttemptHTTP2)
+	assert.True(t, transport.DisableKeepAlives)
+	assert.True(t, transport.DisableCompression)
+}
+
+func TestNewHttpClient_ShouldRequestGzipResponsesIfEnabled(t *testing.T) {
+	t.Parallel()
+
+	receivedEncoding := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		receivedEncoding <- req.Header.Get("Accept-Encoding")
+	}))
+	defer server.Close()
+
+	client, _ := process.NewHttpClient(createArgsHttpClient())
+	resp, err := client.Get(server.URL)
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, "gzip", <-receivedEncoding)
+}
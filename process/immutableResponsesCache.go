@@ -0,0 +1,111 @@
+package process
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// cachedResponse holds the JSON serialized response and its type, so each reader decodes a copy of its own
+type cachedResponse struct {
+	valueType reflect.Type
+	buff      []byte
+}
+
+// ImmutableResponsesCache is a size-bounded LRU cache for the responses which never change once they were fetched
+// from the nodes, such as the blocks read by hash. The least recently used responses are evicted when the capacity
+// is reached
+type ImmutableResponsesCache struct {
+	numHits   uint64
+	numMisses uint64
+	cacher    storage.Cacher
+	capacity  int
+}
+
+// NewImmutableResponsesCache creates a new instance of ImmutableResponsesCache, able to hold at most capacity responses
+func NewImmutableResponsesCache(capacity int) (*ImmutableResponsesCache, error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidResponsesCacheCapacity
+	}
+
+	cacher, err := lrucache.NewCache(capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImmutableResponsesCache{
+		cacher:   cacher,
+		capacity: capacity,
+	}, nil
+}
+
+// Get decodes a copy of the response stored under the given key, if any, in the value pointed to by value. It returns
+// false if no response of the same type is stored under the key
+func (irc *ImmutableResponsesCache) Get(key string, value interface{}) bool {
+	cachedValue, found := irc.cacher.Get([]byte(key))
+	if !found || !decodeCachedValue(cachedValue, value) {
+		atomic.AddUint64(&irc.numMisses, 1)
+		return false
+	}
+
+	atomic.AddUint64(&irc.numHits, 1)
+	return true
+}
+
+func decodeCachedValue(cachedValue interface{}, value interface{}) bool {
+	valuePtr := reflect.ValueOf(value)
+	if valuePtr.Kind() != reflect.Ptr || valuePtr.IsNil() {
+		return false
+	}
+
+	response, ok := cachedValue.(*cachedResponse)
+	if !ok || response.valueType != valuePtr.Type() {
+		return false
+	}
+
+	// the value is reset first, so no field of a previous content is left next to the decoded ones
+	valuePtr.Elem().Set(reflect.Zero(valuePtr.Elem().Type()))
+	err := json.Unmarshal(response.buff, value)
+	if err != nil {
+		log.Warn("immutable responses cache: cannot decode the cached response", "error", err.Error())
+		return false
+	}
+
+	return true
+}
+
+// Put stores a serialized copy of the given response, which should be a pointer, under the given key, so changing the
+// response afterwards does not affect the cached one
+func (irc *ImmutableResponsesCache) Put(key string, value interface{}) {
+	valuePtr := reflect.ValueOf(value)
+	if valuePtr.Kind() != reflect.Ptr || valuePtr.IsNil() {
+		return
+	}
+
+	buff, err := json.Marshal(value)
+	if err != nil {
+		log.Warn("immutable responses cache: cannot serialize the response", "error", err.Error())
+		return
+	}
+
+	_ = irc.cacher.Put([]byte(key), &cachedResponse{valueType: valuePtr.Type(), buff: buff}, len(buff))
+}
+
+// GetStats returns the number of hits and misses since the proxy started and the number of stored responses
+func (irc *ImmutableResponsesCache) GetStats() data.ResponsesCacheStats {
+	return data.ResponsesCacheStats{
+		Hits:       atomic.LoadUint64(&irc.numHits),
+		Misses:     atomic.LoadUint64(&irc.numMisses),
+		NumEntries: irc.cacher.Len(),
+		Capacity:   irc.capacity,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (irc *ImmutableResponsesCache) IsInterfaceNil() bool {
+	return irc == nil
+}
@@ -0,0 +1,90 @@
+package process_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewImmutableResponsesCache_InvalidCapacityShouldErr(t *testing.T) {
+	t.Parallel()
+
+	irc, err := process.NewImmutableResponsesCache(0)
+	assert.True(t, check.IfNil(irc))
+	assert.Equal(t, process.ErrInvalidResponsesCacheCapacity, err)
+}
+
+func TestImmutableResponsesCache_GetAndPutShouldCountTheHitsAndMisses(t *testing.T) {
+	t.Parallel()
+
+	irc, err := process.NewImmutableResponsesCache(10)
+	assert.False(t, check.IfNil(irc))
+	assert.Nil(t, err)
+
+	value := &data.BlockApiResponse{}
+	found := irc.Get("key", value)
+	assert.False(t, found)
+
+	block := &data.BlockApiResponse{Code: "successful"}
+	irc.Put("key", block)
+	found = irc.Get("key", value)
+	assert.True(t, found)
+	assert.Equal(t, block, value)
+
+	assert.Equal(t, data.ResponsesCacheStats{Hits: 1, Misses: 1, NumEntries: 1, Capacity: 10}, irc.GetStats())
+}
+
+func TestImmutableResponsesCache_GetWithAnotherTypeShouldMiss(t *testing.T) {
+	t.Parallel()
+
+	irc, _ := process.NewImmutableResponsesCache(10)
+	irc.Put("key", &data.BlockApiResponse{})
+
+	assert.False(t, irc.Get("key", &data.HyperblockApiResponse{}))
+	assert.False(t, irc.Get("key", data.BlockApiResponse{}))
+	assert.Equal(t, uint64(2), irc.GetStats().Misses)
+}
+
+func TestImmutableResponsesCache_ChangingTheStoredOrTheReadResponsesShouldNotChangeTheCachedOne(t *testing.T) {
+	t.Parallel()
+
+	irc, _ := process.NewImmutableResponsesCache(10)
+	block := &data.BlockApiResponse{}
+	block.Data.Block = data.Block{Nonce: 10, NotarizedBlocks: []*data.NotarizedBlock{{Hash: "hash", Nonce: 9}}}
+	irc.Put("key", block)
+	block.Data.Block.NotarizedBlocks[0].Hash = "changed after put"
+
+	firstValue := &data.BlockApiResponse{}
+	assert.True(t, irc.Get("key", firstValue))
+	assert.Equal(t, "hash", firstValue.Data.Block.NotarizedBlocks[0].Hash)
+	firstValue.Data.Block.NotarizedBlocks[0].Hash = "changed after get"
+	firstValue.Data.Block.Nonce = 11
+
+	secondValue := &data.BlockApiResponse{}
+	assert.True(t, irc.Get("key", secondValue))
+	assert.Equal(t, uint64(10), secondValue.Data.Block.Nonce)
+	assert.Equal(t, "hash", secondValue.Data.Block.NotarizedBlocks[0].Hash)
+}
+
+func TestImmutableResponsesCache_ShouldEvictTheLeastRecentlyUsedResponses(t *testing.T) {
+	t.Parallel()
+
+	irc, _ := process.NewImmutableResponsesCache(3)
+	for i := 0; i < 3; i++ {
+		irc.Put(fmt.Sprintf("key%d", i), &data.BlockApiResponse{})
+	}
+	_ = irc.Get("key0", &data.BlockApiResponse{})
+	irc.Put("key3", &data.BlockApiResponse{})
+
+	found := irc.Get("key1", &data.BlockApiResponse{})
+	assert.False(t, found)
+	for _, key := range []string{"key0", "key2", "key3"} {
+		found = irc.Get(key, &data.BlockApiResponse{})
+		assert.True(t, found)
+	}
+	assert.Equal(t, 3, irc.GetStats().NumEntries)
+}
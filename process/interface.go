@@ -1,6 +1,10 @@
 package process
 
 import (
+	"context"
+	"net/http"
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -16,10 +20,26 @@ type Processor interface {
 	GetFullHistoryNodesOnePerShard() ([]*data.NodeData, error)
 	GetFullHistoryNodes(shardID uint32) ([]*data.NodeData, error)
 	GetAllFullHistoryNodes() ([]*data.NodeData, error)
+	GetNodesForRequest(ctx context.Context, category string, shardID uint32, key string) ([]*data.NodeData, error)
+	GetAllNodesForRequest(category string) ([]*data.NodeData, error)
 	GetShardIDs() []uint32
 	ComputeShardId(addressBuff []byte) (uint32, error)
-	CallGetRestEndPoint(address string, path string, value interface{}) (int, error)
-	CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error)
+	CallGetRestEndPoint(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallPostRestEndPoint(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
+	ReadFromObservers(
+		ctx context.Context,
+		observers []*data.NodeData,
+		read func(ctx context.Context, observer *data.NodeData) (interface{}, int, error),
+		isAnswer func(responseCode int, err error) bool,
+	) (*data.ObserverResponse, error)
+	ReadFromObserversWithQuorum(
+		ctx context.Context,
+		endpoint string,
+		observers []*data.NodeData,
+		read func(ctx context.Context, observer *data.NodeData) (interface{}, int, error),
+		isAnswer func(responseCode int, err error) bool,
+		digest func(value interface{}) (string, error),
+	) (*data.ObserverResponse, error)
 	GetShardCoordinator() sharding.Coordinator
 	GetPubKeyConverter() core.PubkeyConverter
 	GetObserverProvider() observer.NodesProviderHandler
@@ -31,6 +51,7 @@ type Processor interface {
 type ExternalStorageConnector interface {
 	GetTransactionsByAddress(address string) ([]data.DatabaseTransaction, error)
 	GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error)
+	Ping(ctx context.Context) error
 	IsInterfaceNil() bool
 }
 
@@ -46,9 +67,138 @@ type HeartbeatCacheHandler interface {
 	IsInterfaceNil() bool
 }
 
+// LeaderElectionHandler defines what a component which tells if this proxy should update the caches shared with other
+// proxies should be able to do
+type LeaderElectionHandler interface {
+	IsLeader() bool
+	IsInterfaceNil() bool
+}
+
 // ValidatorStatisticsCacheHandler will define what a real validator statistics cacher should do
 type ValidatorStatisticsCacheHandler interface {
-	LoadValStats() (map[string]*data.ValidatorApiResponse, error)
+	LoadValStats() (*data.ValidatorStatisticsResponse, error)
 	StoreValStats(valStats map[string]*data.ValidatorApiResponse) error
 	IsInterfaceNil() bool
 }
+
+// NodesHealthHandler defines what a component which keeps track of the nodes health should be able to do
+type NodesHealthHandler interface {
+	GetNodesHealth() (*data.NodesHealthResponse, error)
+	GetHighestFinalNonce(shardID uint32) (uint64, bool)
+	IsInterfaceNil() bool
+}
+
+// FinalNoncesHandler defines what a component which knows the highest final nonce of each shard should be able to do
+type FinalNoncesHandler interface {
+	GetHighestFinalNonce(shardID uint32) (uint64, bool)
+	IsInterfaceNil() bool
+}
+
+// ResponsesCacheHandler defines what a cache of the responses which never change should be able to do
+type ResponsesCacheHandler interface {
+	Get(key string, value interface{}) bool
+	Put(key string, value interface{})
+	GetStats() data.ResponsesCacheStats
+	IsInterfaceNil() bool
+}
+
+// NetworkConfigGuardHandler defines what a component which quarantines the nodes with an inconsistent network config
+// should be able to do
+type NetworkConfigGuardHandler interface {
+	CheckNodes(nodes []*data.NodeData)
+	GetQuarantineReason(address string) (string, bool)
+	IsInterfaceNil() bool
+}
+
+// CircuitBreakerHandler defines what a component which protects the nodes from being flooded with requests while
+// failing should be able to do
+type CircuitBreakerHandler interface {
+	AllowRequest(address string) bool
+	RecordSuccess(address string)
+	RecordFailure(address string)
+	IsOpen(address string) bool
+	GetCircuitStatus(address string) data.CircuitStatus
+	IsInterfaceNil() bool
+}
+
+// RetryPolicyHandler defines what a component which decides how the failed reads are retried should be able to do
+type RetryPolicyHandler interface {
+	MaxRetries() uint32
+	Backoff(retry uint32) time.Duration
+	IsInterfaceNil() bool
+}
+
+// HedgingPolicyHandler defines what a component which decides when a duplicate read is sent to another observer
+// should be able to do
+type HedgingPolicyHandler interface {
+	RecordLatency(latency time.Duration)
+	IsEnabled() bool
+	HedgeDelay() time.Duration
+	IsInterfaceNil() bool
+}
+
+// QuorumPolicyHandler defines what a component which decides which reads are answered by a quorum of observers
+// should be able to do
+type QuorumPolicyHandler interface {
+	IsEnabledFor(endpoint string) bool
+	NumObservers() uint32
+	MinAgreeing() uint32
+	IsInterfaceNil() bool
+}
+
+// NodesHttpClientsHandler defines what a component which holds the http clients used for the requests towards the
+// nodes should be able to do
+type NodesHttpClientsHandler interface {
+	GetHttpClient(address string) *http.Client
+	ReloadNodes(nodes []*data.NodeData) error
+	IsInterfaceNil() bool
+}
+
+// ConcurrencyLimiterHandler defines what a component which limits the number of in-flight requests towards each node
+// should be able to do
+type ConcurrencyLimiterHandler interface {
+	Acquire(ctx context.Context, address string) error
+	Release(address string)
+	GetLoadStatus(address string) data.NodeLoadStatus
+	IsInterfaceNil() bool
+}
+
+// RequestsCoalescerHandler defines what a component which lets the identical concurrent requests share a single
+// upstream call should be able to do
+type RequestsCoalescerHandler interface {
+	Do(ctx context.Context, key string, call func() (interface{}, int, error)) (interface{}, int, bool, error)
+	IsInterfaceNil() bool
+}
+
+// RoutingTableHandler defines what a component which maps the request categories to the pools of nodes serving them
+// should be able to do
+type RoutingTableHandler interface {
+	GetRoute(category string) Route
+	IsInCustomPool(pool string, address string) bool
+	IsInterfaceNil() bool
+}
+
+// ApiKeysRegistryHandler defines what a component which holds the API keys and counts their requests should be able
+// to do
+type ApiKeysRegistryHandler interface {
+	GetApiKeyTier(key string) (*data.ApiKeyTier, bool)
+	GetTier(name string) (*data.ApiKeyTier, bool)
+	TryConsumeQuota(key string) bool
+	GetApiKeysUsage() *data.ApiKeysUsageResponse
+	SaveUsage() error
+	IsInterfaceNil() bool
+}
+
+// ApiKeysUsageHandler defines what a component which reports the usage of the API keys should be able to do
+type ApiKeysUsageHandler interface {
+	GetApiKeysUsage() *data.ApiKeysUsageResponse
+	IsInterfaceNil() bool
+}
+
+// MetricsHandler defines what a component which collects the metrics of the proxy's traffic should be able to do
+type MetricsHandler interface {
+	RecordUpstreamRequest(address string, endpoint string, status string, duration time.Duration)
+	RecordCacheAccess(cacheName string, isHit bool)
+	SetHealthyObservers(shardID uint32, numHealthy int)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,19 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+type ApiKeysUsageHandlerStub struct {
+	GetApiKeysUsageCalled func() *data.ApiKeysUsageResponse
+}
+
+func (akuhs *ApiKeysUsageHandlerStub) GetApiKeysUsage() *data.ApiKeysUsageResponse {
+	if akuhs.GetApiKeysUsageCalled != nil {
+		return akuhs.GetApiKeysUsageCalled()
+	}
+
+	return &data.ApiKeysUsageResponse{}
+}
+
+func (akuhs *ApiKeysUsageHandlerStub) IsInterfaceNil() bool {
+	return akuhs == nil
+}
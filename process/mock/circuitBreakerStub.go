@@ -0,0 +1,51 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+type CircuitBreakerStub struct {
+	AllowRequestCalled     func(address string) bool
+	RecordSuccessCalled    func(address string)
+	RecordFailureCalled    func(address string)
+	IsOpenCalled           func(address string) bool
+	GetCircuitStatusCalled func(address string) data.CircuitStatus
+}
+
+func (cbs *CircuitBreakerStub) AllowRequest(address string) bool {
+	if cbs.AllowRequestCalled != nil {
+		return cbs.AllowRequestCalled(address)
+	}
+
+	return true
+}
+
+func (cbs *CircuitBreakerStub) RecordSuccess(address string) {
+	if cbs.RecordSuccessCalled != nil {
+		cbs.RecordSuccessCalled(address)
+	}
+}
+
+func (cbs *CircuitBreakerStub) RecordFailure(address string) {
+	if cbs.RecordFailureCalled != nil {
+		cbs.RecordFailureCalled(address)
+	}
+}
+
+func (cbs *CircuitBreakerStub) IsOpen(address string) bool {
+	if cbs.IsOpenCalled != nil {
+		return cbs.IsOpenCalled(address)
+	}
+
+	return false
+}
+
+func (cbs *CircuitBreakerStub) GetCircuitStatus(address string) data.CircuitStatus {
+	if cbs.GetCircuitStatusCalled != nil {
+		return cbs.GetCircuitStatusCalled(address)
+	}
+
+	return data.CircuitStatus{}
+}
+
+func (cbs *CircuitBreakerStub) IsInterfaceNil() bool {
+	return cbs == nil
+}
@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+type ConcurrencyLimiterStub struct {
+	AcquireCalled       func(ctx context.Context, address string) error
+	ReleaseCalled       func(address string)
+	GetLoadStatusCalled func(address string) data.NodeLoadStatus
+}
+
+func (cls *ConcurrencyLimiterStub) Acquire(ctx context.Context, address string) error {
+	if cls.AcquireCalled != nil {
+		return cls.AcquireCalled(ctx, address)
+	}
+
+	return nil
+}
+
+func (cls *ConcurrencyLimiterStub) Release(address string) {
+	if cls.ReleaseCalled != nil {
+		cls.ReleaseCalled(address)
+	}
+}
+
+func (cls *ConcurrencyLimiterStub) GetLoadStatus(address string) data.NodeLoadStatus {
+	if cls.GetLoadStatusCalled != nil {
+		return cls.GetLoadStatusCalled(address)
+	}
+
+	return data.NodeLoadStatus{}
+}
+
+func (cls *ConcurrencyLimiterStub) IsInterfaceNil() bool {
+	return cls == nil
+}
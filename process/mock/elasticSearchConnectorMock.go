@@ -1,6 +1,10 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 type ElasticSearchConnectorMock struct {
 }
@@ -15,6 +19,11 @@ func (escm *ElasticSearchConnectorMock) GetAtlasBlockByShardIDAndNonce(_ uint32,
 	return data.AtlasBlock{}, nil
 }
 
+// Ping -
+func (escm *ElasticSearchConnectorMock) Ping(_ context.Context) error {
+	return nil
+}
+
 // IsInterfaceNil -
 func (escm *ElasticSearchConnectorMock) IsInterfaceNil() bool {
 	return escm == nil
@@ -1,10 +1,15 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-proxy-go/data"
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
 
 type ExternalStorageConnectorStub struct {
 	GetTransactionsByAddressCalled       func(address string) ([]data.DatabaseTransaction, error)
 	GetAtlasBlockByShardIDAndNonceCalled func(shardID uint32, nonce uint64) (data.AtlasBlock, error)
+	PingCalled                           func(ctx context.Context) error
 }
 
 // GetTransactionsByAddress -
@@ -25,6 +30,15 @@ func (e *ExternalStorageConnectorStub) GetAtlasBlockByShardIDAndNonce(shardID ui
 	return data.AtlasBlock{Hash: "hash"}, nil
 }
 
+// Ping -
+func (e *ExternalStorageConnectorStub) Ping(ctx context.Context) error {
+	if e.PingCalled != nil {
+		return e.PingCalled(ctx)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (e *ExternalStorageConnectorStub) IsInterfaceNil() bool {
 	return e == nil
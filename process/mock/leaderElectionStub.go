@@ -0,0 +1,17 @@
+package mock
+
+type LeaderElectionStub struct {
+	IsLeaderCalled func() bool
+}
+
+func (les *LeaderElectionStub) IsLeader() bool {
+	if les.IsLeaderCalled != nil {
+		return les.IsLeaderCalled()
+	}
+
+	return true
+}
+
+func (les *LeaderElectionStub) IsInterfaceNil() bool {
+	return les == nil
+}
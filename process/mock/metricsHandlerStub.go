@@ -0,0 +1,31 @@
+package mock
+
+import "time"
+
+type MetricsHandlerStub struct {
+	RecordUpstreamRequestCalled func(address string, endpoint string, status string, duration time.Duration)
+	RecordCacheAccessCalled     func(cacheName string, isHit bool)
+	SetHealthyObserversCalled   func(shardID uint32, numHealthy int)
+}
+
+func (mhs *MetricsHandlerStub) RecordUpstreamRequest(address string, endpoint string, status string, duration time.Duration) {
+	if mhs.RecordUpstreamRequestCalled != nil {
+		mhs.RecordUpstreamRequestCalled(address, endpoint, status, duration)
+	}
+}
+
+func (mhs *MetricsHandlerStub) RecordCacheAccess(cacheName string, isHit bool) {
+	if mhs.RecordCacheAccessCalled != nil {
+		mhs.RecordCacheAccessCalled(cacheName, isHit)
+	}
+}
+
+func (mhs *MetricsHandlerStub) SetHealthyObservers(shardID uint32, numHealthy int) {
+	if mhs.SetHealthyObserversCalled != nil {
+		mhs.SetHealthyObserversCalled(shardID, numHealthy)
+	}
+}
+
+func (mhs *MetricsHandlerStub) IsInterfaceNil() bool {
+	return mhs == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+type NetworkConfigGuardStub struct {
+	CheckNodesCalled          func(nodes []*data.NodeData)
+	GetQuarantineReasonCalled func(address string) (string, bool)
+}
+
+func (ncgs *NetworkConfigGuardStub) CheckNodes(nodes []*data.NodeData) {
+	if ncgs.CheckNodesCalled != nil {
+		ncgs.CheckNodesCalled(nodes)
+	}
+}
+
+func (ncgs *NetworkConfigGuardStub) GetQuarantineReason(address string) (string, bool) {
+	if ncgs.GetQuarantineReasonCalled != nil {
+		return ncgs.GetQuarantineReasonCalled(address)
+	}
+
+	return "", false
+}
+
+func (ncgs *NetworkConfigGuardStub) IsInterfaceNil() bool {
+	return ncgs == nil
+}
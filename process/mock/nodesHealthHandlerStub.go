@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// NodesHealthHandlerStub -
+type NodesHealthHandlerStub struct {
+	GetNodesHealthCalled       func() (*data.NodesHealthResponse, error)
+	GetHighestFinalNonceCalled func(shardID uint32) (uint64, bool)
+}
+
+// GetNodesHealth -
+func (nhhs *NodesHealthHandlerStub) GetNodesHealth() (*data.NodesHealthResponse, error) {
+	if nhhs.GetNodesHealthCalled != nil {
+		return nhhs.GetNodesHealthCalled()
+	}
+
+	return &data.NodesHealthResponse{}, nil
+}
+
+// GetHighestFinalNonce -
+func (nhhs *NodesHealthHandlerStub) GetHighestFinalNonce(shardID uint32) (uint64, bool) {
+	if nhhs.GetHighestFinalNonceCalled != nil {
+		return nhhs.GetHighestFinalNonceCalled(shardID)
+	}
+
+	return 0, false
+}
+
+// IsInterfaceNil -
+func (nhhs *NodesHealthHandlerStub) IsInterfaceNil() bool {
+	return nhhs == nil
+}
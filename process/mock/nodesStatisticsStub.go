@@ -0,0 +1,46 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/observer"
+)
+
+type NodesStatisticsStub struct {
+	RequestStartedCalled         func(address string)
+	RequestFinishedCalled        func(address string, duration time.Duration, outcome observer.RequestOutcome)
+	GetOutstandingRequestsCalled func(address string) uint32
+	GetAverageLatencyCalled      func(address string) time.Duration
+}
+
+func (nss *NodesStatisticsStub) RequestStarted(address string) {
+	if nss.RequestStartedCalled != nil {
+		nss.RequestStartedCalled(address)
+	}
+}
+
+func (nss *NodesStatisticsStub) RequestFinished(address string, duration time.Duration, outcome observer.RequestOutcome) {
+	if nss.RequestFinishedCalled != nil {
+		nss.RequestFinishedCalled(address, duration, outcome)
+	}
+}
+
+func (nss *NodesStatisticsStub) GetOutstandingRequests(address string) uint32 {
+	if nss.GetOutstandingRequestsCalled != nil {
+		return nss.GetOutstandingRequestsCalled(address)
+	}
+
+	return 0
+}
+
+func (nss *NodesStatisticsStub) GetAverageLatency(address string) time.Duration {
+	if nss.GetAverageLatencyCalled != nil {
+		return nss.GetAverageLatencyCalled(address)
+	}
+
+	return 0
+}
+
+func (nss *NodesStatisticsStub) IsInterfaceNil() bool {
+	return nss == nil
+}
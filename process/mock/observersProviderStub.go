@@ -5,8 +5,14 @@ import (
 )
 
 type ObserversProviderStub struct {
-	GetNodesByShardIdCalled func(shardId uint32) ([]*data.NodeData, error)
-	GetAllNodesCalled       func() ([]*data.NodeData, error)
+	GetNodesByShardIdCalled         func(shardId uint32) ([]*data.NodeData, error)
+	GetNodesByShardIdForKeyCalled   func(shardId uint32, key string) ([]*data.NodeData, error)
+	GetNodesByShardIdWithTagsCalled func(shardId uint32, key string, requiredTags []string) ([]*data.NodeData, error)
+	GetAllNodesCalled               func() ([]*data.NodeData, error)
+	GetAllNodesWithTagsCalled       func(requiredTags []string) ([]*data.NodeData, error)
+	GetAllConfiguredNodesCalled     func() ([]*data.NodeData, error)
+	UpdateNodesHealthCalled         func(unhealthyNodes map[string]struct{})
+	ReloadNodesCalled               func(nodes []*data.NodeData) error
 }
 
 func (ops *ObserversProviderStub) GetNodesByShardId(shardId uint32) ([]*data.NodeData, error) {
@@ -22,6 +28,23 @@ func (ops *ObserversProviderStub) GetNodesByShardId(shardId uint32) ([]*data.Nod
 	}, nil
 }
 
+func (ops *ObserversProviderStub) GetNodesByShardIdForKey(shardId uint32, key string) ([]*data.NodeData, error) {
+	if ops.GetNodesByShardIdForKeyCalled != nil {
+		return ops.GetNodesByShardIdForKeyCalled(shardId, key)
+	}
+
+	return ops.GetNodesByShardId(shardId)
+}
+
+func (ops *ObserversProviderStub) GetNodesByShardIdWithTags(shardId uint32, key string, requiredTags []string) ([]*data.NodeData, error) {
+	if ops.GetNodesByShardIdWithTagsCalled != nil {
+		return ops.GetNodesByShardIdWithTagsCalled(shardId, key, requiredTags)
+	}
+
+	nodes, err := ops.GetNodesByShardIdForKey(shardId, key)
+	return filterNodesByTags(nodes, err, requiredTags)
+}
+
 func (ops *ObserversProviderStub) GetAllNodes() ([]*data.NodeData, error) {
 	if ops.GetAllNodesCalled != nil {
 		return ops.GetAllNodesCalled()
@@ -35,6 +58,57 @@ func (ops *ObserversProviderStub) GetAllNodes() ([]*data.NodeData, error) {
 	}, nil
 }
 
+func (ops *ObserversProviderStub) GetAllNodesWithTags(requiredTags []string) ([]*data.NodeData, error) {
+	if ops.GetAllNodesWithTagsCalled != nil {
+		return ops.GetAllNodesWithTagsCalled(requiredTags)
+	}
+
+	nodes, err := ops.GetAllNodes()
+	return filterNodesByTags(nodes, err, requiredTags)
+}
+
+func (ops *ObserversProviderStub) GetAllConfiguredNodes() ([]*data.NodeData, error) {
+	if ops.GetAllConfiguredNodesCalled != nil {
+		return ops.GetAllConfiguredNodesCalled()
+	}
+
+	return []*data.NodeData{
+		{
+			Address: "address",
+			ShardId: 0,
+		},
+	}, nil
+}
+
+func (ops *ObserversProviderStub) UpdateNodesHealth(unhealthyNodes map[string]struct{}) {
+	if ops.UpdateNodesHealthCalled != nil {
+		ops.UpdateNodesHealthCalled(unhealthyNodes)
+	}
+}
+
+func (ops *ObserversProviderStub) ReloadNodes(nodes []*data.NodeData) error {
+	if ops.ReloadNodesCalled != nil {
+		return ops.ReloadNodesCalled(nodes)
+	}
+
+	return nil
+}
+
 func (ops *ObserversProviderStub) IsInterfaceNil() bool {
 	return ops == nil
 }
+
+func filterNodesByTags(nodes []*data.NodeData, err error, requiredTags []string) ([]*data.NodeData, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	filteredNodes := make([]*data.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if node.HasTags(requiredTags) {
+			filteredNodes = append(filteredNodes, node)
+		}
+	}
+
+	return filteredNodes, nil
+}
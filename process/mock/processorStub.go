@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
@@ -10,10 +12,13 @@ import (
 )
 
 var errNotImplemented = errors.New("not implemented")
+var errNoAnswer = errors.New("no observer answered")
 
 type ProcessorStub struct {
 	ApplyConfigCalled                    func(cfg *config.Config) error
 	GetObserversCalled                   func(shardId uint32) ([]*data.NodeData, error)
+	GetNodesForRequestCalled             func(ctx context.Context, category string, shardId uint32, key string) ([]*data.NodeData, error)
+	GetAllNodesForRequestCalled          func(category string) ([]*data.NodeData, error)
 	GetAllObserversCalled                func() ([]*data.NodeData, error)
 	GetObserversOnePerShardCalled        func() ([]*data.NodeData, error)
 	GetFullHistoryNodesOnePerShardCalled func() ([]*data.NodeData, error)
@@ -21,8 +26,10 @@ type ProcessorStub struct {
 	GetAllFullHistoryNodesCalled         func() ([]*data.NodeData, error)
 	GetShardIDsCalled                    func() []uint32
 	ComputeShardIdCalled                 func(addressBuff []byte) (uint32, error)
-	CallGetRestEndPointCalled            func(address string, path string, value interface{}) (int, error)
-	CallPostRestEndPointCalled           func(address string, path string, data interface{}, response interface{}) (int, error)
+	CallGetRestEndPointCalled            func(ctx context.Context, address string, path string, value interface{}) (int, error)
+	CallPostRestEndPointCalled           func(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error)
+	ReadFromObserversCalled              func(ctx context.Context, observers []*data.NodeData, read func(ctx context.Context, observer *data.NodeData) (interface{}, int, error), isAnswer func(responseCode int, err error) bool) (*data.ObserverResponse, error)
+	ReadFromObserversWithQuorumCalled    func(ctx context.Context, endpoint string, observers []*data.NodeData, read func(ctx context.Context, observer *data.NodeData) (interface{}, int, error), isAnswer func(responseCode int, err error) bool, digest func(value interface{}) (string, error)) (*data.ObserverResponse, error)
 	GetShardCoordinatorCalled            func() sharding.Coordinator
 	GetPubKeyConverterCalled             func() core.PubkeyConverter
 	GetObserverProviderCalled            func() observer.NodesProviderHandler
@@ -83,6 +90,32 @@ func (ps *ProcessorStub) GetObservers(shardID uint32) ([]*data.NodeData, error)
 	return nil, errNotImplemented
 }
 
+// GetNodesForRequest will call the GetNodesForRequestCalled handler if not nil, otherwise it will return the full
+// history nodes of the shard, if any, or its observers
+func (ps *ProcessorStub) GetNodesForRequest(ctx context.Context, category string, shardID uint32, key string) ([]*data.NodeData, error) {
+	if ps.GetNodesForRequestCalled != nil {
+		return ps.GetNodesForRequestCalled(ctx, category, shardID, key)
+	}
+	if ps.GetFullHistoryNodesCalled != nil {
+		fullHistoryNodes, err := ps.GetFullHistoryNodesCalled(shardID)
+		if err == nil && len(fullHistoryNodes) > 0 {
+			return fullHistoryNodes, nil
+		}
+	}
+
+	return ps.GetObservers(shardID)
+}
+
+// GetAllNodesForRequest will call the GetAllNodesForRequestCalled handler if not nil, otherwise it will return all
+// the observers
+func (ps *ProcessorStub) GetAllNodesForRequest(category string) ([]*data.NodeData, error) {
+	if ps.GetAllNodesForRequestCalled != nil {
+		return ps.GetAllNodesForRequestCalled(category)
+	}
+
+	return ps.GetAllObservers()
+}
+
 // ComputeShardId will call the ComputeShardIdCalled if not nil
 func (ps *ProcessorStub) ComputeShardId(addressBuff []byte) (uint32, error) {
 	if ps.ComputeShardIdCalled != nil {
@@ -93,23 +126,66 @@ func (ps *ProcessorStub) ComputeShardId(addressBuff []byte) (uint32, error) {
 }
 
 // CallGetRestEndPoint will call the CallGetRestEndPointCalled if not nil
-func (ps *ProcessorStub) CallGetRestEndPoint(address string, path string, value interface{}) (int, error) {
+func (ps *ProcessorStub) CallGetRestEndPoint(ctx context.Context, address string, path string, value interface{}) (int, error) {
 	if ps.CallGetRestEndPointCalled != nil {
-		return ps.CallGetRestEndPointCalled(address, path, value)
+		return ps.CallGetRestEndPointCalled(ctx, address, path, value)
 	}
 
 	return 0, errNotImplemented
 }
 
 // CallPostRestEndPoint will call the CallPostRestEndPoint if not nil
-func (ps *ProcessorStub) CallPostRestEndPoint(address string, path string, data interface{}, response interface{}) (int, error) {
+func (ps *ProcessorStub) CallPostRestEndPoint(ctx context.Context, address string, path string, data interface{}, response interface{}) (int, error) {
 	if ps.CallPostRestEndPointCalled != nil {
-		return ps.CallPostRestEndPointCalled(address, path, data, response)
+		return ps.CallPostRestEndPointCalled(ctx, address, path, data, response)
 	}
 
 	return 0, errNotImplemented
 }
 
+// ReadFromObservers will call the ReadFromObserversCalled if not nil, otherwise it will try the observers one after another
+func (ps *ProcessorStub) ReadFromObservers(
+	ctx context.Context,
+	observers []*data.NodeData,
+	read func(ctx context.Context, observer *data.NodeData) (interface{}, int, error),
+	isAnswer func(responseCode int, err error) bool,
+) (*data.ObserverResponse, error) {
+	if ps.ReadFromObserversCalled != nil {
+		return ps.ReadFromObserversCalled(ctx, observers, read, isAnswer)
+	}
+
+	for _, observer := range observers {
+		value, responseCode, err := read(ctx, observer)
+		if isAnswer(responseCode, err) {
+			return &data.ObserverResponse{
+				Observer:   observer,
+				StatusCode: responseCode,
+				Value:      value,
+				Err:        err,
+			}, nil
+		}
+	}
+
+	return nil, errNoAnswer
+}
+
+// ReadFromObserversWithQuorum will call the ReadFromObserversWithQuorumCalled if not nil, otherwise it will read as
+// ReadFromObservers does
+func (ps *ProcessorStub) ReadFromObserversWithQuorum(
+	ctx context.Context,
+	endpoint string,
+	observers []*data.NodeData,
+	read func(ctx context.Context, observer *data.NodeData) (interface{}, int, error),
+	isAnswer func(responseCode int, err error) bool,
+	digest func(value interface{}) (string, error),
+) (*data.ObserverResponse, error) {
+	if ps.ReadFromObserversWithQuorumCalled != nil {
+		return ps.ReadFromObserversWithQuorumCalled(ctx, endpoint, observers, read, isAnswer, digest)
+	}
+
+	return ps.ReadFromObservers(ctx, observers, read, isAnswer)
+}
+
 // GetShardIDs will call the GetShardIDsCalled if not nil
 func (ps *ProcessorStub) GetShardIDs() []uint32 {
 	if ps.GetShardIDsCalled != nil {
@@ -0,0 +1,46 @@
+package mock
+
+import "time"
+
+type SharedStoreStub struct {
+	GetCalled            func(key string) ([]byte, bool, error)
+	SetCalled            func(key string, value []byte, ttl time.Duration) error
+	SetIfNotExistsCalled func(key string, value []byte, ttl time.Duration) (bool, error)
+	ExpireIfEqualCalled  func(key string, value []byte, ttl time.Duration) (bool, error)
+}
+
+func (sss *SharedStoreStub) Get(key string) ([]byte, bool, error) {
+	if sss.GetCalled != nil {
+		return sss.GetCalled(key)
+	}
+
+	return nil, false, nil
+}
+
+func (sss *SharedStoreStub) Set(key string, value []byte, ttl time.Duration) error {
+	if sss.SetCalled != nil {
+		return sss.SetCalled(key, value, ttl)
+	}
+
+	return nil
+}
+
+func (sss *SharedStoreStub) SetIfNotExists(key string, value []byte, ttl time.Duration) (bool, error) {
+	if sss.SetIfNotExistsCalled != nil {
+		return sss.SetIfNotExistsCalled(key, value, ttl)
+	}
+
+	return false, nil
+}
+
+func (sss *SharedStoreStub) ExpireIfEqual(key string, value []byte, ttl time.Duration) (bool, error) {
+	if sss.ExpireIfEqualCalled != nil {
+		return sss.ExpireIfEqualCalled(key, value, ttl)
+	}
+
+	return false, nil
+}
+
+func (sss *SharedStoreStub) IsInterfaceNil() bool {
+	return sss == nil
+}
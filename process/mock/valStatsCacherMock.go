@@ -12,12 +12,12 @@ type ValStatsCacherMock struct {
 }
 
 // LoadValStats --
-func (vscm *ValStatsCacherMock) LoadValStats() (map[string]*data.ValidatorApiResponse, error) {
+func (vscm *ValStatsCacherMock) LoadValStats() (*data.ValidatorStatisticsResponse, error) {
 	if vscm.Data == nil {
 		return nil, errors.New("nil Data")
 	}
 
-	return vscm.Data, nil
+	return &data.ValidatorStatisticsResponse{Statistics: vscm.Data}, nil
 }
 
 // StoreValStats --
@@ -0,0 +1,213 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// networkConsistencyCheckEndpoint is the endpoint name of the requests sent for checking the nodes' network config
+const networkConsistencyCheckEndpoint = "network-consistency-check"
+
+// networkConfig holds the values of a node's /network/config response which should be the same on all the nodes
+type networkConfig struct {
+	chainID      string
+	numShards    uint32
+	minTxVersion uint32
+}
+
+// ArgsNetworkConfigGuard holds the arguments needed for creating a new NetworkConfigGuard
+type ArgsNetworkConfigGuard struct {
+	Processor       Processor
+	ExpectedChainID string
+}
+
+// NetworkConfigGuard fetches the network config of the observers and of the full history nodes and quarantines the
+// nodes which report another chain ID, number of shards or minimum transaction version than the others. The chain ID
+// is compared against the expected one, if provided, while the other values are compared against the ones reported
+// by most of the nodes. A quarantined node is released as soon as it reports a consistent network config again
+type NetworkConfigGuard struct {
+	proc            Processor
+	expectedChainID string
+	mutNodes        sync.RWMutex
+	configs         map[string]networkConfig
+	quarantined     map[string]string
+}
+
+// NewNetworkConfigGuard creates a new instance of NetworkConfigGuard
+func NewNetworkConfigGuard(args ArgsNetworkConfigGuard) (*NetworkConfigGuard, error) {
+	if check.IfNil(args.Processor) {
+		return nil, ErrNilCoreProcessor
+	}
+
+	return &NetworkConfigGuard{
+		proc:            args.Processor,
+		expectedChainID: args.ExpectedChainID,
+		configs:         make(map[string]networkConfig),
+		quarantined:     make(map[string]string),
+	}, nil
+}
+
+// CheckNodes fetches the network config of the given nodes and updates the quarantined ones. A node which does not
+// answer keeps its last known network config, while the nodes which are not given anymore are forgotten
+func (ncg *NetworkConfigGuard) CheckNodes(nodes []*data.NodeData) {
+	addresses := make(map[string]struct{})
+	for _, node := range nodes {
+		addresses[node.Address] = struct{}{}
+	}
+
+	fetchedConfigs := ncg.fetchNetworkConfigs(addresses)
+
+	ncg.mutNodes.Lock()
+	defer ncg.mutNodes.Unlock()
+
+	for address := range ncg.configs {
+		if _, found := addresses[address]; !found {
+			delete(ncg.configs, address)
+			delete(ncg.quarantined, address)
+		}
+	}
+	for address, config := range fetchedConfigs {
+		ncg.configs[address] = config
+	}
+
+	reference := ncg.computeReferenceConfig()
+	for address, config := range ncg.configs {
+		ncg.updateQuarantine(address, checkNetworkConfig(config, reference))
+	}
+}
+
+func (ncg *NetworkConfigGuard) fetchNetworkConfigs(addresses map[string]struct{}) map[string]networkConfig {
+	mutConfigs := sync.Mutex{}
+	configs := make(map[string]networkConfig)
+	wg := &sync.WaitGroup{}
+	wg.Add(len(addresses))
+	for address := range addresses {
+		go func(address string) {
+			defer wg.Done()
+
+			config, err := ncg.fetchNetworkConfig(address)
+			if err != nil {
+				log.Debug("network config request", "address", address, "error", err.Error())
+				return
+			}
+
+			mutConfigs.Lock()
+			configs[address] = config
+			mutConfigs.Unlock()
+		}(address)
+	}
+	wg.Wait()
+
+	return configs
+}
+
+func (ncg *NetworkConfigGuard) fetchNetworkConfig(address string) (networkConfig, error) {
+	var response data.GenericAPIResponse
+	ctx := data.WithEndpointName(context.Background(), networkConsistencyCheckEndpoint)
+	_, err := ncg.proc.CallGetRestEndPoint(ctx, address, NetworkConfigPath, &response)
+	if err != nil {
+		return networkConfig{}, err
+	}
+
+	return parseNetworkConfig(&response)
+}
+
+func parseNetworkConfig(response *data.GenericAPIResponse) (networkConfig, error) {
+	responseData, ok := response.Data.(map[string]interface{})
+	if !ok {
+		return networkConfig{}, ErrNetworkConfigNotReported
+	}
+	configMetrics, ok := responseData["config"].(map[string]interface{})
+	if !ok {
+		return networkConfig{}, ErrNetworkConfigNotReported
+	}
+	chainID, ok := configMetrics[core.MetricChainId].(string)
+	if !ok || len(chainID) == 0 {
+		return networkConfig{}, ErrNetworkConfigNotReported
+	}
+
+	return networkConfig{
+		chainID:      chainID,
+		numShards:    uint32(getUint(configMetrics[core.MetricNumShardsWithoutMetacahin])),
+		minTxVersion: uint32(getUint(configMetrics[core.MetricMinTransactionVersion])),
+	}, nil
+}
+
+// computeReferenceConfig should be called under mutex protection
+func (ncg *NetworkConfigGuard) computeReferenceConfig() networkConfig {
+	chainIDs := make(map[string]int)
+	numShards := make(map[uint32]int)
+	minTxVersions := make(map[uint32]int)
+	for _, config := range ncg.configs {
+		chainIDs[config.chainID]++
+		numShards[config.numShards]++
+		minTxVersions[config.minTxVersion]++
+	}
+
+	reference := networkConfig{
+		chainID:      ncg.expectedChainID,
+		numShards:    mostReportedUint32(numShards),
+		minTxVersion: mostReportedUint32(minTxVersions),
+	}
+	if len(reference.chainID) == 0 {
+		reference.chainID = mostReportedString(chainIDs)
+	}
+
+	return reference
+}
+
+func checkNetworkConfig(config networkConfig, reference networkConfig) error {
+	if config.chainID != reference.chainID {
+		return fmt.Errorf("%w: the node reports chain ID %s instead of %s",
+			ErrInconsistentNetworkConfig, config.chainID, reference.chainID)
+	}
+	if config.numShards != reference.numShards {
+		return fmt.Errorf("%w: the node reports %d shards instead of %d",
+			ErrInconsistentNetworkConfig, config.numShards, reference.numShards)
+	}
+	if config.minTxVersion != reference.minTxVersion {
+		return fmt.Errorf("%w: the node reports the minimum transaction version %d instead of %d",
+			ErrInconsistentNetworkConfig, config.minTxVersion, reference.minTxVersion)
+	}
+
+	return nil
+}
+
+// updateQuarantine should be called under mutex protection
+func (ncg *NetworkConfigGuard) updateQuarantine(address string, checkErr error) {
+	reason, isQuarantined := ncg.quarantined[address]
+	if checkErr == nil {
+		if isQuarantined {
+			log.Info("node released from quarantine", "address", address)
+			delete(ncg.quarantined, address)
+		}
+		return
+	}
+
+	if isQuarantined && reason == checkErr.Error() {
+		return
+	}
+
+	log.Error("node quarantined", "address", address, "error", checkErr.Error())
+	ncg.quarantined[address] = checkErr.Error()
+}
+
+// GetQuarantineReason returns the reason for which the given node is quarantined and true, or false if the node is
+// not quarantined
+func (ncg *NetworkConfigGuard) GetQuarantineReason(address string) (string, bool) {
+	ncg.mutNodes.RLock()
+	defer ncg.mutNodes.RUnlock()
+
+	reason, isQuarantined := ncg.quarantined[address]
+	return reason, isQuarantined
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncg *NetworkConfigGuard) IsInterfaceNil() bool {
+	return ncg == nil
+}
@@ -0,0 +1,159 @@
+package process_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type testNetworkConfig struct {
+	chainID      string
+	numShards    uint32
+	minTxVersion uint32
+}
+
+func createProcessorStubWithNetworkConfigs(mutConfigs *sync.RWMutex, configs map[string]testNetworkConfig) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
+			mutConfigs.RLock()
+			config, found := configs[address]
+			mutConfigs.RUnlock()
+			if !found {
+				return 0, errors.New("connection refused")
+			}
+
+			response := data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						core.MetricChainId:                   config.chainID,
+						core.MetricNumShardsWithoutMetacahin: config.numShards,
+						core.MetricMinTransactionVersion:     config.minTxVersion,
+					},
+				},
+			}
+			responseBytes, _ := json.Marshal(response)
+
+			return 200, json.Unmarshal(responseBytes, value)
+		},
+	}
+}
+
+func TestNewNetworkConfigGuard_NilProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ncg, err := process.NewNetworkConfigGuard(process.ArgsNetworkConfigGuard{})
+
+	assert.True(t, check.IfNil(ncg))
+	assert.Equal(t, process.ErrNilCoreProcessor, err)
+}
+
+func TestNewNetworkConfigGuard_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ncg, err := process.NewNetworkConfigGuard(process.ArgsNetworkConfigGuard{Processor: &mock.ProcessorStub{}})
+
+	assert.False(t, check.IfNil(ncg))
+	assert.Nil(t, err)
+}
+
+func TestNetworkConfigGuard_CheckNodesShouldQuarantineTheInconsistentNodes(t *testing.T) {
+	t.Parallel()
+
+	configs := map[string]testNetworkConfig{
+		"node0": {chainID: "1", numShards: 3, minTxVersion: 1},
+		"node1": {chainID: "1", numShards: 3, minTxVersion: 1},
+		"node2": {chainID: "1", numShards: 3, minTxVersion: 1},
+		"node3": {chainID: "T", numShards: 3, minTxVersion: 1},
+		"node4": {chainID: "1", numShards: 2, minTxVersion: 1},
+		"node5": {chainID: "1", numShards: 3, minTxVersion: 2},
+	}
+	args := process.ArgsNetworkConfigGuard{
+		Processor: createProcessorStubWithNetworkConfigs(&sync.RWMutex{}, configs),
+	}
+	ncg, _ := process.NewNetworkConfigGuard(args)
+
+	nodes := []*data.NodeData{
+		{Address: "node0"}, {Address: "node1"}, {Address: "node2"},
+		{Address: "node3"}, {Address: "node4"}, {Address: "node5"},
+	}
+	ncg.CheckNodes(nodes)
+
+	for _, address := range []string{"node0", "node1", "node2"} {
+		_, isQuarantined := ncg.GetQuarantineReason(address)
+		assert.False(t, isQuarantined, address)
+	}
+	for _, address := range []string{"node3", "node4", "node5"} {
+		reason, isQuarantined := ncg.GetQuarantineReason(address)
+		assert.True(t, isQuarantined, address)
+		assert.True(t, strings.Contains(reason, process.ErrInconsistentNetworkConfig.Error()))
+	}
+}
+
+func TestNetworkConfigGuard_CheckNodesShouldUseTheExpectedChainID(t *testing.T) {
+	t.Parallel()
+
+	configs := map[string]testNetworkConfig{
+		"node0": {chainID: "T", numShards: 3, minTxVersion: 1},
+		"node1": {chainID: "T", numShards: 3, minTxVersion: 1},
+		"node2": {chainID: "1", numShards: 3, minTxVersion: 1},
+	}
+	args := process.ArgsNetworkConfigGuard{
+		Processor:       createProcessorStubWithNetworkConfigs(&sync.RWMutex{}, configs),
+		ExpectedChainID: "1",
+	}
+	ncg, _ := process.NewNetworkConfigGuard(args)
+
+	ncg.CheckNodes([]*data.NodeData{{Address: "node0"}, {Address: "node1"}, {Address: "node2"}})
+
+	_, isQuarantined := ncg.GetQuarantineReason("node0")
+	assert.True(t, isQuarantined)
+	_, isQuarantined = ncg.GetQuarantineReason("node1")
+	assert.True(t, isQuarantined)
+	_, isQuarantined = ncg.GetQuarantineReason("node2")
+	assert.False(t, isQuarantined)
+}
+
+func TestNetworkConfigGuard_CheckNodesShouldReleaseTheNodesWhichBecameConsistent(t *testing.T) {
+	t.Parallel()
+
+	mutConfigs := &sync.RWMutex{}
+	configs := map[string]testNetworkConfig{
+		"node0": {chainID: "1", numShards: 3, minTxVersion: 1},
+		"node1": {chainID: "1", numShards: 3, minTxVersion: 1},
+		"node2": {chainID: "T", numShards: 3, minTxVersion: 1},
+	}
+	args := process.ArgsNetworkConfigGuard{
+		Processor: createProcessorStubWithNetworkConfigs(mutConfigs, configs),
+	}
+	ncg, _ := process.NewNetworkConfigGuard(args)
+	nodes := []*data.NodeData{{Address: "node0"}, {Address: "node1"}, {Address: "node2"}}
+
+	ncg.CheckNodes(nodes)
+	_, isQuarantined := ncg.GetQuarantineReason("node2")
+	assert.True(t, isQuarantined)
+
+	// a node which does not answer keeps its last known network config
+	mutConfigs.Lock()
+	delete(configs, "node2")
+	mutConfigs.Unlock()
+	ncg.CheckNodes(nodes)
+	_, isQuarantined = ncg.GetQuarantineReason("node2")
+	assert.True(t, isQuarantined)
+
+	mutConfigs.Lock()
+	configs["node2"] = testNetworkConfig{chainID: "1", numShards: 3, minTxVersion: 1}
+	mutConfigs.Unlock()
+	ncg.CheckNodes(nodes)
+	_, isQuarantined = ncg.GetQuarantineReason("node2")
+	assert.False(t, isQuarantined)
+}
@@ -0,0 +1,73 @@
+package process
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// nodeStatusMetrics holds the metrics of a node's /node/status response used for checking the node
+type nodeStatusMetrics struct {
+	nonce                uint64
+	hasNonce             bool
+	highestFinalNonce    uint64
+	hasHighestFinalNonce bool
+	shardID              uint32
+	hasShardID           bool
+	numShards            uint32
+	hasNumShards         bool
+}
+
+func parseNodeStatusMetrics(response *data.GenericAPIResponse) nodeStatusMetrics {
+	metrics := nodeStatusMetrics{}
+
+	nonce, ok := getMetric(response.Data, core.MetricNonce)
+	if ok {
+		metrics.nonce = getUint(nonce)
+		metrics.hasNonce = true
+	}
+
+	highestFinalNonce, ok := getMetric(response.Data, core.MetricHighestFinalBlock)
+	if ok {
+		metrics.highestFinalNonce = getUint(highestFinalNonce)
+		metrics.hasHighestFinalNonce = true
+	}
+
+	shardID, ok := getMetric(response.Data, core.MetricShardId)
+	if ok {
+		metrics.shardID = uint32(getUint(shardID))
+		metrics.hasShardID = true
+	}
+
+	numShards, ok := getMetric(response.Data, core.MetricNumShardsWithoutMetacahin)
+	if ok {
+		metrics.numShards = uint32(getUint(numShards))
+		metrics.hasNumShards = true
+	}
+
+	return metrics
+}
+
+// mostReportedUint32 returns the value with the highest count. On a tie, the smallest value is chosen, so the outcome
+// does not depend on the map's iteration order
+func mostReportedUint32(counts map[uint32]int) uint32 {
+	mostReported, highestCount := uint32(0), 0
+	for value, count := range counts {
+		if count > highestCount || (count == highestCount && value < mostReported) {
+			mostReported, highestCount = value, count
+		}
+	}
+
+	return mostReported
+}
+
+// mostReportedString returns the value with the highest count. On a tie, the lexicographically smallest value is chosen
+func mostReportedString(counts map[string]int) string {
+	mostReported, highestCount := "", 0
+	for value, count := range counts {
+		if count > highestCount || (count == highestCount && value < mostReported) {
+			mostReported, highestCount = value, count
+		}
+	}
+
+	return mostReported
+}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"math"
 	"strconv"
@@ -25,23 +26,47 @@ const NodeStatusPath = "/node/status"
 
 // NodeStatusProcessor handles the action needed for fetching data related to status metrics from nodes
 type NodeStatusProcessor struct {
-	proc Processor
+	proc                Processor
+	nodesHealthHandler  NodesHealthHandler
+	apiKeysUsageHandler ApiKeysUsageHandler
 }
 
 // NewNodeStatusProcessor creates a new instance of NodeStatusProcessor
-func NewNodeStatusProcessor(processor Processor) (*NodeStatusProcessor, error) {
+func NewNodeStatusProcessor(
+	processor Processor,
+	nodesHealthHandler NodesHealthHandler,
+	apiKeysUsageHandler ApiKeysUsageHandler,
+) (*NodeStatusProcessor, error) {
 	if check.IfNil(processor) {
 		return nil, ErrNilCoreProcessor
 	}
+	if check.IfNil(nodesHealthHandler) {
+		return nil, ErrNilNodesHealthHandler
+	}
+	if check.IfNil(apiKeysUsageHandler) {
+		return nil, ErrNilApiKeysUsageHandler
+	}
 
 	return &NodeStatusProcessor{
-		proc: processor,
+		proc:                processor,
+		nodesHealthHandler:  nodesHealthHandler,
+		apiKeysUsageHandler: apiKeysUsageHandler,
 	}, nil
 }
 
+// GetNodesHealth returns the health state of the observers and full history nodes, as seen by the proxy
+func (nsp *NodeStatusProcessor) GetNodesHealth() (*data.NodesHealthResponse, error) {
+	return nsp.nodesHealthHandler.GetNodesHealth()
+}
+
+// GetApiKeysUsage returns the usage counters of the API keys
+func (nsp *NodeStatusProcessor) GetApiKeysUsage() *data.ApiKeysUsageResponse {
+	return nsp.apiKeysUsageHandler.GetApiKeysUsage()
+}
+
 // GetNetworkStatusMetrics will simply forward the network status metrics from an observer in the given shard
-func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
-	observers, err := nsp.proc.GetObservers(shardID)
+func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
+	observers, err := nsp.proc.GetNodesForRequest(ctx, RequestCategoryNetwork, shardID, "")
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +74,7 @@ func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.G
 	for _, observer := range observers {
 		var responseNetworkMetrics *data.GenericAPIResponse
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, NetworkStatusPath, &responseNetworkMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkStatusPath, &responseNetworkMetrics)
 		if err != nil {
 			log.Error("network metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -64,8 +89,8 @@ func (nsp *NodeStatusProcessor) GetNetworkStatusMetrics(shardID uint32) (*data.G
 }
 
 // GetNetworkConfigMetrics will simply forward the network config metrics from an observer in the given shard
-func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
-	observers, err := nsp.proc.GetAllObservers()
+func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	observers, err := nsp.proc.GetAllNodesForRequest(RequestCategoryNetwork)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +98,7 @@ func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIRespo
 	for _, observer := range observers {
 		var responseNetworkMetrics *data.GenericAPIResponse
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, NetworkConfigPath, &responseNetworkMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NetworkConfigPath, &responseNetworkMetrics)
 		if err != nil {
 			log.Error("network metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -88,13 +113,13 @@ func (nsp *NodeStatusProcessor) GetNetworkConfigMetrics() (*data.GenericAPIRespo
 }
 
 // GetNetworkConfigMetrics will simply forward the network config metrics from an observer in the given shard
-func (nsp *NodeStatusProcessor) GetEconomicsDataMetrics() (*data.GenericAPIResponse, error) {
-	metaObservers, err := nsp.proc.GetObservers(core.MetachainShardId)
+func (nsp *NodeStatusProcessor) GetEconomicsDataMetrics(ctx context.Context) (*data.GenericAPIResponse, error) {
+	metaObservers, err := nsp.proc.GetNodesForRequest(ctx, RequestCategoryNetwork, core.MetachainShardId, "")
 	if err != nil {
 		return nil, err
 	}
 
-	metaResponse, err := nsp.getEconomicsDataMetrics(metaObservers)
+	metaResponse, err := nsp.getEconomicsDataMetrics(ctx, metaObservers)
 	if err == nil {
 		return metaResponse, nil
 	}
@@ -102,19 +127,19 @@ func (nsp *NodeStatusProcessor) GetEconomicsDataMetrics() (*data.GenericAPIRespo
 	log.Warn("cannot get economics data metrics from metachain observer. will try with all observers",
 		"error", err)
 
-	allObservers, err := nsp.proc.GetAllObservers()
+	allObservers, err := nsp.proc.GetAllNodesForRequest(RequestCategoryNetwork)
 	if err != nil {
 		return nil, err
 	}
 
-	return nsp.getEconomicsDataMetrics(allObservers)
+	return nsp.getEconomicsDataMetrics(ctx, allObservers)
 }
 
-func (nsp *NodeStatusProcessor) getEconomicsDataMetrics(observers []*data.NodeData) (*data.GenericAPIResponse, error) {
+func (nsp *NodeStatusProcessor) getEconomicsDataMetrics(ctx context.Context, observers []*data.NodeData) (*data.GenericAPIResponse, error) {
 	for _, observer := range observers {
 		var responseNetworkMetrics *data.GenericAPIResponse
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, EconomicsDataPath, &responseNetworkMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, EconomicsDataPath, &responseNetworkMetrics)
 		if err != nil {
 			log.Error("economics data request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -127,8 +152,8 @@ func (nsp *NodeStatusProcessor) getEconomicsDataMetrics(observers []*data.NodeDa
 	return nil, ErrSendingRequest
 }
 
-func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error) {
-	observers, err := nsp.proc.GetObservers(shardID)
+func (nsp *NodeStatusProcessor) getNodeStatusMetrics(ctx context.Context, shardID uint32) (*data.GenericAPIResponse, error) {
+	observers, err := nsp.proc.GetNodesForRequest(ctx, RequestCategoryNetwork, shardID, "")
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +161,7 @@ func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.Gene
 	for _, observer := range observers {
 		var responseNetworkMetrics *data.GenericAPIResponse
 
-		_, err := nsp.proc.CallGetRestEndPoint(observer.Address, NodeStatusPath, &responseNetworkMetrics)
+		_, err := nsp.proc.CallGetRestEndPoint(ctx, observer.Address, NodeStatusPath, &responseNetworkMetrics)
 		if err != nil {
 			log.Error("node status metrics request", "observer", observer.Address, "error", err.Error())
 			continue
@@ -151,7 +176,7 @@ func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.Gene
 }
 
 // GetLatestFullySynchronizedHyperblockNonce will compute nonce of the latest hyperblock that can be returned
-func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error) {
 	shardsIDs, err := nsp.getShardsIDs()
 	if err != nil {
 		return 0, err
@@ -159,7 +184,7 @@ func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uin
 
 	nonces := make([]uint64, 0)
 	for shardID := range shardsIDs {
-		nodeStatusResponse, err := nsp.getNodeStatusMetrics(shardID)
+		nodeStatusResponse, err := nsp.getNodeStatusMetrics(ctx, shardID)
 		if err != nil {
 			return 0, err
 		}
@@ -198,7 +223,7 @@ func getMinNonce(noncesSlice []uint64) uint64 {
 }
 
 func (nsp *NodeStatusProcessor) getShardsIDs() (map[uint32]struct{}, error) {
-	observers, err := nsp.proc.GetAllObservers()
+	observers, err := nsp.proc.GetAllNodesForRequest(RequestCategoryNetwork)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"testing"
@@ -14,12 +15,67 @@ import (
 func TestNewNodeStatusProcessor_NilBaseProcessor(t *testing.T) {
 	t.Parallel()
 
-	nodeStatusProc, err := NewNodeStatusProcessor(nil)
+	nodeStatusProc, err := NewNodeStatusProcessor(nil, &mock.NodesHealthHandlerStub{}, &mock.ApiKeysUsageHandlerStub{})
 
 	require.Equal(t, ErrNilCoreProcessor, err)
 	require.Nil(t, nodeStatusProc)
 }
 
+func TestNewNodeStatusProcessor_NilNodesHealthHandler(t *testing.T) {
+	t.Parallel()
+
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, nil, &mock.ApiKeysUsageHandlerStub{})
+
+	require.Equal(t, ErrNilNodesHealthHandler, err)
+	require.Nil(t, nodeStatusProc)
+}
+
+func TestNewNodeStatusProcessor_NilApiKeysUsageHandler(t *testing.T) {
+	t.Parallel()
+
+	nodeStatusProc, err := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.NodesHealthHandlerStub{}, nil)
+
+	require.Equal(t, ErrNilApiKeysUsageHandler, err)
+	require.Nil(t, nodeStatusProc)
+}
+
+func TestNodeStatusProcessor_GetNodesHealthShouldForwardTheHandlerResponse(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &data.NodesHealthResponse{
+		Observers: []*data.NodeHealthStatus{
+			{Address: "address1", ShardId: 0, IsHealthy: false, ConsecutiveFailures: 3},
+		},
+	}
+	nodeStatusProc, _ := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.NodesHealthHandlerStub{
+		GetNodesHealthCalled: func() (*data.NodesHealthResponse, error) {
+			return expectedResponse, nil
+		},
+	}, &mock.ApiKeysUsageHandlerStub{})
+
+	response, err := nodeStatusProc.GetNodesHealth()
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, response)
+}
+
+func TestNodeStatusProcessor_GetApiKeysUsageShouldForwardTheHandlerResponse(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &data.ApiKeysUsageResponse{
+		Day: "2021-03-04",
+		Keys: []*data.ApiKeyUsage{
+			{Key: "abcd****", Owner: "owner", Tier: "basic", RequestsToday: 5, DailyQuota: 100, TotalRequests: 20},
+		},
+	}
+	nodeStatusProc, _ := NewNodeStatusProcessor(&mock.ProcessorStub{}, &mock.NodesHealthHandlerStub{}, &mock.ApiKeysUsageHandlerStub{
+		GetApiKeysUsageCalled: func() *data.ApiKeysUsageResponse {
+			return expectedResponse
+		},
+	})
+
+	require.Equal(t, expectedResponse, nodeStatusProc.GetApiKeysUsage())
+}
+
 func TestNodeStatusProcessor_GetConfigMetricsGetRestEndPointError(t *testing.T) {
 	t.Parallel()
 
@@ -30,12 +86,12 @@ func TestNodeStatusProcessor_GetConfigMetricsGetRestEndPointError(t *testing.T)
 				{Address: "address1", ShardId: 0},
 			}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			return 0, localErr
 		},
-	})
+	}, &mock.NodesHealthHandlerStub{}, &mock.ApiKeysUsageHandlerStub{})
 
-	status, err := nodeStatusProc.GetNetworkConfigMetrics()
+	status, err := nodeStatusProc.GetNetworkConfigMetrics(context.Background())
 	require.Equal(t, ErrSendingRequest, err)
 	require.Nil(t, status)
 }
@@ -49,7 +105,7 @@ func TestNodeStatusProcessor_GetConfigMetrics(t *testing.T) {
 				{Address: "address1", ShardId: 0},
 			}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			localMap := map[string]interface{}{
 				"key": 1,
 			}
@@ -58,9 +114,9 @@ func TestNodeStatusProcessor_GetConfigMetrics(t *testing.T) {
 
 			return 0, json.Unmarshal(genRespBytes, value)
 		},
-	})
+	}, &mock.NodesHealthHandlerStub{}, &mock.ApiKeysUsageHandlerStub{})
 
-	genericResponse, err := nodeStatusProc.GetNetworkConfigMetrics()
+	genericResponse, err := nodeStatusProc.GetNetworkConfigMetrics(context.Background())
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -81,9 +137,9 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetObserversFailedShouldErr(t *tes
 		GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, err error) {
 			return nil, localErr
 		},
-	})
+	}, &mock.NodesHealthHandlerStub{}, &mock.ApiKeysUsageHandlerStub{})
 
-	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	status, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Equal(t, localErr, err)
 	require.Nil(t, status)
 }
@@ -98,12 +154,12 @@ func TestNodeStatusProcessor_GetNetworkMetricsGetRestEndPointError(t *testing.T)
 				{Address: "address1", ShardId: 0},
 			}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			return 0, localErr
 		},
-	})
+	}, &mock.NodesHealthHandlerStub{}, &mock.ApiKeysUsageHandlerStub{})
 
-	status, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	status, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Equal(t, ErrSendingRequest, err)
 	require.Nil(t, status)
 }
@@ -117,7 +173,7 @@ func TestNodeStatusProcessor_GetNetworkMetrics(t *testing.T) {
 				{Address: "address1", ShardId: 0},
 			}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			localMap := map[string]interface{}{
 				"key": 1,
 			}
@@ -126,9 +182,9 @@ func TestNodeStatusProcessor_GetNetworkMetrics(t *testing.T) {
 
 			return 0, json.Unmarshal(genRespBytes, value)
 		},
-	})
+	}, &mock.NodesHealthHandlerStub{}, &mock.ApiKeysUsageHandlerStub{})
 
-	genericResponse, err := nodeStatusProc.GetNetworkStatusMetrics(0)
+	genericResponse, err := nodeStatusProc.GetNetworkStatusMetrics(context.Background(), 0)
 	require.Nil(t, err)
 	require.NotNil(t, genericResponse)
 
@@ -163,7 +219,7 @@ func TestNodeStatusProcessor_GetLatestBlockNonce(t *testing.T) {
 			}
 		},
 
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 
 			var localMap map[string]interface{}
 			if address == "address1" {
@@ -185,9 +241,9 @@ func TestNodeStatusProcessor_GetLatestBlockNonce(t *testing.T) {
 
 			return 0, json.Unmarshal(genRespBytes, value)
 		},
-	})
+	}, &mock.NodesHealthHandlerStub{}, &mock.ApiKeysUsageHandlerStub{})
 
-	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
+	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, uint64(122), nonce)
 }
@@ -207,7 +263,7 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsGetRestEndPointErrorOnMetaSh
 				{Address: addressMeta, ShardId: core.MetachainShardId},
 			}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			if address == addressMeta {
 				return 0, localErr
 			}
@@ -216,9 +272,9 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsGetRestEndPointErrorOnMetaSh
 			}
 			return 200, nil
 		},
-	})
+	}, &mock.NodesHealthHandlerStub{}, &mock.ApiKeysUsageHandlerStub{})
 
-	_, err := nodeStatusProc.GetEconomicsDataMetrics()
+	_, err := nodeStatusProc.GetEconomicsDataMetrics(context.Background())
 	require.NoError(t, err)
 	require.True(t, shardNodeWasCalled)
 }
@@ -239,13 +295,13 @@ func TestNodeStatusProcessor_GetEconomicsDataMetricsShouldWork(t *testing.T) {
 				{Address: addressMeta, ShardId: core.MetachainShardId},
 			}, nil
 		},
-		CallGetRestEndPointCalled: func(_ string, _ string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, _ string, _ string, value interface{}) (int, error) {
 			expectedResponseBytes, _ := json.Marshal(expectedResponse)
 			return 200, json.Unmarshal(expectedResponseBytes, value)
 		},
-	})
+	}, &mock.NodesHealthHandlerStub{}, &mock.ApiKeysUsageHandlerStub{})
 
-	actualResponse, err := nodeStatusProc.GetEconomicsDataMetrics()
+	actualResponse, err := nodeStatusProc.GetEconomicsDataMetrics(context.Background())
 	require.NoError(t, err)
 	require.Equal(t, *expectedResponse, *actualResponse)
 }
@@ -0,0 +1,176 @@
+package process
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+const (
+	// CircuitClosed is the state of a circuit which lets all the requests pass
+	CircuitClosed = "closed"
+	// CircuitOpen is the state of a circuit which rejects all the requests until the cool-down period elapses
+	CircuitOpen = "open"
+	// CircuitHalfOpen is the state of a circuit which lets a single trial request pass
+	CircuitHalfOpen = "half-open"
+)
+
+type circuit struct {
+	state               string
+	consecutiveFailures uint32
+	openedAt            time.Time
+	isTrialInProgress   bool
+	trialStartedAt      time.Time
+	timesOpened         uint32
+}
+
+// ArgsNodesCircuitBreaker holds the arguments needed for creating a new NodesCircuitBreaker
+type ArgsNodesCircuitBreaker struct {
+	FailureThreshold uint32
+	CoolDown         time.Duration
+}
+
+// NodesCircuitBreaker keeps a circuit for each node address. A circuit opens after FailureThreshold consecutive
+// failed requests and rejects all the requests until the cool-down period elapses. Afterwards, it becomes half-open
+// and lets a single trial request pass: if it succeeds, the circuit closes, otherwise it opens again. A trial request
+// whose outcome is not recorded within a cool-down period is replaced by a new one
+type NodesCircuitBreaker struct {
+	failureThreshold uint32
+	coolDown         time.Duration
+	mutCircuits      sync.Mutex
+	circuits         map[string]*circuit
+}
+
+// NewNodesCircuitBreaker creates a new instance of NodesCircuitBreaker
+func NewNodesCircuitBreaker(args ArgsNodesCircuitBreaker) (*NodesCircuitBreaker, error) {
+	if args.FailureThreshold == 0 {
+		return nil, ErrInvalidCircuitBreakerFailureThreshold
+	}
+	if args.CoolDown <= 0 {
+		return nil, ErrInvalidCircuitBreakerCoolDown
+	}
+
+	return &NodesCircuitBreaker{
+		failureThreshold: args.FailureThreshold,
+		coolDown:         args.CoolDown,
+		circuits:         make(map[string]*circuit),
+	}, nil
+}
+
+// AllowRequest returns true if a request can be sent to the given node
+func (ncb *NodesCircuitBreaker) AllowRequest(address string) bool {
+	ncb.mutCircuits.Lock()
+	defer ncb.mutCircuits.Unlock()
+
+	c, found := ncb.circuits[address]
+	if !found {
+		return true
+	}
+
+	switch c.state {
+	case CircuitOpen:
+		if time.Since(c.openedAt) < ncb.coolDown {
+			return false
+		}
+
+		log.Debug("circuit is half-open, sending a trial request", "address", address)
+		c.state = CircuitHalfOpen
+		c.isTrialInProgress = true
+		c.trialStartedAt = time.Now()
+		return true
+	case CircuitHalfOpen:
+		// a trial request whose outcome was never recorded (e.g. aborted by the caller) is replaced after a cool-down
+		if c.isTrialInProgress && time.Since(c.trialStartedAt) < ncb.coolDown {
+			return false
+		}
+
+		c.isTrialInProgress = true
+		c.trialStartedAt = time.Now()
+		return true
+	default:
+		return true
+	}
+}
+
+// RecordSuccess will close the circuit of the given node
+func (ncb *NodesCircuitBreaker) RecordSuccess(address string) {
+	ncb.mutCircuits.Lock()
+	defer ncb.mutCircuits.Unlock()
+
+	c, found := ncb.circuits[address]
+	if !found {
+		return
+	}
+
+	if c.state != CircuitClosed {
+		log.Info("circuit closed, node will receive requests again", "address", address)
+	}
+
+	c.state = CircuitClosed
+	c.consecutiveFailures = 0
+	c.isTrialInProgress = false
+}
+
+// RecordFailure will count a failed request for the given node and will open its circuit if needed
+func (ncb *NodesCircuitBreaker) RecordFailure(address string) {
+	ncb.mutCircuits.Lock()
+	defer ncb.mutCircuits.Unlock()
+
+	c, found := ncb.circuits[address]
+	if !found {
+		c = &circuit{state: CircuitClosed}
+		ncb.circuits[address] = c
+	}
+
+	c.consecutiveFailures++
+	c.isTrialInProgress = false
+
+	shouldOpen := c.state == CircuitHalfOpen ||
+		(c.state == CircuitClosed && c.consecutiveFailures >= ncb.failureThreshold)
+	if !shouldOpen {
+		return
+	}
+
+	log.Warn("circuit opened, node will not receive requests",
+		"address", address,
+		"consecutive failures", c.consecutiveFailures,
+		"cool-down", ncb.coolDown)
+	c.state = CircuitOpen
+	c.openedAt = time.Now()
+	c.timesOpened++
+}
+
+// IsOpen returns true if the circuit of the given node is open and its cool-down period did not elapse
+func (ncb *NodesCircuitBreaker) IsOpen(address string) bool {
+	ncb.mutCircuits.Lock()
+	defer ncb.mutCircuits.Unlock()
+
+	c, found := ncb.circuits[address]
+	if !found {
+		return false
+	}
+
+	return c.state == CircuitOpen && time.Since(c.openedAt) < ncb.coolDown
+}
+
+// GetCircuitStatus returns the state of the circuit of the given node
+func (ncb *NodesCircuitBreaker) GetCircuitStatus(address string) data.CircuitStatus {
+	ncb.mutCircuits.Lock()
+	defer ncb.mutCircuits.Unlock()
+
+	c, found := ncb.circuits[address]
+	if !found {
+		return data.CircuitStatus{State: CircuitClosed}
+	}
+
+	return data.CircuitStatus{
+		State:       c.state,
+		TimesOpened: c.timesOpened,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncb *NodesCircuitBreaker) IsInterfaceNil() bool {
+	return ncb == nil
+}
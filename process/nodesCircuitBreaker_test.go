@@ -0,0 +1,132 @@
+package process_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func createArgsNodesCircuitBreaker(failureThreshold uint32, coolDown time.Duration) process.ArgsNodesCircuitBreaker {
+	return process.ArgsNodesCircuitBreaker{
+		FailureThreshold: failureThreshold,
+		CoolDown:         coolDown,
+	}
+}
+
+func TestNewNodesCircuitBreaker_InvalidFailureThresholdShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ncb, err := process.NewNodesCircuitBreaker(createArgsNodesCircuitBreaker(0, time.Second))
+
+	assert.True(t, check.IfNil(ncb))
+	assert.Equal(t, process.ErrInvalidCircuitBreakerFailureThreshold, err)
+}
+
+func TestNewNodesCircuitBreaker_InvalidCoolDownShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ncb, err := process.NewNodesCircuitBreaker(createArgsNodesCircuitBreaker(3, 0))
+
+	assert.True(t, check.IfNil(ncb))
+	assert.Equal(t, process.ErrInvalidCircuitBreakerCoolDown, err)
+}
+
+func TestNewNodesCircuitBreaker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ncb, err := process.NewNodesCircuitBreaker(createArgsNodesCircuitBreaker(3, time.Second))
+
+	assert.False(t, check.IfNil(ncb))
+	assert.Nil(t, err)
+}
+
+func TestNodesCircuitBreaker_ShouldOpenOnlyAfterFailureThreshold(t *testing.T) {
+	t.Parallel()
+
+	ncb, _ := process.NewNodesCircuitBreaker(createArgsNodesCircuitBreaker(2, time.Minute))
+
+	ncb.RecordFailure("addr")
+	assert.True(t, ncb.AllowRequest("addr"))
+	assert.False(t, ncb.IsOpen("addr"))
+
+	ncb.RecordFailure("addr")
+	assert.False(t, ncb.AllowRequest("addr"))
+	assert.True(t, ncb.IsOpen("addr"))
+	assert.True(t, ncb.AllowRequest("other addr"))
+
+	status := ncb.GetCircuitStatus("addr")
+	assert.Equal(t, process.CircuitOpen, status.State)
+	assert.Equal(t, uint32(1), status.TimesOpened)
+}
+
+func TestNodesCircuitBreaker_SuccessShouldResetConsecutiveFailures(t *testing.T) {
+	t.Parallel()
+
+	ncb, _ := process.NewNodesCircuitBreaker(createArgsNodesCircuitBreaker(2, time.Minute))
+
+	ncb.RecordFailure("addr")
+	ncb.RecordSuccess("addr")
+	ncb.RecordFailure("addr")
+
+	assert.True(t, ncb.AllowRequest("addr"))
+	assert.Equal(t, process.CircuitClosed, ncb.GetCircuitStatus("addr").State)
+}
+
+func TestNodesCircuitBreaker_HalfOpenShouldAllowASingleTrialAndCloseOnSuccess(t *testing.T) {
+	t.Parallel()
+
+	coolDown := 50 * time.Millisecond
+	ncb, _ := process.NewNodesCircuitBreaker(createArgsNodesCircuitBreaker(1, coolDown))
+
+	ncb.RecordFailure("addr")
+	assert.False(t, ncb.AllowRequest("addr"))
+
+	time.Sleep(coolDown + 10*time.Millisecond)
+	assert.False(t, ncb.IsOpen("addr"))
+	assert.True(t, ncb.AllowRequest("addr"))
+	assert.Equal(t, process.CircuitHalfOpen, ncb.GetCircuitStatus("addr").State)
+	assert.False(t, ncb.AllowRequest("addr"))
+
+	ncb.RecordSuccess("addr")
+	assert.True(t, ncb.AllowRequest("addr"))
+	assert.True(t, ncb.AllowRequest("addr"))
+	assert.Equal(t, process.CircuitClosed, ncb.GetCircuitStatus("addr").State)
+}
+
+func TestNodesCircuitBreaker_HalfOpenShouldReopenOnFailure(t *testing.T) {
+	t.Parallel()
+
+	coolDown := 50 * time.Millisecond
+	ncb, _ := process.NewNodesCircuitBreaker(createArgsNodesCircuitBreaker(3, coolDown))
+
+	for i := 0; i < 3; i++ {
+		ncb.RecordFailure("addr")
+	}
+
+	time.Sleep(coolDown + 10*time.Millisecond)
+	assert.True(t, ncb.AllowRequest("addr"))
+
+	ncb.RecordFailure("addr")
+	assert.False(t, ncb.AllowRequest("addr"))
+	assert.True(t, ncb.IsOpen("addr"))
+	assert.Equal(t, uint32(2), ncb.GetCircuitStatus("addr").TimesOpened)
+}
+
+func TestNodesCircuitBreaker_HalfOpenShouldAllowANewTrialIfTheOutcomeWasNotRecorded(t *testing.T) {
+	t.Parallel()
+
+	coolDown := 50 * time.Millisecond
+	ncb, _ := process.NewNodesCircuitBreaker(createArgsNodesCircuitBreaker(1, coolDown))
+
+	ncb.RecordFailure("addr")
+	time.Sleep(coolDown + 10*time.Millisecond)
+	assert.True(t, ncb.AllowRequest("addr"))
+	assert.False(t, ncb.AllowRequest("addr"))
+
+	time.Sleep(coolDown + 10*time.Millisecond)
+	assert.True(t, ncb.AllowRequest("addr"))
+	assert.Equal(t, process.CircuitHalfOpen, ncb.GetCircuitStatus("addr").State)
+}
@@ -0,0 +1,142 @@
+package process
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+type nodeLoad struct {
+	slots  chan struct{}
+	queued uint32
+}
+
+// ArgsNodesConcurrencyLimiter holds the arguments needed for creating a new NodesConcurrencyLimiter
+type ArgsNodesConcurrencyLimiter struct {
+	MaxInFlightRequests uint32
+	MaxQueuedRequests   uint32
+	QueueTimeout        time.Duration
+}
+
+// NodesConcurrencyLimiter allows at most MaxInFlightRequests requests in flight towards each node. The requests above
+// this limit wait in a queue of at most MaxQueuedRequests requests, for at most QueueTimeout.
+// A request which finds the queue full, or which waits too long, is rejected, so it can be sent to another node
+type NodesConcurrencyLimiter struct {
+	maxInFlightRequests uint32
+	maxQueuedRequests   uint32
+	queueTimeout        time.Duration
+	mutNodes            sync.Mutex
+	nodes               map[string]*nodeLoad
+}
+
+// NewNodesConcurrencyLimiter creates a new instance of NodesConcurrencyLimiter
+func NewNodesConcurrencyLimiter(args ArgsNodesConcurrencyLimiter) (*NodesConcurrencyLimiter, error) {
+	if args.MaxInFlightRequests == 0 {
+		return nil, ErrInvalidMaxInFlightRequests
+	}
+	if args.MaxQueuedRequests > 0 && args.QueueTimeout <= 0 {
+		return nil, ErrInvalidQueueTimeout
+	}
+
+	return &NodesConcurrencyLimiter{
+		maxInFlightRequests: args.MaxInFlightRequests,
+		maxQueuedRequests:   args.MaxQueuedRequests,
+		queueTimeout:        args.QueueTimeout,
+		nodes:               make(map[string]*nodeLoad),
+	}, nil
+}
+
+// Acquire reserves an in-flight request slot on the given node, waiting in the node's queue if all the slots are
+// taken. It returns ErrNodeOverloaded if the queue is full or if no slot was freed within the queue timeout. Each
+// successful call should be followed by a call to Release
+func (ncl *NodesConcurrencyLimiter) Acquire(ctx context.Context, address string) error {
+	node := ncl.getNodeLoad(address)
+	select {
+	case node.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	if !ncl.enqueue(node) {
+		return ErrNodeOverloaded
+	}
+	defer ncl.dequeue(node)
+
+	timer := time.NewTimer(ncl.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case node.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return ErrNodeOverloaded
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees the in-flight request slot reserved on the given node, letting the first queued request, if any, pass
+func (ncl *NodesConcurrencyLimiter) Release(address string) {
+	node := ncl.getNodeLoad(address)
+	select {
+	case <-node.slots:
+	default:
+		log.Warn("concurrency limiter: release without acquire", "address", address)
+	}
+}
+
+func (ncl *NodesConcurrencyLimiter) getNodeLoad(address string) *nodeLoad {
+	ncl.mutNodes.Lock()
+	defer ncl.mutNodes.Unlock()
+
+	node, found := ncl.nodes[address]
+	if !found {
+		node = &nodeLoad{
+			slots: make(chan struct{}, ncl.maxInFlightRequests),
+		}
+		ncl.nodes[address] = node
+	}
+
+	return node
+}
+
+func (ncl *NodesConcurrencyLimiter) enqueue(node *nodeLoad) bool {
+	ncl.mutNodes.Lock()
+	defer ncl.mutNodes.Unlock()
+
+	if node.queued >= ncl.maxQueuedRequests {
+		return false
+	}
+
+	node.queued++
+	return true
+}
+
+func (ncl *NodesConcurrencyLimiter) dequeue(node *nodeLoad) {
+	ncl.mutNodes.Lock()
+	node.queued--
+	ncl.mutNodes.Unlock()
+}
+
+// GetLoadStatus returns the number of in-flight and of queued requests of the given node
+func (ncl *NodesConcurrencyLimiter) GetLoadStatus(address string) data.NodeLoadStatus {
+	ncl.mutNodes.Lock()
+	defer ncl.mutNodes.Unlock()
+
+	node, found := ncl.nodes[address]
+	if !found {
+		return data.NodeLoadStatus{}
+	}
+
+	return data.NodeLoadStatus{
+		InFlightRequests: uint32(len(node.slots)),
+		QueuedRequests:   node.queued,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ncl *NodesConcurrencyLimiter) IsInterfaceNil() bool {
+	return ncl == nil
+}
@@ -0,0 +1,115 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsNodesConcurrencyLimiter() process.ArgsNodesConcurrencyLimiter {
+	return process.ArgsNodesConcurrencyLimiter{
+		MaxInFlightRequests: 2,
+		MaxQueuedRequests:   1,
+		QueueTimeout:        time.Second,
+	}
+}
+
+func TestNewNodesConcurrencyLimiter_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesConcurrencyLimiter()
+	args.MaxInFlightRequests = 0
+	ncl, err := process.NewNodesConcurrencyLimiter(args)
+	assert.True(t, check.IfNil(ncl))
+	assert.Equal(t, process.ErrInvalidMaxInFlightRequests, err)
+
+	args = createArgsNodesConcurrencyLimiter()
+	args.QueueTimeout = 0
+	ncl, err = process.NewNodesConcurrencyLimiter(args)
+	assert.True(t, check.IfNil(ncl))
+	assert.Equal(t, process.ErrInvalidQueueTimeout, err)
+
+	args.MaxQueuedRequests = 0
+	ncl, err = process.NewNodesConcurrencyLimiter(args)
+	assert.False(t, check.IfNil(ncl))
+	assert.Nil(t, err)
+}
+
+func TestNodesConcurrencyLimiter_WithoutQueueShouldRejectTheExcessRequests(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesConcurrencyLimiter()
+	args.MaxQueuedRequests = 0
+	ncl, _ := process.NewNodesConcurrencyLimiter(args)
+
+	require.Nil(t, ncl.Acquire(context.Background(), "addr1"))
+	require.Nil(t, ncl.Acquire(context.Background(), "addr1"))
+	assert.Equal(t, process.ErrNodeOverloaded, ncl.Acquire(context.Background(), "addr1"))
+	assert.Nil(t, ncl.Acquire(context.Background(), "addr2"))
+	assert.Equal(t, data.NodeLoadStatus{InFlightRequests: 2}, ncl.GetLoadStatus("addr1"))
+
+	ncl.Release("addr1")
+	assert.Nil(t, ncl.Acquire(context.Background(), "addr1"))
+}
+
+func TestNodesConcurrencyLimiter_QueuedRequestShouldPassWhenASlotIsReleased(t *testing.T) {
+	t.Parallel()
+
+	ncl, _ := process.NewNodesConcurrencyLimiter(createArgsNodesConcurrencyLimiter())
+	require.Nil(t, ncl.Acquire(context.Background(), "addr"))
+	require.Nil(t, ncl.Acquire(context.Background(), "addr"))
+
+	acquired := make(chan error)
+	go func() {
+		acquired <- ncl.Acquire(context.Background(), "addr")
+	}()
+
+	assert.Eventually(t, func() bool {
+		return ncl.GetLoadStatus("addr").QueuedRequests == 1
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, process.ErrNodeOverloaded, ncl.Acquire(context.Background(), "addr"))
+
+	ncl.Release("addr")
+	assert.Nil(t, <-acquired)
+	assert.Equal(t, data.NodeLoadStatus{InFlightRequests: 2}, ncl.GetLoadStatus("addr"))
+}
+
+func TestNodesConcurrencyLimiter_QueuedRequestShouldTimeOut(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesConcurrencyLimiter()
+	args.MaxInFlightRequests = 1
+	args.QueueTimeout = 10 * time.Millisecond
+	ncl, _ := process.NewNodesConcurrencyLimiter(args)
+	require.Nil(t, ncl.Acquire(context.Background(), "addr"))
+
+	startTime := time.Now()
+	err := ncl.Acquire(context.Background(), "addr")
+
+	assert.Equal(t, process.ErrNodeOverloaded, err)
+	assert.True(t, time.Since(startTime) >= args.QueueTimeout)
+	assert.Equal(t, data.NodeLoadStatus{InFlightRequests: 1}, ncl.GetLoadStatus("addr"))
+}
+
+func TestNodesConcurrencyLimiter_QueuedRequestShouldStopWhenTheContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesConcurrencyLimiter()
+	args.MaxInFlightRequests = 1
+	ncl, _ := process.NewNodesConcurrencyLimiter(args)
+	require.Nil(t, ncl.Acquire(context.Background(), "addr"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := ncl.Acquire(ctx, "addr")
+
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, uint32(0), ncl.GetLoadStatus("addr").QueuedRequests)
+}
@@ -0,0 +1,432 @@
+package process
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/observer"
+)
+
+type nodeHealth struct {
+	isHealthy           bool
+	consecutiveFailures uint32
+	lastCheck           time.Time
+	lastError           string
+	metrics             nodeStatusMetrics
+	shardMismatch       string
+}
+
+// ArgsNodesHealthChecker holds the arguments needed for creating a new NodesHealthChecker
+type ArgsNodesHealthChecker struct {
+	Processor              Processor
+	HttpClients            NodesHttpClientsHandler
+	CircuitBreaker         CircuitBreakerHandler
+	ConcurrencyLimiter     ConcurrencyLimiterHandler
+	NetworkConfigGuard     NetworkConfigGuardHandler
+	Metrics                MetricsHandler
+	CheckInterval          time.Duration
+	MaxConsecutiveFailures uint32
+	SyncAwareRouting       bool
+	MaxNoncesBehind        uint64
+	CheckShards            bool
+	RejectMismatchingNodes bool
+}
+
+// NodesHealthChecker periodically probes the observers and the full history nodes. The nodes which fail too many
+// consecutive checks are evicted from the nodes providers and are re-admitted as soon as they answer again.
+// If sync aware routing is enabled, the nodes which are too far behind the highest nonce in their shard are evicted
+// as well, until they catch up. If the shards are checked, the nodes which report another shard than the configured
+// one, or another number of shards than the proxy uses, are reported and, if mismatching nodes are rejected, evicted.
+// The nodes quarantined by the network config guard are evicted as well. The probes are sent straight through the
+// nodes' http clients, so they are neither coalesced nor limited and still reach the nodes whose circuit is open
+type NodesHealthChecker struct {
+	proc                   Processor
+	httpClients            NodesHttpClientsHandler
+	circuitBreaker         CircuitBreakerHandler
+	concurrencyLimiter     ConcurrencyLimiterHandler
+	networkConfigGuard     NetworkConfigGuardHandler
+	metrics                MetricsHandler
+	checkInterval          time.Duration
+	maxConsecutiveFailures uint32
+	syncAwareRouting       bool
+	maxNoncesBehind        uint64
+	checkShards            bool
+	rejectMismatchingNodes bool
+	mutNodesHealth         sync.RWMutex
+	nodesHealth            map[string]*nodeHealth
+}
+
+// NewNodesHealthChecker creates a new instance of NodesHealthChecker
+func NewNodesHealthChecker(args ArgsNodesHealthChecker) (*NodesHealthChecker, error) {
+	if check.IfNil(args.Processor) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(args.HttpClients) {
+		return nil, ErrNilNodesHttpClients
+	}
+	if check.IfNil(args.CircuitBreaker) {
+		return nil, ErrNilCircuitBreaker
+	}
+	if check.IfNil(args.ConcurrencyLimiter) {
+		return nil, ErrNilConcurrencyLimiter
+	}
+	if check.IfNil(args.NetworkConfigGuard) {
+		return nil, ErrNilNetworkConfigGuard
+	}
+	if check.IfNil(args.Metrics) {
+		return nil, ErrNilMetricsHandler
+	}
+	if args.CheckInterval <= 0 {
+		return nil, ErrInvalidHealthCheckInterval
+	}
+	if args.MaxConsecutiveFailures == 0 {
+		return nil, ErrInvalidMaxConsecutiveFailures
+	}
+
+	return &NodesHealthChecker{
+		proc:                   args.Processor,
+		httpClients:            args.HttpClients,
+		circuitBreaker:         args.CircuitBreaker,
+		concurrencyLimiter:     args.ConcurrencyLimiter,
+		networkConfigGuard:     args.NetworkConfigGuard,
+		metrics:                args.Metrics,
+		checkInterval:          args.CheckInterval,
+		maxConsecutiveFailures: args.MaxConsecutiveFailures,
+		syncAwareRouting:       args.SyncAwareRouting,
+		maxNoncesBehind:        args.MaxNoncesBehind,
+		checkShards:            args.CheckShards,
+		rejectMismatchingNodes: args.RejectMismatchingNodes,
+		nodesHealth:            make(map[string]*nodeHealth),
+	}, nil
+}
+
+// StartHealthChecks will start checking the nodes at the configured interval, until the given context is done. The
+// first check is done before returning, so the unhealthy or inconsistent nodes are evicted before the proxy starts
+// serving requests
+func (nhc *NodesHealthChecker) StartHealthChecks(ctx context.Context) {
+	nhc.CheckNodes()
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debug("nodes health checks stopped")
+				return
+			case <-time.After(nhc.checkInterval):
+			}
+
+			nhc.CheckNodes()
+		}
+	}()
+}
+
+// CheckNodes probes all the configured nodes once and updates the nodes providers accordingly
+func (nhc *NodesHealthChecker) CheckNodes() {
+	observersProvider := nhc.proc.GetObserverProvider()
+	fullHistoryNodesProvider := nhc.proc.GetFullHistoryNodesProvider()
+
+	observers := getConfiguredNodes(observersProvider)
+	fullHistoryNodes := getConfiguredNodes(fullHistoryNodesProvider)
+
+	allNodes := make([]*data.NodeData, 0, len(observers)+len(fullHistoryNodes))
+	allNodes = append(allNodes, observers...)
+	allNodes = append(allNodes, fullHistoryNodes...)
+	nhc.probeNodes(allNodes)
+	nhc.reportShardMismatches(allNodes)
+	nhc.networkConfigGuard.CheckNodes(allNodes)
+
+	unhealthyObservers := nhc.updateNodesProvider(observersProvider, observers)
+	nhc.updateNodesProvider(fullHistoryNodesProvider, fullHistoryNodes)
+
+	nhc.recordHealthyObservers(observers, unhealthyObservers)
+}
+
+// recordHealthyObservers records, for each shard, the number of observers which were not evicted
+func (nhc *NodesHealthChecker) recordHealthyObservers(observers []*data.NodeData, unhealthyObservers map[string]struct{}) {
+	// the shards without any healthy observer are recorded as well, with 0 healthy observers
+	numHealthyObservers := make(map[uint32]int)
+	for _, node := range observers {
+		_, isUnhealthy := unhealthyObservers[node.Address]
+		numHealthy := numHealthyObservers[node.ShardId]
+		if !isUnhealthy {
+			numHealthy++
+		}
+		numHealthyObservers[node.ShardId] = numHealthy
+	}
+
+	for shardID, numHealthy := range numHealthyObservers {
+		nhc.metrics.SetHealthyObservers(shardID, numHealthy)
+	}
+}
+
+func getConfiguredNodes(nodesProvider observer.NodesProviderHandler) []*data.NodeData {
+	nodes, err := nodesProvider.GetAllConfiguredNodes()
+	if err != nil {
+		return nil
+	}
+
+	return nodes
+}
+
+func (nhc *NodesHealthChecker) probeNodes(nodes []*data.NodeData) {
+	addresses := make(map[string]struct{})
+	for _, node := range nodes {
+		addresses[node.Address] = struct{}{}
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(len(addresses))
+	for address := range addresses {
+		go func(address string) {
+			metrics, err := nhc.probeNode(address)
+			nhc.updateNodeHealth(address, metrics, err)
+			wg.Done()
+		}(address)
+	}
+	wg.Wait()
+}
+
+func (nhc *NodesHealthChecker) probeNode(address string) (nodeStatusMetrics, error) {
+	var response data.GenericAPIResponse
+	_, err := sendGetRequest(context.Background(), address, NodeStatusPath, &response, nhc.httpClients.GetHttpClient(address).Do)
+	if err != nil {
+		return nodeStatusMetrics{}, err
+	}
+
+	return parseNodeStatusMetrics(&response), nil
+}
+
+func (nhc *NodesHealthChecker) updateNodeHealth(address string, metrics nodeStatusMetrics, probeErr error) {
+	nhc.mutNodesHealth.Lock()
+	defer nhc.mutNodesHealth.Unlock()
+
+	health, found := nhc.nodesHealth[address]
+	if !found {
+		health = &nodeHealth{isHealthy: true}
+		nhc.nodesHealth[address] = health
+	}
+	health.lastCheck = time.Now()
+
+	if probeErr == nil {
+		if !health.isHealthy {
+			log.Info("node is healthy again", "address", address)
+		}
+
+		health.isHealthy = true
+		health.consecutiveFailures = 0
+		health.lastError = ""
+		health.metrics = metrics
+		return
+	}
+
+	health.consecutiveFailures++
+	health.lastError = probeErr.Error()
+	health.metrics = nodeStatusMetrics{}
+	if health.isHealthy && health.consecutiveFailures >= nhc.maxConsecutiveFailures {
+		log.Warn("node marked as unhealthy",
+			"address", address,
+			"consecutive failures", health.consecutiveFailures,
+			"error", probeErr.Error())
+		health.isHealthy = false
+	}
+}
+
+// reportShardMismatches logs the nodes which started or stopped contradicting the config
+func (nhc *NodesHealthChecker) reportShardMismatches(nodes []*data.NodeData) {
+	if !nhc.checkShards {
+		return
+	}
+
+	numShards := nhc.proc.GetShardCoordinator().NumberOfShards()
+
+	nhc.mutNodesHealth.Lock()
+	defer nhc.mutNodesHealth.Unlock()
+
+	for _, node := range nodes {
+		health, found := nhc.nodesHealth[node.Address]
+		if !found || !health.isHealthy {
+			continue
+		}
+
+		shardMismatch := ""
+		err := checkNodeShard(node, health.metrics, numShards)
+		if err != nil {
+			shardMismatch = err.Error()
+		}
+		if shardMismatch == health.shardMismatch {
+			continue
+		}
+
+		health.shardMismatch = shardMismatch
+		if len(shardMismatch) == 0 {
+			log.Info("node is consistent with the config again", "address", node.Address)
+			continue
+		}
+
+		log.Warn("node contradicts the config",
+			"address", node.Address,
+			"error", shardMismatch,
+			"rejected", nhc.rejectMismatchingNodes)
+	}
+}
+
+// updateNodesProvider will evict from the provider the unhealthy and the quarantined nodes and, if enabled, the ones which
+// are out of sync or report another shard. It returns the evicted nodes
+func (nhc *NodesHealthChecker) updateNodesProvider(
+	nodesProvider observer.NodesProviderHandler,
+	nodes []*data.NodeData,
+) map[string]struct{} {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	unhealthyNodes := make(map[string]struct{})
+
+	nhc.mutNodesHealth.RLock()
+	highestNonces := nhc.computeHighestNonces(nodes)
+	for _, node := range nodes {
+		health, found := nhc.nodesHealth[node.Address]
+		if !found {
+			continue
+		}
+
+		isSynced := nhc.isNodeSynced(health, highestNonces[node.ShardId])
+		isRejected := nhc.rejectMismatchingNodes && !nhc.isNodeInConfiguredShard(node, health)
+		_, isQuarantined := nhc.networkConfigGuard.GetQuarantineReason(node.Address)
+		if !health.isHealthy || !isSynced || isRejected || isQuarantined {
+			unhealthyNodes[node.Address] = struct{}{}
+		}
+	}
+	nhc.mutNodesHealth.RUnlock()
+
+	nodesProvider.UpdateNodesHealth(unhealthyNodes)
+
+	return unhealthyNodes
+}
+
+// computeHighestNonces should be called under mutex protection
+func (nhc *NodesHealthChecker) computeHighestNonces(nodes []*data.NodeData) map[uint32]uint64 {
+	highestNonces := make(map[uint32]uint64)
+	for _, node := range nodes {
+		health, found := nhc.nodesHealth[node.Address]
+		if !found || !health.isHealthy || !health.metrics.hasNonce {
+			continue
+		}
+		// a node from another shard would distort the highest nonce of its configured shard
+		if !nhc.isNodeInConfiguredShard(node, health) {
+			continue
+		}
+
+		if health.metrics.nonce > highestNonces[node.ShardId] {
+			highestNonces[node.ShardId] = health.metrics.nonce
+		}
+	}
+
+	return highestNonces
+}
+
+// GetHighestFinalNonce returns the highest final nonce reported during the last check by the healthy nodes of the given
+// shard. The second value is false if none of them reported its highest final nonce
+func (nhc *NodesHealthChecker) GetHighestFinalNonce(shardID uint32) (uint64, bool) {
+	observers := getConfiguredNodes(nhc.proc.GetObserverProvider())
+	fullHistoryNodes := getConfiguredNodes(nhc.proc.GetFullHistoryNodesProvider())
+
+	nhc.mutNodesHealth.RLock()
+	defer nhc.mutNodesHealth.RUnlock()
+
+	highestFinalNonce, found := uint64(0), false
+	for _, nodes := range [][]*data.NodeData{observers, fullHistoryNodes} {
+		for _, node := range nodes {
+			if node.ShardId != shardID {
+				continue
+			}
+			health, ok := nhc.nodesHealth[node.Address]
+			if !ok || !health.isHealthy || !health.metrics.hasHighestFinalNonce {
+				continue
+			}
+			if !nhc.isNodeInConfiguredShard(node, health) {
+				continue
+			}
+
+			if !found || health.metrics.highestFinalNonce > highestFinalNonce {
+				highestFinalNonce = health.metrics.highestFinalNonce
+				found = true
+			}
+		}
+	}
+
+	return highestFinalNonce, found
+}
+
+func (nhc *NodesHealthChecker) isNodeSynced(health *nodeHealth, highestNonceInShard uint64) bool {
+	if !nhc.syncAwareRouting || !health.metrics.hasNonce {
+		return true
+	}
+
+	return health.metrics.nonce+nhc.maxNoncesBehind >= highestNonceInShard
+}
+
+func (nhc *NodesHealthChecker) isNodeInConfiguredShard(node *data.NodeData, health *nodeHealth) bool {
+	if !nhc.checkShards {
+		return true
+	}
+
+	numShards := nhc.proc.GetShardCoordinator().NumberOfShards()
+	return checkNodeShard(node, health.metrics, numShards) == nil
+}
+
+// GetNodesHealth returns the health state of all the observers and full history nodes
+func (nhc *NodesHealthChecker) GetNodesHealth() (*data.NodesHealthResponse, error) {
+	observers := getConfiguredNodes(nhc.proc.GetObserverProvider())
+	fullHistoryNodes := getConfiguredNodes(nhc.proc.GetFullHistoryNodesProvider())
+
+	return &data.NodesHealthResponse{
+		Observers:        nhc.getNodesHealthStatus(observers),
+		FullHistoryNodes: nhc.getNodesHealthStatus(fullHistoryNodes),
+	}, nil
+}
+
+func (nhc *NodesHealthChecker) getNodesHealthStatus(nodes []*data.NodeData) []*data.NodeHealthStatus {
+	nhc.mutNodesHealth.RLock()
+	defer nhc.mutNodesHealth.RUnlock()
+
+	highestNonces := nhc.computeHighestNonces(nodes)
+	statuses := make([]*data.NodeHealthStatus, 0, len(nodes))
+	for _, node := range nodes {
+		status := &data.NodeHealthStatus{
+			Address:             node.Address,
+			ShardId:             node.ShardId,
+			Tags:                node.Tags,
+			IsHealthy:           true,
+			IsSynced:            true,
+			IsInConfiguredShard: true,
+			Circuit:             nhc.circuitBreaker.GetCircuitStatus(node.Address),
+			Load:                nhc.concurrencyLimiter.GetLoadStatus(node.Address),
+		}
+
+		status.QuarantineReason, status.IsQuarantined = nhc.networkConfigGuard.GetQuarantineReason(node.Address)
+
+		health, found := nhc.nodesHealth[node.Address]
+		if found {
+			status.IsHealthy = health.isHealthy
+			status.IsSynced = nhc.isNodeSynced(health, highestNonces[node.ShardId])
+			status.IsInConfiguredShard = nhc.isNodeInConfiguredShard(node, health)
+			status.Nonce = health.metrics.nonce
+			status.ConsecutiveFailures = health.consecutiveFailures
+			status.LastCheck = health.lastCheck
+			status.LastError = health.lastError
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nhc *NodesHealthChecker) IsInterfaceNil() bool {
+	return nhc == nil
+}
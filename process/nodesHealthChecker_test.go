@@ -0,0 +1,591 @@
+package process_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/observer"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// createNodeStatusHttpClients returns the http clients answering the node status requests with the metrics returned by
+// the given function for the requested node
+func createNodeStatusHttpClients(getMetrics func(address string) (map[string]interface{}, error)) process.NodesHttpClientsHandler {
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		metrics, err := getMetrics(strings.TrimSuffix(req.URL.String(), process.NodeStatusPath))
+		if err != nil {
+			return nil, err
+		}
+
+		responseBytes, _ := json.Marshal(data.GenericAPIResponse{Data: map[string]interface{}{"metrics": metrics}})
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(bytes.NewReader(responseBytes)),
+		}, nil
+	})
+	nodesHttpClients, _ := process.NewNodesHttpClients(&http.Client{Transport: transport}, nil)
+
+	return nodesHttpClients
+}
+
+func createFailingNodesHttpClients(failingAddresses map[string]struct{}, mutFailingAddresses *sync.RWMutex) process.NodesHttpClientsHandler {
+	return createNodeStatusHttpClients(func(address string) (map[string]interface{}, error) {
+		mutFailingAddresses.RLock()
+		defer mutFailingAddresses.RUnlock()
+
+		_, shouldFail := failingAddresses[address]
+		if shouldFail {
+			return nil, errors.New("connection refused")
+		}
+
+		return map[string]interface{}{}, nil
+	})
+}
+
+func createProcessorStubForHealthChecks(
+	observers []*data.NodeData,
+	fullHistoryNodes []*data.NodeData,
+) (*mock.ProcessorStub, map[string]struct{}, map[string]struct{}) {
+	unhealthyObservers := make(map[string]struct{})
+	unhealthyFullHistoryNodes := make(map[string]struct{})
+
+	observersProvider := &mock.ObserversProviderStub{
+		GetAllConfiguredNodesCalled: func() ([]*data.NodeData, error) {
+			return observers, nil
+		},
+		UpdateNodesHealthCalled: func(unhealthyNodes map[string]struct{}) {
+			for address := range unhealthyObservers {
+				delete(unhealthyObservers, address)
+			}
+			for address := range unhealthyNodes {
+				unhealthyObservers[address] = struct{}{}
+			}
+		},
+	}
+	fullHistoryNodesProvider := &mock.ObserversProviderStub{
+		GetAllConfiguredNodesCalled: func() ([]*data.NodeData, error) {
+			return fullHistoryNodes, nil
+		},
+		UpdateNodesHealthCalled: func(unhealthyNodes map[string]struct{}) {
+			for address := range unhealthyFullHistoryNodes {
+				delete(unhealthyFullHistoryNodes, address)
+			}
+			for address := range unhealthyNodes {
+				unhealthyFullHistoryNodes[address] = struct{}{}
+			}
+		},
+	}
+
+	proc := &mock.ProcessorStub{
+		GetObserverProviderCalled: func() observer.NodesProviderHandler {
+			return observersProvider
+		},
+		GetFullHistoryNodesProviderCalled: func() observer.NodesProviderHandler {
+			return fullHistoryNodesProvider
+		},
+	}
+
+	return proc, unhealthyObservers, unhealthyFullHistoryNodes
+}
+
+func createArgsNodesHealthChecker(
+	proc process.Processor,
+	checkInterval time.Duration,
+	maxConsecutiveFailures uint32,
+) process.ArgsNodesHealthChecker {
+	return process.ArgsNodesHealthChecker{
+		Processor:              proc,
+		HttpClients:            createFailingNodesHttpClients(nil, &sync.RWMutex{}),
+		CircuitBreaker:         &mock.CircuitBreakerStub{},
+		ConcurrencyLimiter:     &mock.ConcurrencyLimiterStub{},
+		NetworkConfigGuard:     &mock.NetworkConfigGuardStub{},
+		Metrics:                &mock.MetricsHandlerStub{},
+		CheckInterval:          checkInterval,
+		MaxConsecutiveFailures: maxConsecutiveFailures,
+	}
+}
+
+func TestNewNodesHealthChecker_NilProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nhc, err := process.NewNodesHealthChecker(createArgsNodesHealthChecker(nil, time.Second, 3))
+
+	assert.True(t, check.IfNil(nhc))
+	assert.Equal(t, process.ErrNilCoreProcessor, err)
+}
+
+func TestNewNodesHealthChecker_NilHttpClientsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesHealthChecker(&mock.ProcessorStub{}, time.Second, 3)
+	args.HttpClients = nil
+	nhc, err := process.NewNodesHealthChecker(args)
+
+	assert.True(t, check.IfNil(nhc))
+	assert.Equal(t, process.ErrNilNodesHttpClients, err)
+}
+
+func TestNewNodesHealthChecker_NilCircuitBreakerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesHealthChecker(&mock.ProcessorStub{}, time.Second, 3)
+	args.CircuitBreaker = nil
+	nhc, err := process.NewNodesHealthChecker(args)
+
+	assert.True(t, check.IfNil(nhc))
+	assert.Equal(t, process.ErrNilCircuitBreaker, err)
+}
+
+func TestNewNodesHealthChecker_NilConcurrencyLimiterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesHealthChecker(&mock.ProcessorStub{}, time.Second, 3)
+	args.ConcurrencyLimiter = nil
+	nhc, err := process.NewNodesHealthChecker(args)
+
+	assert.True(t, check.IfNil(nhc))
+	assert.Equal(t, process.ErrNilConcurrencyLimiter, err)
+}
+
+func TestNewNodesHealthChecker_NilNetworkConfigGuardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesHealthChecker(&mock.ProcessorStub{}, time.Second, 3)
+	args.NetworkConfigGuard = nil
+	nhc, err := process.NewNodesHealthChecker(args)
+
+	assert.True(t, check.IfNil(nhc))
+	assert.Equal(t, process.ErrNilNetworkConfigGuard, err)
+}
+
+func TestNewNodesHealthChecker_NilMetricsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsNodesHealthChecker(&mock.ProcessorStub{}, time.Second, 3)
+	args.Metrics = nil
+	nhc, err := process.NewNodesHealthChecker(args)
+
+	assert.True(t, check.IfNil(nhc))
+	assert.Equal(t, process.ErrNilMetricsHandler, err)
+}
+
+func TestNewNodesHealthChecker_InvalidCheckIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nhc, err := process.NewNodesHealthChecker(createArgsNodesHealthChecker(&mock.ProcessorStub{}, 0, 3))
+
+	assert.True(t, check.IfNil(nhc))
+	assert.Equal(t, process.ErrInvalidHealthCheckInterval, err)
+}
+
+func TestNewNodesHealthChecker_InvalidMaxConsecutiveFailuresShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nhc, err := process.NewNodesHealthChecker(createArgsNodesHealthChecker(&mock.ProcessorStub{}, time.Second, 0))
+
+	assert.True(t, check.IfNil(nhc))
+	assert.Equal(t, process.ErrInvalidMaxConsecutiveFailures, err)
+}
+
+func TestNewNodesHealthChecker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	nhc, err := process.NewNodesHealthChecker(createArgsNodesHealthChecker(&mock.ProcessorStub{}, time.Second, 3))
+
+	assert.False(t, check.IfNil(nhc))
+	assert.Nil(t, err)
+}
+
+func TestNodesHealthChecker_CheckNodesShouldMarkUnhealthyOnlyAfterMaxConsecutiveFailures(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+		{Address: "obs1", ShardId: 0},
+	}
+	fullHistoryNodes := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+	}
+	failingAddresses := map[string]struct{}{"obs0": {}}
+	proc, unhealthyObservers, unhealthyFullHistoryNodes := createProcessorStubForHealthChecks(observers, fullHistoryNodes)
+
+	args := createArgsNodesHealthChecker(proc, time.Second, 2)
+	args.HttpClients = createFailingNodesHttpClients(failingAddresses, &sync.RWMutex{})
+	nhc, _ := process.NewNodesHealthChecker(args)
+
+	nhc.CheckNodes()
+	assert.Equal(t, 0, len(unhealthyObservers))
+	assert.Equal(t, 0, len(unhealthyFullHistoryNodes))
+
+	nhc.CheckNodes()
+	assert.Equal(t, map[string]struct{}{"obs0": {}}, unhealthyObservers)
+	assert.Equal(t, map[string]struct{}{"obs0": {}}, unhealthyFullHistoryNodes)
+
+	nodesHealth, err := nhc.GetNodesHealth()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(nodesHealth.Observers))
+	assert.False(t, nodesHealth.Observers[0].IsHealthy)
+	assert.Equal(t, uint32(2), nodesHealth.Observers[0].ConsecutiveFailures)
+	assert.Contains(t, nodesHealth.Observers[0].LastError, "connection refused")
+	assert.True(t, nodesHealth.Observers[1].IsHealthy)
+	require.Equal(t, 1, len(nodesHealth.FullHistoryNodes))
+	assert.False(t, nodesHealth.FullHistoryNodes[0].IsHealthy)
+}
+
+func TestNodesHealthChecker_CheckNodesShouldProbeTheNodesWithTheirHttpClients(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{{Address: "obs0", ShardId: 0}}
+	proc, unhealthyObservers, _ := createProcessorStubForHealthChecks(observers, nil)
+	proc.CallGetRestEndPointCalled = func(_ context.Context, address string, _ string, _ interface{}) (int, error) {
+		assert.Fail(t, "the probes should not go through the processor", address)
+		return http.StatusServiceUnavailable, process.ErrCircuitOpen
+	}
+
+	probedAddresses := make([]string, 0)
+	args := createArgsNodesHealthChecker(proc, time.Second, 1)
+	args.HttpClients = createNodeStatusHttpClients(func(address string) (map[string]interface{}, error) {
+		probedAddresses = append(probedAddresses, address)
+		return map[string]interface{}{}, nil
+	})
+	nhc, _ := process.NewNodesHealthChecker(args)
+
+	nhc.CheckNodes()
+	assert.Equal(t, []string{"obs0"}, probedAddresses)
+	assert.Equal(t, 0, len(unhealthyObservers))
+}
+
+func TestNodesHealthChecker_StartHealthChecksShouldStopWhenTheContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{{Address: "obs0", ShardId: 0}}
+	proc, _, _ := createProcessorStubForHealthChecks(observers, nil)
+
+	numProbes := int32(0)
+	args := createArgsNodesHealthChecker(proc, 10*time.Millisecond, 1)
+	args.HttpClients = createNodeStatusHttpClients(func(_ string) (map[string]interface{}, error) {
+		atomic.AddInt32(&numProbes, 1)
+		return map[string]interface{}{}, nil
+	})
+	nhc, _ := process.NewNodesHealthChecker(args)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	nhc.StartHealthChecks(ctx)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numProbes))
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	time.Sleep(20 * time.Millisecond)
+	numProbesWhenStopped := atomic.LoadInt32(&numProbes)
+	assert.True(t, numProbesWhenStopped > 1)
+
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, numProbesWhenStopped, atomic.LoadInt32(&numProbes))
+}
+
+func TestNodesHealthChecker_CheckNodesShouldReAdmitRecoveredNodes(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+		{Address: "obs1", ShardId: 0},
+	}
+	failingAddresses := map[string]struct{}{"obs0": {}}
+	mutFailingAddresses := &sync.RWMutex{}
+	proc, unhealthyObservers, _ := createProcessorStubForHealthChecks(observers, nil)
+
+	args := createArgsNodesHealthChecker(proc, time.Second, 1)
+	args.HttpClients = createFailingNodesHttpClients(failingAddresses, mutFailingAddresses)
+	nhc, _ := process.NewNodesHealthChecker(args)
+
+	nhc.CheckNodes()
+	assert.Equal(t, map[string]struct{}{"obs0": {}}, unhealthyObservers)
+
+	mutFailingAddresses.Lock()
+	delete(failingAddresses, "obs0")
+	mutFailingAddresses.Unlock()
+
+	nhc.CheckNodes()
+	assert.Equal(t, 0, len(unhealthyObservers))
+
+	nodesHealth, _ := nhc.GetNodesHealth()
+	assert.True(t, nodesHealth.Observers[0].IsHealthy)
+	assert.Equal(t, uint32(0), nodesHealth.Observers[0].ConsecutiveFailures)
+	assert.Empty(t, nodesHealth.Observers[0].LastError)
+}
+
+func TestNodesHealthChecker_CheckNodesShouldRecordTheHealthyObserversOfEachShard(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+		{Address: "obs1", ShardId: 0},
+		{Address: "obs2", ShardId: 1},
+	}
+	failingAddresses := map[string]struct{}{"obs0": {}, "obs2": {}}
+	proc, _, _ := createProcessorStubForHealthChecks(observers, nil)
+
+	healthyObservers := make(map[uint32]int)
+	args := createArgsNodesHealthChecker(proc, time.Second, 1)
+	args.HttpClients = createFailingNodesHttpClients(failingAddresses, &sync.RWMutex{})
+	args.Metrics = &mock.MetricsHandlerStub{
+		SetHealthyObserversCalled: func(shardID uint32, numHealthy int) {
+			healthyObservers[shardID] = numHealthy
+		},
+	}
+	nhc, _ := process.NewNodesHealthChecker(args)
+
+	nhc.CheckNodes()
+	assert.Equal(t, map[uint32]int{0: 1, 1: 0}, healthyObservers)
+}
+
+func TestNodesHealthChecker_GetNodesHealthBeforeAnyCheckShouldReportHealthyNodes(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+	}
+	proc, _, _ := createProcessorStubForHealthChecks(observers, nil)
+
+	nhc, _ := process.NewNodesHealthChecker(createArgsNodesHealthChecker(proc, time.Second, 1))
+
+	nodesHealth, err := nhc.GetNodesHealth()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(nodesHealth.Observers))
+	assert.True(t, nodesHealth.Observers[0].IsHealthy)
+	assert.True(t, nodesHealth.Observers[0].LastCheck.IsZero())
+	assert.Equal(t, 0, len(nodesHealth.FullHistoryNodes))
+}
+
+func createNoncesHttpClients(nonces map[string]uint64) process.NodesHttpClientsHandler {
+	return createNodeStatusHttpClients(func(address string) (map[string]interface{}, error) {
+		return map[string]interface{}{core.MetricNonce: nonces[address]}, nil
+	})
+}
+
+func TestNodesHealthChecker_CheckNodesWithSyncAwareRoutingShouldEvictLaggingNodes(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+		{Address: "obs1", ShardId: 0},
+		{Address: "obs2", ShardId: 1},
+		{Address: "obs3", ShardId: 1},
+	}
+	proc, unhealthyObservers, _ := createProcessorStubForHealthChecks(observers, nil)
+
+	args := createArgsNodesHealthChecker(proc, time.Second, 1)
+	args.HttpClients = createNoncesHttpClients(map[string]uint64{
+		"obs0": 1000,
+		"obs1": 500,
+		"obs2": 20,
+		"obs3": 30,
+	})
+	args.SyncAwareRouting = true
+	args.MaxNoncesBehind = 10
+	nhc, _ := process.NewNodesHealthChecker(args)
+
+	nhc.CheckNodes()
+	assert.Equal(t, map[string]struct{}{"obs1": {}}, unhealthyObservers)
+
+	nodesHealth, _ := nhc.GetNodesHealth()
+	require.Equal(t, 4, len(nodesHealth.Observers))
+	assert.True(t, nodesHealth.Observers[0].IsSynced)
+	assert.Equal(t, uint64(1000), nodesHealth.Observers[0].Nonce)
+	assert.False(t, nodesHealth.Observers[1].IsSynced)
+	assert.True(t, nodesHealth.Observers[1].IsHealthy)
+	assert.Equal(t, uint64(500), nodesHealth.Observers[1].Nonce)
+	assert.True(t, nodesHealth.Observers[2].IsSynced)
+	assert.True(t, nodesHealth.Observers[3].IsSynced)
+}
+
+func TestNodesHealthChecker_CheckNodesWithoutSyncAwareRoutingShouldNotEvictLaggingNodes(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+		{Address: "obs1", ShardId: 0},
+	}
+	proc, unhealthyObservers, _ := createProcessorStubForHealthChecks(observers, nil)
+
+	args := createArgsNodesHealthChecker(proc, time.Second, 1)
+	args.HttpClients = createNoncesHttpClients(map[string]uint64{
+		"obs0": 1000,
+		"obs1": 500,
+	})
+	nhc, _ := process.NewNodesHealthChecker(args)
+
+	nhc.CheckNodes()
+	assert.Equal(t, 0, len(unhealthyObservers))
+
+	nodesHealth, _ := nhc.GetNodesHealth()
+	assert.True(t, nodesHealth.Observers[1].IsSynced)
+	assert.Equal(t, uint64(500), nodesHealth.Observers[1].Nonce)
+}
+
+func TestNodesHealthChecker_GetHighestFinalNonceShouldUseTheHealthyNodesOfTheShard(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+		{Address: "obs1", ShardId: 0},
+		{Address: "obs2", ShardId: 1},
+	}
+	fullHistoryNodes := []*data.NodeData{{Address: "fhn0", ShardId: 0}}
+	proc, _, _ := createProcessorStubForHealthChecks(observers, fullHistoryNodes)
+	finalNonces := map[string]uint64{"obs0": 90, "obs1": 200, "fhn0": 120}
+	args := createArgsNodesHealthChecker(proc, time.Second, 1)
+	args.HttpClients = createNodeStatusHttpClients(func(address string) (map[string]interface{}, error) {
+		if address == "obs1" {
+			return nil, errors.New("connection refused")
+		}
+
+		metrics := map[string]interface{}{}
+		finalNonce, ok := finalNonces[address]
+		if ok {
+			metrics[core.MetricHighestFinalBlock] = finalNonce
+		}
+
+		return metrics, nil
+	})
+	nhc, _ := process.NewNodesHealthChecker(args)
+
+	_, found := nhc.GetHighestFinalNonce(0)
+	assert.False(t, found)
+
+	nhc.CheckNodes()
+
+	highestFinalNonce, found := nhc.GetHighestFinalNonce(0)
+	assert.True(t, found)
+	assert.Equal(t, uint64(120), highestFinalNonce)
+
+	_, found = nhc.GetHighestFinalNonce(1)
+	assert.False(t, found)
+}
+
+func setShardsOnHealthCheckerArgs(args *process.ArgsNodesHealthChecker, numShards uint32, reportedShards map[string]uint32) {
+	args.Processor.(*mock.ProcessorStub).GetShardCoordinatorCalled = func() sharding.Coordinator {
+		return &mock.ShardCoordinatorMock{NumShards: numShards}
+	}
+	args.HttpClients = createNodeStatusHttpClients(func(address string) (map[string]interface{}, error) {
+		return map[string]interface{}{
+			core.MetricShardId:                   reportedShards[address],
+			core.MetricNumShardsWithoutMetacahin: numShards,
+		}, nil
+	})
+}
+
+func TestNodesHealthChecker_CheckNodesShouldEvictRejectedMismatchingNodes(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+		{Address: "obs1", ShardId: 0},
+		{Address: "obs2", ShardId: 1},
+	}
+	proc, unhealthyObservers, _ := createProcessorStubForHealthChecks(observers, nil)
+
+	args := createArgsNodesHealthChecker(proc, time.Second, 1)
+	setShardsOnHealthCheckerArgs(&args, 2, map[string]uint32{
+		"obs0": 0,
+		"obs1": 1,
+		"obs2": 1,
+	})
+	args.CheckShards = true
+	args.RejectMismatchingNodes = true
+	nhc, _ := process.NewNodesHealthChecker(args)
+
+	nhc.CheckNodes()
+	assert.Equal(t, map[string]struct{}{"obs1": {}}, unhealthyObservers)
+
+	nodesHealth, _ := nhc.GetNodesHealth()
+	require.Equal(t, 3, len(nodesHealth.Observers))
+	assert.True(t, nodesHealth.Observers[0].IsInConfiguredShard)
+	assert.False(t, nodesHealth.Observers[1].IsInConfiguredShard)
+	assert.True(t, nodesHealth.Observers[1].IsHealthy)
+	assert.True(t, nodesHealth.Observers[2].IsInConfiguredShard)
+}
+
+func TestNodesHealthChecker_CheckNodesShouldOnlyReportMismatchingNodesIfNotRejected(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+		{Address: "obs1", ShardId: 0},
+	}
+	proc, unhealthyObservers, _ := createProcessorStubForHealthChecks(observers, nil)
+
+	args := createArgsNodesHealthChecker(proc, time.Second, 1)
+	setShardsOnHealthCheckerArgs(&args, 2, map[string]uint32{
+		"obs0": 0,
+		"obs1": 1,
+	})
+	args.CheckShards = true
+	nhc, _ := process.NewNodesHealthChecker(args)
+
+	nhc.CheckNodes()
+	assert.Equal(t, 0, len(unhealthyObservers))
+
+	nodesHealth, _ := nhc.GetNodesHealth()
+	assert.False(t, nodesHealth.Observers[1].IsInConfiguredShard)
+}
+
+func TestNodesHealthChecker_CheckNodesShouldEvictQuarantinedNodes(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "obs0", ShardId: 0},
+		{Address: "obs1", ShardId: 0},
+	}
+	proc, unhealthyObservers, _ := createProcessorStubForHealthChecks(observers, nil)
+
+	checkedNodes := make([]*data.NodeData, 0)
+	args := createArgsNodesHealthChecker(proc, time.Second, 1)
+	args.NetworkConfigGuard = &mock.NetworkConfigGuardStub{
+		CheckNodesCalled: func(nodes []*data.NodeData) {
+			checkedNodes = append(checkedNodes, nodes...)
+		},
+		GetQuarantineReasonCalled: func(address string) (string, bool) {
+			if address == "obs1" {
+				return "inconsistent network config", true
+			}
+
+			return "", false
+		},
+	}
+	nhc, _ := process.NewNodesHealthChecker(args)
+
+	nhc.CheckNodes()
+	assert.Equal(t, observers, checkedNodes)
+	assert.Equal(t, map[string]struct{}{"obs1": {}}, unhealthyObservers)
+
+	nodesHealth, _ := nhc.GetNodesHealth()
+	require.Equal(t, 2, len(nodesHealth.Observers))
+	assert.False(t, nodesHealth.Observers[0].IsQuarantined)
+	assert.True(t, nodesHealth.Observers[1].IsQuarantined)
+	assert.True(t, nodesHealth.Observers[1].IsHealthy)
+	assert.Equal(t, "inconsistent network config", nodesHealth.Observers[1].QuarantineReason)
+}
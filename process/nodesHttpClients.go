@@ -0,0 +1,211 @@
+package process
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+type nodeCredentials struct {
+	username       string
+	password       string
+	bearerToken    string
+	clientCertFile string
+	clientKeyFile  string
+	caCertFile     string
+}
+
+func (nc nodeCredentials) isEmpty() bool {
+	return nc == nodeCredentials{}
+}
+
+func (nc nodeCredentials) hasTLSSettings() bool {
+	return len(nc.clientCertFile) > 0 || len(nc.clientKeyFile) > 0 || len(nc.caCertFile) > 0
+}
+
+// credentialsRoundTripper adds the node's basic auth or bearer token on every request
+type credentialsRoundTripper struct {
+	next        http.RoundTripper
+	username    string
+	password    string
+	bearerToken string
+}
+
+// RoundTrip sends the request with the node's credentials
+func (crt *credentialsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(crt.username) == 0 && len(crt.bearerToken) == 0 {
+		return crt.next.RoundTrip(req)
+	}
+
+	// a RoundTripper must not modify the request it receives
+	authenticatedReq := req.Clone(req.Context())
+	if len(crt.bearerToken) > 0 {
+		authenticatedReq.Header.Set("Authorization", "Bearer "+crt.bearerToken)
+	} else {
+		authenticatedReq.SetBasicAuth(crt.username, crt.password)
+	}
+
+	return crt.next.RoundTrip(authenticatedReq)
+}
+
+// NodesHttpClients holds the http clients used for the requests towards the nodes. The nodes without credentials
+// share the default http client, while each node with credentials gets its own client which adds them on every
+// request and, for mutual TLS, its own transport
+type NodesHttpClients struct {
+	defaultClient *http.Client
+	mutClients    sync.RWMutex
+	clients       map[string]*http.Client
+}
+
+// NewNodesHttpClients creates a new instance of NodesHttpClients
+func NewNodesHttpClients(defaultClient *http.Client, nodes []*data.NodeData) (*NodesHttpClients, error) {
+	if defaultClient == nil {
+		return nil, ErrNilHttpClient
+	}
+
+	nhc := &NodesHttpClients{
+		defaultClient: defaultClient,
+	}
+	err := nhc.ReloadNodes(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return nhc, nil
+}
+
+// ReloadNodes will replace the http clients of the nodes with credentials. If the credentials of any node are
+// invalid, the current clients are kept
+func (nhc *NodesHttpClients) ReloadNodes(nodes []*data.NodeData) error {
+	credentialsByAddress := make(map[string]nodeCredentials)
+	for _, node := range nodes {
+		credentials := nodeCredentials{
+			username:       node.Username,
+			password:       node.Password,
+			bearerToken:    node.BearerToken,
+			clientCertFile: node.ClientCertFile,
+			clientKeyFile:  node.ClientKeyFile,
+			caCertFile:     node.CACertFile,
+		}
+
+		existingCredentials, found := credentialsByAddress[node.Address]
+		if found && existingCredentials != credentials {
+			return fmt.Errorf("%w: %s is defined more than once", ErrConflictingNodeCredentials, node.Address)
+		}
+		credentialsByAddress[node.Address] = credentials
+	}
+
+	clients := make(map[string]*http.Client)
+	for address, credentials := range credentialsByAddress {
+		if credentials.isEmpty() {
+			continue
+		}
+
+		client, err := nhc.createHttpClient(credentials)
+		if err != nil {
+			return fmt.Errorf("%w for node %s", err, address)
+		}
+		clients[address] = client
+	}
+
+	nhc.mutClients.Lock()
+	oldClients := nhc.clients
+	nhc.clients = clients
+	nhc.mutClients.Unlock()
+
+	for _, client := range oldClients {
+		client.CloseIdleConnections()
+	}
+
+	return nil
+}
+
+func (nhc *NodesHttpClients) createHttpClient(credentials nodeCredentials) (*http.Client, error) {
+	if len(credentials.bearerToken) > 0 && len(credentials.username) > 0 {
+		return nil, ErrConflictingNodeCredentials
+	}
+
+	transport := nhc.defaultClient.Transport
+	if credentials.hasTLSSettings() {
+		tlsTransport, err := nhc.createTLSTransport(credentials)
+		if err != nil {
+			return nil, err
+		}
+		transport = tlsTransport
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &http.Client{
+		Transport: &credentialsRoundTripper{
+			next:        transport,
+			username:    credentials.username,
+			password:    credentials.password,
+			bearerToken: credentials.bearerToken,
+		},
+		Timeout: nhc.defaultClient.Timeout,
+	}, nil
+}
+
+func (nhc *NodesHttpClients) createTLSTransport(credentials nodeCredentials) (*http.Transport, error) {
+	tlsConfig := &tls.Config{}
+
+	hasClientCert := len(credentials.clientCertFile) > 0
+	hasClientKey := len(credentials.clientKeyFile) > 0
+	if hasClientCert != hasClientKey {
+		return nil, ErrIncompleteClientCertificate
+	}
+	if hasClientCert {
+		certificate, err := tls.LoadX509KeyPair(credentials.clientCertFile, credentials.clientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if len(credentials.caCertFile) > 0 {
+		caCert, err := ioutil.ReadFile(credentials.caCertFile)
+		if err != nil {
+			return nil, err
+		}
+
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, ErrInvalidCACertificate
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	baseTransport, ok := nhc.defaultClient.Transport.(*http.Transport)
+	if !ok {
+		baseTransport = http.DefaultTransport.(*http.Transport)
+	}
+	transport := baseTransport.Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// GetHttpClient returns the http client to be used for the requests towards the given node
+func (nhc *NodesHttpClients) GetHttpClient(address string) *http.Client {
+	nhc.mutClients.RLock()
+	defer nhc.mutClients.RUnlock()
+
+	client, found := nhc.clients[address]
+	if !found {
+		return nhc.defaultClient
+	}
+
+	return client
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nhc *NodesHttpClients) IsInterfaceNil() bool {
+	return nhc == nil
+}
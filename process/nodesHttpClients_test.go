@@ -0,0 +1,229 @@
+package process_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	privateKey  *ecdsa.PrivateKey
+	certPEM     []byte
+	keyPEM      []byte
+}
+
+func createTestCertificate(t *testing.T, template *x509.Certificate, parent *testCertificate) *testCertificate {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	parentCertificate, parentKey := template, privateKey
+	if parent != nil {
+		parentCertificate, parentKey = parent.certificate, parent.privateKey
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, parentCertificate, &privateKey.PublicKey, parentKey)
+	require.Nil(t, err)
+	certificate, err := x509.ParseCertificate(certDER)
+	require.Nil(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	require.Nil(t, err)
+
+	return &testCertificate{
+		certificate: certificate,
+		privateKey:  privateKey,
+		certPEM:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		keyPEM:      pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func createCertificateTemplate(serialNumber int64, commonName string) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber: big.NewInt(serialNumber),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+}
+
+func writeTestFile(t *testing.T, dir string, name string, content []byte) string {
+	filePath := filepath.Join(dir, name)
+	err := ioutil.WriteFile(filePath, content, os.ModePerm)
+	require.Nil(t, err)
+
+	return filePath
+}
+
+func TestNewNodesHttpClients_NilDefaultClientShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nhc, err := process.NewNodesHttpClients(nil, nil)
+
+	assert.True(t, check.IfNil(nhc))
+	assert.Equal(t, process.ErrNilHttpClient, err)
+}
+
+func TestNewNodesHttpClients_InvalidCredentialsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*data.NodeData{{Address: "addr", Username: "user", Password: "pass", BearerToken: "token"}}
+	nhc, err := process.NewNodesHttpClients(http.DefaultClient, nodes)
+	assert.True(t, check.IfNil(nhc))
+	assert.True(t, errors.Is(err, process.ErrConflictingNodeCredentials))
+
+	nodes = []*data.NodeData{{Address: "addr", BearerToken: "token1"}, {Address: "addr", BearerToken: "token2"}}
+	nhc, err = process.NewNodesHttpClients(http.DefaultClient, nodes)
+	assert.True(t, check.IfNil(nhc))
+	assert.True(t, errors.Is(err, process.ErrConflictingNodeCredentials))
+
+	nodes = []*data.NodeData{{Address: "addr", ClientCertFile: "client.crt"}}
+	nhc, err = process.NewNodesHttpClients(http.DefaultClient, nodes)
+	assert.True(t, check.IfNil(nhc))
+	assert.True(t, errors.Is(err, process.ErrIncompleteClientCertificate))
+
+	nodes = []*data.NodeData{{Address: "addr", CACertFile: writeTestFile(t, t.TempDir(), "ca.crt", []byte("not a certificate"))}}
+	nhc, err = process.NewNodesHttpClients(http.DefaultClient, nodes)
+	assert.True(t, check.IfNil(nhc))
+	assert.True(t, errors.Is(err, process.ErrInvalidCACertificate))
+}
+
+func TestNodesHttpClients_NodeWithoutCredentialsShouldUseTheDefaultClient(t *testing.T) {
+	t.Parallel()
+
+	defaultClient := &http.Client{}
+	nodes := []*data.NodeData{{Address: "addr1"}, {Address: "addr1"}, {Address: "addr2", BearerToken: "token"}}
+	nhc, err := process.NewNodesHttpClients(defaultClient, nodes)
+	require.Nil(t, err)
+
+	assert.True(t, nhc.GetHttpClient("addr1") == defaultClient)
+	assert.True(t, nhc.GetHttpClient("unknown") == defaultClient)
+	assert.False(t, nhc.GetHttpClient("addr2") == defaultClient)
+}
+
+func TestNodesHttpClients_ShouldSendBasicAuthAndBearerTokens(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		username, password, ok := req.BasicAuth()
+		if ok && username == "user" && password == "pass" {
+			return
+		}
+		if req.Header.Get("Authorization") == "Bearer token" {
+			return
+		}
+
+		rw.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	basicAuthAddress := server.URL + "/basic"
+	bearerAddress := server.URL + "/bearer"
+	nodes := []*data.NodeData{
+		{Address: basicAuthAddress, Username: "user", Password: "pass"},
+		{Address: bearerAddress, BearerToken: "token"},
+	}
+	nhc, _ := process.NewNodesHttpClients(&http.Client{Timeout: time.Second}, nodes)
+
+	for _, address := range []string{basicAuthAddress, bearerAddress} {
+		req, _ := http.NewRequest(http.MethodGet, address, nil)
+		resp, err := nhc.GetHttpClient(address).Do(req)
+		require.Nil(t, err)
+		_ = resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Empty(t, req.Header.Get("Authorization"))
+	}
+
+	resp, err := nhc.GetHttpClient(server.URL).Get(server.URL)
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestNodesHttpClients_ShouldUseMutualTLS(t *testing.T) {
+	t.Parallel()
+
+	caTemplate := createCertificateTemplate(1, "test CA")
+	caTemplate.IsCA = true
+	caTemplate.BasicConstraintsValid = true
+	caTemplate.KeyUsage = x509.KeyUsageCertSign
+	ca := createTestCertificate(t, caTemplate, nil)
+
+	serverTemplate := createCertificateTemplate(2, "observer")
+	serverTemplate.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	serverTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	serverCert := createTestCertificate(t, serverTemplate, ca)
+
+	clientTemplate := createCertificateTemplate(3, "proxy")
+	clientTemplate.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	clientCert := createTestCertificate(t, clientTemplate, ca)
+
+	serverKeyPair, err := tls.X509KeyPair(serverCert.certPEM, serverCert.keyPEM)
+	require.Nil(t, err)
+	caPool := x509.NewCertPool()
+	caPool.AddCert(ca.certificate)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverKeyPair},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	dir := t.TempDir()
+	nodes := []*data.NodeData{{
+		Address:        server.URL,
+		ClientCertFile: writeTestFile(t, dir, "client.crt", clientCert.certPEM),
+		ClientKeyFile:  writeTestFile(t, dir, "client.key", clientCert.keyPEM),
+		CACertFile:     writeTestFile(t, dir, "ca.crt", ca.certPEM),
+	}}
+	httpClient, _ := process.NewHttpClient(createArgsHttpClient())
+	nhc, err := process.NewNodesHttpClients(httpClient, nodes)
+	require.Nil(t, err)
+
+	resp, err := nhc.GetHttpClient(server.URL).Get(server.URL)
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, err = httpClient.Get(server.URL)
+	assert.NotNil(t, err)
+}
+
+func TestNodesHttpClients_ReloadNodes(t *testing.T) {
+	t.Parallel()
+
+	defaultClient := &http.Client{}
+	nhc, _ := process.NewNodesHttpClients(defaultClient, []*data.NodeData{{Address: "addr1", BearerToken: "token"}})
+	clientAddr1 := nhc.GetHttpClient("addr1")
+
+	err := nhc.ReloadNodes([]*data.NodeData{{Address: "addr2", ClientCertFile: "client.crt"}})
+	assert.True(t, errors.Is(err, process.ErrIncompleteClientCertificate))
+	assert.True(t, nhc.GetHttpClient("addr1") == clientAddr1)
+
+	err = nhc.ReloadNodes([]*data.NodeData{{Address: "addr1"}, {Address: "addr2", BearerToken: "token"}})
+	require.Nil(t, err)
+	assert.True(t, nhc.GetHttpClient("addr1") == defaultClient)
+	assert.False(t, nhc.GetHttpClient("addr2") == defaultClient)
+}
@@ -0,0 +1,77 @@
+package process
+
+import "fmt"
+
+const (
+	// QuorumEndpointAccounts identifies the account reads (address, balance, nonce, username)
+	QuorumEndpointAccounts = "accounts"
+	// QuorumEndpointVmValues identifies the smart contract queries (vm-values)
+	QuorumEndpointVmValues = "vm-values"
+)
+
+// ArgsQuorumPolicy holds the arguments needed for creating a new QuorumPolicy
+type ArgsQuorumPolicy struct {
+	NumObservers uint32
+	MinAgreeing  uint32
+	Endpoints    []string
+}
+
+// QuorumPolicy decides which reads are sent to several observers of a shard in parallel, to how many observers and
+// how many of them should give the same answer for it to be returned. If MinAgreeing is not provided, the majority
+// of NumObservers is required
+type QuorumPolicy struct {
+	numObservers uint32
+	minAgreeing  uint32
+	endpoints    map[string]struct{}
+}
+
+// NewQuorumPolicy creates a new instance of QuorumPolicy
+func NewQuorumPolicy(args ArgsQuorumPolicy) (*QuorumPolicy, error) {
+	if args.NumObservers < 2 {
+		return nil, fmt.Errorf("%w: at least 2 observers are needed, provided %d", ErrInvalidQuorum, args.NumObservers)
+	}
+
+	minAgreeing := args.MinAgreeing
+	if minAgreeing == 0 {
+		minAgreeing = args.NumObservers/2 + 1
+	}
+	// less than a majority would allow two different answers to be accepted at the same time
+	if minAgreeing <= args.NumObservers/2 || minAgreeing > args.NumObservers {
+		return nil, fmt.Errorf("%w: %d agreeing observers out of %d", ErrInvalidQuorum, minAgreeing, args.NumObservers)
+	}
+
+	endpoints := make(map[string]struct{}, len(args.Endpoints))
+	for _, endpoint := range args.Endpoints {
+		if endpoint != QuorumEndpointAccounts && endpoint != QuorumEndpointVmValues {
+			return nil, fmt.Errorf("%w: unknown endpoint %s", ErrInvalidQuorum, endpoint)
+		}
+		endpoints[endpoint] = struct{}{}
+	}
+
+	return &QuorumPolicy{
+		numObservers: args.NumObservers,
+		minAgreeing:  minAgreeing,
+		endpoints:    endpoints,
+	}, nil
+}
+
+// IsEnabledFor returns true if the reads of the given endpoint should be answered by a quorum of observers
+func (qp *QuorumPolicy) IsEnabledFor(endpoint string) bool {
+	_, found := qp.endpoints[endpoint]
+	return found
+}
+
+// NumObservers returns the number of observers queried in parallel
+func (qp *QuorumPolicy) NumObservers() uint32 {
+	return qp.numObservers
+}
+
+// MinAgreeing returns the number of observers which should give the same answer for it to be returned
+func (qp *QuorumPolicy) MinAgreeing() uint32 {
+	return qp.minAgreeing
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qp *QuorumPolicy) IsInterfaceNil() bool {
+	return qp == nil
+}
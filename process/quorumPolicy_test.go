@@ -0,0 +1,45 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewQuorumPolicy_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	qp, err := process.NewQuorumPolicy(process.ArgsQuorumPolicy{NumObservers: 1})
+	assert.True(t, check.IfNil(qp))
+	assert.True(t, errors.Is(err, process.ErrInvalidQuorum))
+
+	qp, err = process.NewQuorumPolicy(process.ArgsQuorumPolicy{NumObservers: 4, MinAgreeing: 2})
+	assert.True(t, check.IfNil(qp))
+	assert.True(t, errors.Is(err, process.ErrInvalidQuorum))
+
+	qp, err = process.NewQuorumPolicy(process.ArgsQuorumPolicy{NumObservers: 3, MinAgreeing: 4})
+	assert.True(t, check.IfNil(qp))
+	assert.True(t, errors.Is(err, process.ErrInvalidQuorum))
+
+	qp, err = process.NewQuorumPolicy(process.ArgsQuorumPolicy{NumObservers: 3, Endpoints: []string{"transactions"}})
+	assert.True(t, check.IfNil(qp))
+	assert.True(t, errors.Is(err, process.ErrInvalidQuorum))
+}
+
+func TestNewQuorumPolicy_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	qp, err := process.NewQuorumPolicy(process.ArgsQuorumPolicy{
+		NumObservers: 4,
+		Endpoints:    []string{process.QuorumEndpointAccounts},
+	})
+	assert.False(t, check.IfNil(qp))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(4), qp.NumObservers())
+	assert.Equal(t, uint32(3), qp.MinAgreeing())
+	assert.True(t, qp.IsEnabledFor(process.QuorumEndpointAccounts))
+	assert.False(t, qp.IsEnabledFor(process.QuorumEndpointVmValues))
+}
@@ -0,0 +1,190 @@
+package process
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ArgsReadinessChecker holds the arguments needed for creating a new ReadinessChecker
+type ArgsReadinessChecker struct {
+	Processor            Processor
+	ExternalStorage      ExternalStorageConnector
+	CheckExternalStorage bool
+	ProbeTimeout         time.Duration
+	ResultValidity       time.Duration
+}
+
+// ReadinessChecker tells whether the proxy can serve requests, by probing all the configured observers and, if
+// enabled, the external storage. The proxy is ready if each shard, the metachain included, has at least one reachable
+// observer. A result is reused for the configured validity, so frequent readiness probes don't flood the observers
+type ReadinessChecker struct {
+	proc                 Processor
+	externalStorage      ExternalStorageConnector
+	checkExternalStorage bool
+	probeTimeout         time.Duration
+	resultValidity       time.Duration
+	getTimeHandler       func() time.Time
+	mutReadiness         sync.Mutex
+	lastReadiness        *data.ReadinessResponse
+}
+
+// NewReadinessChecker creates a new instance of ReadinessChecker
+func NewReadinessChecker(args ArgsReadinessChecker) (*ReadinessChecker, error) {
+	if check.IfNil(args.Processor) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(args.ExternalStorage) {
+		return nil, ErrNilDatabaseConnector
+	}
+	if args.ProbeTimeout <= 0 {
+		return nil, ErrInvalidReadinessProbeTimeout
+	}
+
+	return &ReadinessChecker{
+		proc:                 args.Processor,
+		externalStorage:      args.ExternalStorage,
+		checkExternalStorage: args.CheckExternalStorage,
+		probeTimeout:         args.ProbeTimeout,
+		resultValidity:       args.ResultValidity,
+		getTimeHandler:       time.Now,
+	}, nil
+}
+
+// GetReadiness returns the readiness of the proxy. The concurrent calls wait for the same check
+func (rc *ReadinessChecker) GetReadiness() *data.ReadinessResponse {
+	rc.mutReadiness.Lock()
+	defer rc.mutReadiness.Unlock()
+
+	now := rc.getTimeHandler()
+	if rc.lastReadiness != nil && now.Sub(rc.lastReadiness.CheckTime) < rc.resultValidity {
+		return rc.lastReadiness
+	}
+
+	readiness := rc.checkReadiness(now)
+	if rc.lastReadiness != nil && rc.lastReadiness.IsReady != readiness.IsReady {
+		log.Info("proxy readiness changed", "is ready", readiness.IsReady)
+	}
+	rc.lastReadiness = readiness
+
+	return readiness
+}
+
+func (rc *ReadinessChecker) checkReadiness(now time.Time) *data.ReadinessResponse {
+	ctx, cancel := context.WithTimeout(context.Background(), rc.probeTimeout)
+	defer cancel()
+
+	readiness := &data.ReadinessResponse{
+		CheckTime: now,
+	}
+
+	wg := &sync.WaitGroup{}
+	if rc.checkExternalStorage {
+		wg.Add(1)
+		go func() {
+			readiness.ElasticSearch = rc.checkExternalStorageReadiness(ctx)
+			wg.Done()
+		}()
+	}
+
+	observers := getConfiguredNodes(rc.proc.GetObserverProvider())
+	reachableAddresses := rc.probeObservers(ctx, observers)
+	wg.Wait()
+
+	readiness.Shards = rc.computeShardsReadiness(observers, reachableAddresses)
+	readiness.IsReady = true
+	for _, shard := range readiness.Shards {
+		if !shard.IsReachable {
+			readiness.IsReady = false
+		}
+		if shard.ShardId == core.MetachainShardId {
+			readiness.IsMetachainReachable = shard.IsReachable
+		}
+	}
+
+	return readiness
+}
+
+// probeObservers returns the addresses of the observers which answered before the context's deadline
+func (rc *ReadinessChecker) probeObservers(ctx context.Context, observers []*data.NodeData) map[string]struct{} {
+	addresses := make(map[string]struct{})
+	for _, node := range observers {
+		addresses[node.Address] = struct{}{}
+	}
+
+	mutReachable := sync.Mutex{}
+	reachableAddresses := make(map[string]struct{})
+	wg := &sync.WaitGroup{}
+	wg.Add(len(addresses))
+	for address := range addresses {
+		go func(address string) {
+			var response data.GenericAPIResponse
+			_, err := rc.proc.CallGetRestEndPoint(ctx, address, NodeStatusPath, &response)
+			if err == nil {
+				mutReachable.Lock()
+				reachableAddresses[address] = struct{}{}
+				mutReachable.Unlock()
+			}
+			wg.Done()
+		}(address)
+	}
+	wg.Wait()
+
+	return reachableAddresses
+}
+
+// computeShardsReadiness returns the readiness of each shard known by the shard coordinator and of each other shard
+// having configured observers, so a shard without any configured observer is reported as unreachable as well
+func (rc *ReadinessChecker) computeShardsReadiness(
+	observers []*data.NodeData,
+	reachableAddresses map[string]struct{},
+) []*data.ShardReadiness {
+	shardsReadiness := make([]*data.ShardReadiness, 0)
+	shardsReadinessMap := make(map[uint32]*data.ShardReadiness)
+	getShardReadiness := func(shardID uint32) *data.ShardReadiness {
+		shardReadiness, found := shardsReadinessMap[shardID]
+		if !found {
+			shardReadiness = &data.ShardReadiness{ShardId: shardID}
+			shardsReadinessMap[shardID] = shardReadiness
+			shardsReadiness = append(shardsReadiness, shardReadiness)
+		}
+
+		return shardReadiness
+	}
+
+	for _, shardID := range computeShardIDs(rc.proc.GetShardCoordinator()) {
+		getShardReadiness(shardID)
+	}
+	for _, node := range observers {
+		shardReadiness := getShardReadiness(node.ShardId)
+		shardReadiness.NumConfiguredObservers++
+		_, isReachable := reachableAddresses[node.Address]
+		if isReachable {
+			shardReadiness.NumReachableObservers++
+			shardReadiness.IsReachable = true
+		}
+	}
+
+	return shardsReadiness
+}
+
+func (rc *ReadinessChecker) checkExternalStorageReadiness(ctx context.Context) *data.ExternalStorageReadiness {
+	err := rc.externalStorage.Ping(ctx)
+	if err != nil {
+		return &data.ExternalStorageReadiness{
+			IsReachable: false,
+			LastError:   err.Error(),
+		}
+	}
+
+	return &data.ExternalStorageReadiness{IsReachable: true}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *ReadinessChecker) IsInterfaceNil() bool {
+	return rc == nil
+}
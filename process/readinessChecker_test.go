@@ -0,0 +1,158 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/observer"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createProcessorStubForReadiness(observers []*data.NodeData, failingAddresses map[string]struct{}) *mock.ProcessorStub {
+	return &mock.ProcessorStub{
+		GetObserverProviderCalled: func() observer.NodesProviderHandler {
+			return &mock.ObserversProviderStub{
+				GetAllConfiguredNodesCalled: func() ([]*data.NodeData, error) {
+					return observers, nil
+				},
+			}
+		},
+		GetShardCoordinatorCalled: func() sharding.Coordinator {
+			return &mock.ShardCoordinatorMock{NumShards: 2}
+		},
+		CallGetRestEndPointCalled: func(_ context.Context, address string, _ string, _ interface{}) (int, error) {
+			_, shouldFail := failingAddresses[address]
+			if shouldFail {
+				return 0, errors.New("connection refused")
+			}
+
+			return 200, nil
+		},
+	}
+}
+
+func createArgsReadinessChecker(proc process.Processor) process.ArgsReadinessChecker {
+	return process.ArgsReadinessChecker{
+		Processor:       proc,
+		ExternalStorage: &mock.ExternalStorageConnectorStub{},
+		ProbeTimeout:    time.Second,
+	}
+}
+
+func TestNewReadinessChecker_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsReadinessChecker(nil)
+	rc, err := process.NewReadinessChecker(args)
+	assert.Nil(t, rc)
+	assert.Equal(t, process.ErrNilCoreProcessor, err)
+
+	args = createArgsReadinessChecker(&mock.ProcessorStub{})
+	args.ExternalStorage = nil
+	rc, err = process.NewReadinessChecker(args)
+	assert.Nil(t, rc)
+	assert.Equal(t, process.ErrNilDatabaseConnector, err)
+
+	args = createArgsReadinessChecker(&mock.ProcessorStub{})
+	args.ProbeTimeout = 0
+	rc, err = process.NewReadinessChecker(args)
+	assert.Nil(t, rc)
+	assert.Equal(t, process.ErrInvalidReadinessProbeTimeout, err)
+}
+
+func TestReadinessChecker_GetReadinessAllShardsReachableShouldBeReady(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "shard0-a", ShardId: 0},
+		{Address: "shard0-b", ShardId: 0},
+		{Address: "shard1", ShardId: 1},
+		{Address: "meta", ShardId: core.MetachainShardId},
+	}
+	failingAddresses := map[string]struct{}{"shard0-b": {}}
+	rc, err := process.NewReadinessChecker(createArgsReadinessChecker(createProcessorStubForReadiness(observers, failingAddresses)))
+	require.Nil(t, err)
+
+	readiness := rc.GetReadiness()
+	assert.True(t, readiness.IsReady)
+	assert.True(t, readiness.IsMetachainReachable)
+	assert.Nil(t, readiness.ElasticSearch)
+	assert.Equal(t, []*data.ShardReadiness{
+		{ShardId: 0, NumConfiguredObservers: 2, NumReachableObservers: 1, IsReachable: true},
+		{ShardId: 1, NumConfiguredObservers: 1, NumReachableObservers: 1, IsReachable: true},
+		{ShardId: core.MetachainShardId, NumConfiguredObservers: 1, NumReachableObservers: 1, IsReachable: true},
+	}, readiness.Shards)
+}
+
+func TestReadinessChecker_GetReadinessShardWithoutReachableObserverShouldNotBeReady(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "shard0", ShardId: 0},
+		{Address: "meta", ShardId: core.MetachainShardId},
+	}
+	failingAddresses := map[string]struct{}{"meta": {}}
+	rc, err := process.NewReadinessChecker(createArgsReadinessChecker(createProcessorStubForReadiness(observers, failingAddresses)))
+	require.Nil(t, err)
+
+	readiness := rc.GetReadiness()
+	assert.False(t, readiness.IsReady)
+	assert.False(t, readiness.IsMetachainReachable)
+	require.Equal(t, 3, len(readiness.Shards))
+	assert.True(t, readiness.Shards[0].IsReachable)
+	// shard 1 has no configured observer at all
+	assert.Equal(t, &data.ShardReadiness{ShardId: 1}, readiness.Shards[1])
+	assert.Equal(t, &data.ShardReadiness{ShardId: core.MetachainShardId, NumConfiguredObservers: 1}, readiness.Shards[2])
+}
+
+func TestReadinessChecker_GetReadinessShouldCheckTheExternalStorageIfEnabled(t *testing.T) {
+	t.Parallel()
+
+	observers := []*data.NodeData{
+		{Address: "shard0", ShardId: 0},
+		{Address: "shard1", ShardId: 1},
+		{Address: "meta", ShardId: core.MetachainShardId},
+	}
+	args := createArgsReadinessChecker(createProcessorStubForReadiness(observers, nil))
+	args.CheckExternalStorage = true
+	args.ExternalStorage = &mock.ExternalStorageConnectorStub{
+		PingCalled: func(_ context.Context) error {
+			return errors.New("cannot reach database")
+		},
+	}
+	rc, err := process.NewReadinessChecker(args)
+	require.Nil(t, err)
+
+	readiness := rc.GetReadiness()
+	assert.True(t, readiness.IsReady)
+	assert.Equal(t, &data.ExternalStorageReadiness{IsReachable: false, LastError: "cannot reach database"}, readiness.ElasticSearch)
+}
+
+func TestReadinessChecker_GetReadinessShouldReuseTheResultWhileValid(t *testing.T) {
+	t.Parallel()
+
+	numProbes := int32(0)
+	proc := createProcessorStubForReadiness([]*data.NodeData{{Address: "shard0", ShardId: 0}}, nil)
+	proc.CallGetRestEndPointCalled = func(_ context.Context, _ string, _ string, _ interface{}) (int, error) {
+		atomic.AddInt32(&numProbes, 1)
+		return 200, nil
+	}
+	args := createArgsReadinessChecker(proc)
+	args.ResultValidity = time.Hour
+	rc, err := process.NewReadinessChecker(args)
+	require.Nil(t, err)
+
+	firstReadiness := rc.GetReadiness()
+	secondReadiness := rc.GetReadiness()
+	assert.True(t, firstReadiness == secondReadiness)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numProbes))
+}
@@ -0,0 +1,72 @@
+package process
+
+import (
+	"context"
+	"sync"
+)
+
+type coalescedCall struct {
+	done       chan struct{}
+	value      interface{}
+	statusCode int
+	err        error
+}
+
+// RequestsCoalescer lets the identical requests which are in flight at the same time share a single upstream call:
+// the first request makes the call, while the others wait for its result instead of making their own calls
+type RequestsCoalescer struct {
+	mutCalls sync.Mutex
+	calls    map[string]*coalescedCall
+}
+
+// NewRequestsCoalescer creates a new instance of RequestsCoalescer
+func NewRequestsCoalescer() *RequestsCoalescer {
+	return &RequestsCoalescer{
+		calls: make(map[string]*coalescedCall),
+	}
+}
+
+// Do makes the given call, unless a call with the same key is already in flight, in which case it waits for the
+// result of that call, for as long as the given context allows. The returned flag is true if the result is shared
+// with another request. The shared value should be treated as read-only
+func (rc *RequestsCoalescer) Do(
+	ctx context.Context,
+	key string,
+	call func() (interface{}, int, error),
+) (interface{}, int, bool, error) {
+	rc.mutCalls.Lock()
+	inFlightCall, found := rc.calls[key]
+	if found {
+		rc.mutCalls.Unlock()
+
+		select {
+		case <-inFlightCall.done:
+			return inFlightCall.value, inFlightCall.statusCode, true, inFlightCall.err
+		case <-ctx.Done():
+			return nil, 0, true, ctx.Err()
+		}
+	}
+
+	newCall := &coalescedCall{
+		done: make(chan struct{}),
+	}
+	rc.calls[key] = newCall
+	rc.mutCalls.Unlock()
+
+	defer func() {
+		rc.mutCalls.Lock()
+		delete(rc.calls, key)
+		rc.mutCalls.Unlock()
+
+		close(newCall.done)
+	}()
+
+	newCall.value, newCall.statusCode, newCall.err = call()
+
+	return newCall.value, newCall.statusCode, false, newCall.err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *RequestsCoalescer) IsInterfaceNil() bool {
+	return rc == nil
+}
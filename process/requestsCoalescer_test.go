@@ -0,0 +1,112 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+type coalescedResult struct {
+	value      interface{}
+	statusCode int
+	isShared   bool
+	err        error
+}
+
+func doInBackground(rc *process.RequestsCoalescer, ctx context.Context, key string, call func() (interface{}, int, error)) chan coalescedResult {
+	results := make(chan coalescedResult, 1)
+	go func() {
+		value, statusCode, isShared, err := rc.Do(ctx, key, call)
+		results <- coalescedResult{value: value, statusCode: statusCode, isShared: isShared, err: err}
+	}()
+
+	return results
+}
+
+func TestNewRequestsCoalescer(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, check.IfNil(process.NewRequestsCoalescer()))
+}
+
+func TestRequestsCoalescer_DoShouldShareTheInFlightCall(t *testing.T) {
+	t.Parallel()
+
+	rc := process.NewRequestsCoalescer()
+	numCalls := uint32(0)
+	releaseCall := make(chan struct{})
+	call := func() (interface{}, int, error) {
+		atomic.AddUint32(&numCalls, 1)
+		<-releaseCall
+		return "value", http.StatusOK, nil
+	}
+
+	firstResult := doInBackground(rc, context.Background(), "key", call)
+	time.Sleep(50 * time.Millisecond)
+	secondResult := doInBackground(rc, context.Background(), "key", call)
+	otherKeyResult := doInBackground(rc, context.Background(), "other key", call)
+	time.Sleep(50 * time.Millisecond)
+	close(releaseCall)
+
+	assert.Equal(t, coalescedResult{value: "value", statusCode: http.StatusOK}, <-firstResult)
+	assert.Equal(t, coalescedResult{value: "value", statusCode: http.StatusOK, isShared: true}, <-secondResult)
+	assert.Equal(t, coalescedResult{value: "value", statusCode: http.StatusOK}, <-otherKeyResult)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numCalls))
+
+	_, _, isShared, _ := rc.Do(context.Background(), "key", call)
+	assert.False(t, isShared)
+	assert.Equal(t, uint32(3), atomic.LoadUint32(&numCalls))
+}
+
+func TestRequestsCoalescer_DoShouldShareTheError(t *testing.T) {
+	t.Parallel()
+
+	rc := process.NewRequestsCoalescer()
+	expectedErr := errors.New("expected error")
+	releaseCall := make(chan struct{})
+	call := func() (interface{}, int, error) {
+		<-releaseCall
+		return nil, http.StatusBadRequest, expectedErr
+	}
+
+	firstResult := doInBackground(rc, context.Background(), "key", call)
+	time.Sleep(50 * time.Millisecond)
+	secondResult := doInBackground(rc, context.Background(), "key", call)
+	time.Sleep(50 * time.Millisecond)
+	close(releaseCall)
+
+	assert.Equal(t, expectedErr, (<-firstResult).err)
+	result := <-secondResult
+	assert.True(t, result.isShared)
+	assert.Equal(t, http.StatusBadRequest, result.statusCode)
+	assert.Equal(t, expectedErr, result.err)
+}
+
+func TestRequestsCoalescer_DoShouldStopWaitingWhenTheContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	rc := process.NewRequestsCoalescer()
+	releaseCall := make(chan struct{})
+	defer close(releaseCall)
+	call := func() (interface{}, int, error) {
+		<-releaseCall
+		return "value", http.StatusOK, nil
+	}
+
+	_ = doInBackground(rc, context.Background(), "key", call)
+	time.Sleep(50 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, isShared, err := rc.Do(ctx, "key", call)
+
+	assert.True(t, isShared)
+	assert.Equal(t, context.Canceled, err)
+}
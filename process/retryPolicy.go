@@ -0,0 +1,59 @@
+package process
+
+import (
+	"math/rand"
+	"time"
+)
+
+// ArgsRetryPolicy holds the arguments needed for creating a new RetryPolicy
+type ArgsRetryPolicy struct {
+	MaxRetries     uint32
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// RetryPolicy decides how many times a failed request is retried and how long to wait before each retry. The
+// backoff doubles with each retry, up to MaxBackoff, and is randomized between half and the whole of its value so
+// the retries of concurrent requests are spread in time
+type RetryPolicy struct {
+	maxRetries     uint32
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// NewRetryPolicy creates a new instance of RetryPolicy
+func NewRetryPolicy(args ArgsRetryPolicy) (*RetryPolicy, error) {
+	if args.InitialBackoff <= 0 || args.MaxBackoff < args.InitialBackoff {
+		return nil, ErrInvalidRetryBackoff
+	}
+
+	return &RetryPolicy{
+		maxRetries:     args.MaxRetries,
+		initialBackoff: args.InitialBackoff,
+		maxBackoff:     args.MaxBackoff,
+	}, nil
+}
+
+// MaxRetries returns the maximum number of times a failed request is retried
+func (rp *RetryPolicy) MaxRetries() uint32 {
+	return rp.maxRetries
+}
+
+// Backoff returns the duration to wait before the given retry, starting from 0
+func (rp *RetryPolicy) Backoff(retry uint32) time.Duration {
+	backoff := rp.maxBackoff
+	if retry < 32 {
+		exponential := rp.initialBackoff << retry
+		if exponential > 0 && exponential < rp.maxBackoff {
+			backoff = exponential
+		}
+	}
+
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rp *RetryPolicy) IsInterfaceNil() bool {
+	return rp == nil
+}
@@ -0,0 +1,53 @@
+package process_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRetryPolicy_InvalidBackoffShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rp, err := process.NewRetryPolicy(process.ArgsRetryPolicy{MaxRetries: 2, InitialBackoff: 0, MaxBackoff: time.Second})
+	assert.True(t, check.IfNil(rp))
+	assert.Equal(t, process.ErrInvalidRetryBackoff, err)
+
+	rp, err = process.NewRetryPolicy(process.ArgsRetryPolicy{MaxRetries: 2, InitialBackoff: time.Second, MaxBackoff: time.Millisecond})
+	assert.True(t, check.IfNil(rp))
+	assert.Equal(t, process.ErrInvalidRetryBackoff, err)
+}
+
+func TestNewRetryPolicy_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rp, err := process.NewRetryPolicy(process.ArgsRetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Second})
+	assert.False(t, check.IfNil(rp))
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(2), rp.MaxRetries())
+}
+
+func TestRetryPolicy_BackoffShouldGrowExponentiallyWithJitterUpToTheMaximum(t *testing.T) {
+	t.Parallel()
+
+	initialBackoff := 100 * time.Millisecond
+	maxBackoff := time.Second
+	rp, _ := process.NewRetryPolicy(process.ArgsRetryPolicy{MaxRetries: 100, InitialBackoff: initialBackoff, MaxBackoff: maxBackoff})
+
+	for i := 0; i < 100; i++ {
+		backoff := rp.Backoff(0)
+		assert.True(t, backoff >= initialBackoff/2 && backoff <= initialBackoff)
+
+		backoff = rp.Backoff(2)
+		assert.True(t, backoff >= 2*initialBackoff && backoff <= 4*initialBackoff)
+
+		backoff = rp.Backoff(10)
+		assert.True(t, backoff >= maxBackoff/2 && backoff <= maxBackoff)
+
+		backoff = rp.Backoff(99)
+		assert.True(t, backoff >= maxBackoff/2 && backoff <= maxBackoff)
+	}
+}
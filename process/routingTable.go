@@ -0,0 +1,188 @@
+package process
+
+import (
+	"fmt"
+)
+
+const (
+	// RequestCategoryAccount is the category of the account reads (balance, nonce, storage, ESDT tokens and so on)
+	RequestCategoryAccount = "account"
+	// RequestCategoryTxSend is the category of the transactions sent, simulated or whose cost is computed
+	RequestCategoryTxSend = "tx-send"
+	// RequestCategoryTxGet is the category of the transaction reads and of the transaction status reads by sender
+	RequestCategoryTxGet = "tx-get"
+	// RequestCategoryTxStatus is the category of the transaction status reads without a sender
+	RequestCategoryTxStatus = "tx-status"
+	// RequestCategoryTxDestination is the category of the cross shard transactions read from their destination shard
+	RequestCategoryTxDestination = "tx-destination"
+	// RequestCategoryBlock is the category of the block reads
+	RequestCategoryBlock = "block"
+	// RequestCategoryHyperblock is the category of the blocks read for building a hyperblock
+	RequestCategoryHyperblock = "hyperblock"
+	// RequestCategoryVmQuery is the category of the smart contract queries
+	RequestCategoryVmQuery = "vm-query"
+	// RequestCategoryNetwork is the category of the network, node status, heartbeat and validator statistics reads
+	RequestCategoryNetwork = "network"
+)
+
+const (
+	// PoolObservers is the name of the pool holding all the observers
+	PoolObservers = "observers"
+	// PoolFullHistoryNodes is the name of the pool holding all the full history nodes
+	PoolFullHistoryNodes = "full-history-nodes"
+)
+
+const (
+	// FallbackOnEmpty makes a route use the nodes of its first pool which has available nodes in the requested shard
+	FallbackOnEmpty = "on-empty"
+	// FallbackOnFailure makes a route use the nodes of all its pools, in order, so a request which fails on all the
+	// nodes of a pool is sent to the nodes of the next pool
+	FallbackOnFailure = "on-failure"
+)
+
+// Route holds the ordered pools of nodes which serve a request category and the rule for using the next pool. If
+// RequiredTags is not empty, only the nodes having all these tags serve the request category
+type Route struct {
+	Pools        []string
+	Fallback     string
+	RequiredTags []string
+}
+
+// ArgsRoutingTable holds the arguments needed for creating a new RoutingTable
+type ArgsRoutingTable struct {
+	Routes      map[string]Route
+	CustomPools map[string][]string
+}
+
+// RoutingTable maps each request category to the ordered pools of nodes which serve it. Besides the observers and
+// the full history nodes, a pool can be a custom one, holding the observers and full history nodes with the given
+// addresses. The categories without a configured route keep the default one
+type RoutingTable struct {
+	routes      map[string]Route
+	customPools map[string]map[string]struct{}
+}
+
+// NewRoutingTable creates a new instance of RoutingTable
+func NewRoutingTable(args ArgsRoutingTable) (*RoutingTable, error) {
+	customPools, err := createCustomPools(args.CustomPools)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := createDefaultRoutes()
+	for category, route := range args.Routes {
+		_, isKnownCategory := routes[category]
+		if !isKnownCategory {
+			return nil, fmt.Errorf("%w: unknown request category %s", ErrInvalidRoute, category)
+		}
+
+		err = checkRoute(route, customPools)
+		if err != nil {
+			return nil, fmt.Errorf("%w for request category %s", err, category)
+		}
+		if len(route.Fallback) == 0 {
+			route.Fallback = FallbackOnEmpty
+		}
+
+		routes[category] = route
+	}
+
+	return &RoutingTable{
+		routes:      routes,
+		customPools: customPools,
+	}, nil
+}
+
+// createDefaultRoutes returns the routes the proxy used before the routing table was configurable
+func createDefaultRoutes() map[string]Route {
+	observersOnly := Route{
+		Pools:    []string{PoolObservers},
+		Fallback: FallbackOnEmpty,
+	}
+	fullHistoryNodesFirst := Route{
+		Pools:    []string{PoolFullHistoryNodes, PoolObservers},
+		Fallback: FallbackOnEmpty,
+	}
+
+	return map[string]Route{
+		RequestCategoryAccount:       observersOnly,
+		RequestCategoryTxSend:        observersOnly,
+		RequestCategoryTxGet:         fullHistoryNodesFirst,
+		RequestCategoryTxStatus:      observersOnly,
+		RequestCategoryTxDestination: observersOnly,
+		RequestCategoryBlock:         fullHistoryNodesFirst,
+		RequestCategoryHyperblock:    fullHistoryNodesFirst,
+		RequestCategoryVmQuery:       observersOnly,
+		RequestCategoryNetwork:       observersOnly,
+	}
+}
+
+func createCustomPools(pools map[string][]string) (map[string]map[string]struct{}, error) {
+	customPools := make(map[string]map[string]struct{}, len(pools))
+	for name, addresses := range pools {
+		if len(name) == 0 || name == PoolObservers || name == PoolFullHistoryNodes {
+			return nil, fmt.Errorf("%w: invalid custom pool name \"%s\"", ErrInvalidRoute, name)
+		}
+		if len(addresses) == 0 {
+			return nil, fmt.Errorf("%w: the custom pool %s has no nodes", ErrInvalidRoute, name)
+		}
+
+		customPools[name] = make(map[string]struct{}, len(addresses))
+		for _, address := range addresses {
+			customPools[name][address] = struct{}{}
+		}
+	}
+
+	return customPools, nil
+}
+
+func checkRoute(route Route, customPools map[string]map[string]struct{}) error {
+	if len(route.Pools) == 0 {
+		return fmt.Errorf("%w: no pools", ErrInvalidRoute)
+	}
+	for _, pool := range route.Pools {
+		_, isCustomPool := customPools[pool]
+		if pool != PoolObservers && pool != PoolFullHistoryNodes && !isCustomPool {
+			return fmt.Errorf("%w: unknown pool %s", ErrInvalidRoute, pool)
+		}
+	}
+	if route.Fallback != "" && route.Fallback != FallbackOnEmpty && route.Fallback != FallbackOnFailure {
+		return fmt.Errorf("%w: unknown fallback %s", ErrInvalidRoute, route.Fallback)
+	}
+	for _, tag := range route.RequiredTags {
+		if len(tag) == 0 {
+			return fmt.Errorf("%w: empty required tag", ErrInvalidRoute)
+		}
+	}
+
+	return nil
+}
+
+// GetRoute returns the route of the given request category. An unknown category is served by the observers
+func (rt *RoutingTable) GetRoute(category string) Route {
+	route, found := rt.routes[category]
+	if !found {
+		return Route{
+			Pools:    []string{PoolObservers},
+			Fallback: FallbackOnEmpty,
+		}
+	}
+
+	return route
+}
+
+// IsInCustomPool returns true if the node with the given address belongs to the given custom pool
+func (rt *RoutingTable) IsInCustomPool(pool string, address string) bool {
+	addresses, found := rt.customPools[pool]
+	if !found {
+		return false
+	}
+
+	_, found = addresses[address]
+	return found
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *RoutingTable) IsInterfaceNil() bool {
+	return rt == nil
+}
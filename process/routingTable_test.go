@@ -0,0 +1,81 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRoutingTable_InvalidRoutesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	invalidArgs := []process.ArgsRoutingTable{
+		{Routes: map[string]process.Route{"unknown": {Pools: []string{process.PoolObservers}}}},
+		{Routes: map[string]process.Route{process.RequestCategoryBlock: {}}},
+		{Routes: map[string]process.Route{process.RequestCategoryBlock: {Pools: []string{"unknown"}}}},
+		{Routes: map[string]process.Route{process.RequestCategoryBlock: {Pools: []string{process.PoolObservers}, Fallback: "unknown"}}},
+		{CustomPools: map[string][]string{process.PoolObservers: {"addr"}}},
+		{CustomPools: map[string][]string{"pool": nil}},
+		{Routes: map[string]process.Route{process.RequestCategoryVmQuery: {Pools: []string{process.PoolObservers}, RequiredTags: []string{""}}}},
+	}
+	for _, args := range invalidArgs {
+		rt, err := process.NewRoutingTable(args)
+
+		assert.True(t, check.IfNil(rt))
+		assert.True(t, errors.Is(err, process.ErrInvalidRoute))
+	}
+}
+
+func TestNewRoutingTable_WithoutRoutesShouldUseTheDefaultOnes(t *testing.T) {
+	t.Parallel()
+
+	rt, err := process.NewRoutingTable(process.ArgsRoutingTable{})
+	require.Nil(t, err)
+
+	observersOnly := process.Route{Pools: []string{process.PoolObservers}, Fallback: process.FallbackOnEmpty}
+	fullHistoryNodesFirst := process.Route{
+		Pools:    []string{process.PoolFullHistoryNodes, process.PoolObservers},
+		Fallback: process.FallbackOnEmpty,
+	}
+	assert.Equal(t, observersOnly, rt.GetRoute(process.RequestCategoryAccount))
+	assert.Equal(t, observersOnly, rt.GetRoute(process.RequestCategoryTxSend))
+	assert.Equal(t, fullHistoryNodesFirst, rt.GetRoute(process.RequestCategoryTxGet))
+	assert.Equal(t, observersOnly, rt.GetRoute(process.RequestCategoryTxStatus))
+	assert.Equal(t, observersOnly, rt.GetRoute(process.RequestCategoryTxDestination))
+	assert.Equal(t, fullHistoryNodesFirst, rt.GetRoute(process.RequestCategoryBlock))
+	assert.Equal(t, fullHistoryNodesFirst, rt.GetRoute(process.RequestCategoryHyperblock))
+	assert.Equal(t, observersOnly, rt.GetRoute(process.RequestCategoryVmQuery))
+	assert.Equal(t, observersOnly, rt.GetRoute(process.RequestCategoryNetwork))
+	assert.Equal(t, observersOnly, rt.GetRoute("unknown"))
+}
+
+func TestRoutingTable_ConfiguredRoutesAndCustomPools(t *testing.T) {
+	t.Parallel()
+
+	rt, err := process.NewRoutingTable(process.ArgsRoutingTable{
+		Routes: map[string]process.Route{
+			process.RequestCategoryVmQuery: {Pools: []string{"sc-queries", process.PoolObservers}},
+			process.RequestCategoryBlock:   {Pools: []string{process.PoolObservers}, Fallback: process.FallbackOnFailure},
+		},
+		CustomPools: map[string][]string{"sc-queries": {"addr1", "addr2"}},
+	})
+	require.Nil(t, err)
+
+	assert.Equal(t, process.Route{
+		Pools:    []string{"sc-queries", process.PoolObservers},
+		Fallback: process.FallbackOnEmpty,
+	}, rt.GetRoute(process.RequestCategoryVmQuery))
+	assert.Equal(t, process.Route{
+		Pools:    []string{process.PoolObservers},
+		Fallback: process.FallbackOnFailure,
+	}, rt.GetRoute(process.RequestCategoryBlock))
+	assert.Equal(t, []string{process.PoolFullHistoryNodes, process.PoolObservers}, rt.GetRoute(process.RequestCategoryHyperblock).Pools)
+
+	assert.True(t, rt.IsInCustomPool("sc-queries", "addr2"))
+	assert.False(t, rt.IsInCustomPool("sc-queries", "addr3"))
+	assert.False(t, rt.IsInCustomPool(process.PoolObservers, "addr1"))
+}
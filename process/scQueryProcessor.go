@@ -1,9 +1,12 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -36,7 +39,7 @@ func NewSCQueryProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (
 }
 
 // ExecuteQuery resolves the request by sending the request to the right observer and replies back the answer
-func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
+func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(ctx context.Context, query *data.SCQuery) (*vm.VMOutputApi, error) {
 	addressBytes, err := scQueryProcessor.pubKeyConverter.Decode(query.ScAddress)
 	if err != nil {
 		return nil, err
@@ -47,38 +50,64 @@ func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm
 		return nil, err
 	}
 
-	observers, err := scQueryProcessor.proc.GetObservers(shardID)
+	observers, err := scQueryProcessor.proc.GetNodesForRequest(ctx, RequestCategoryVmQuery, shardID, query.ScAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, observer := range observers {
-		request := scQueryProcessor.createRequestFromQuery(query)
+	request := scQueryProcessor.createRequestFromQuery(query)
+	readVmValue := func(ctx context.Context, observer *data.NodeData) (interface{}, int, error) {
 		response := &data.ResponseVmValue{}
-
-		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPoint(observer.Address, SCQueryServicePath, request, response)
-		isObserverDown := httpStatus == http.StatusNotFound || httpStatus == http.StatusRequestTimeout
-		isOk := httpStatus == http.StatusOK
-		responseHasExplicitError := len(response.Error) > 0
-
+		httpStatus, err := scQueryProcessor.proc.CallPostRestEndPoint(ctx, observer.Address, SCQueryServicePath, request, response)
+		return response, httpStatus, err
+	}
+	isObserverUp := func(httpStatus int, err error) bool {
+		isObserverDown := httpStatus == http.StatusNotFound ||
+			httpStatus == http.StatusRequestTimeout ||
+			httpStatus == http.StatusServiceUnavailable
 		if isObserverDown {
 			log.LogIfError(err)
-			continue
 		}
 
-		if isOk {
-			log.Debug("SC query sent successfully, received response", "observer", observer.Address, "shard", shardID)
-			return response.Data.Data, nil
-		}
+		return !isObserverDown
+	}
 
-		if responseHasExplicitError {
-			return nil, fmt.Errorf(response.Error)
+	answer, err := scQueryProcessor.proc.ReadFromObserversWithQuorum(ctx, QuorumEndpointVmValues, observers, readVmValue, isObserverUp, vmValueDigest)
+	if err != nil {
+		if errors.Is(err, ErrObserversDisagreement) || errors.Is(err, ErrObserversOverloaded) {
+			log.Error("SC query", "address", query.ScAddress, "function", query.FuncName, "error", err.Error())
+			return nil, err
 		}
+		return nil, ErrSendingRequest
+	}
 
-		return nil, err
+	response := answer.Value.(*data.ResponseVmValue)
+	if answer.StatusCode == http.StatusOK {
+		log.Debug("SC query sent successfully, received response", "observer", answer.Observer.Address, "shard", shardID)
+		return response.Data.Data, nil
+	}
+	if len(response.Error) > 0 {
+		return nil, fmt.Errorf(response.Error)
+	}
+
+	return nil, answer.Err
+}
+
+// vmValueDigest describes the outcome of a SC query, as compared between the observers of a quorum read
+func vmValueDigest(value interface{}) (string, error) {
+	response := value.(*data.ResponseVmValue)
+	if response.Data.Data == nil {
+		return fmt.Sprintf("error %s", response.Error), nil
+	}
+
+	vmOutput := response.Data.Data
+	returnData := make([]string, len(vmOutput.ReturnData))
+	for i, returnValue := range vmOutput.ReturnData {
+		returnData[i] = hex.EncodeToString(returnValue)
 	}
 
-	return nil, ErrSendingRequest
+	return fmt.Sprintf("return code %s, return message %s, return data [%s], error %s",
+		vmOutput.ReturnCode, vmOutput.ReturnMessage, strings.Join(returnData, ", "), response.Error), nil
 }
 
 func (scQueryProcessor *SCQueryProcessor) createRequestFromQuery(query *data.SCQuery) data.VmValueRequest {
@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -49,7 +50,7 @@ func TestSCQueryProcessor_ExecuteQueryComputeShardIdFailsShouldErr(t *testing.T)
 		},
 	}, testPubKeyConverter)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -67,7 +68,7 @@ func TestSCQueryProcessor_ExecuteQueryGetObserversFailsShouldErr(t *testing.T) {
 		},
 	}, testPubKeyConverter)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -86,12 +87,12 @@ func TestSCQueryProcessor_ExecuteQuerySendingFailsOnAllObserversShouldErr(t *tes
 				{Address: "address2", ShardId: 0},
 			}, nil
 		},
-		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
+		CallPostRestEndPointCalled: func(_ context.Context, address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusNotFound, errExpected
 		},
 	}, testPubKeyConverter)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, ErrSendingRequest, err)
 }
@@ -108,7 +109,7 @@ func TestSCQueryProcessor_ExecuteQuery(t *testing.T) {
 				{Address: "adress1", ShardId: 0},
 			}, nil
 		},
-		CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+		CallPostRestEndPointCalled: func(_ context.Context, address string, path string, dataValue interface{}, response interface{}) (int, error) {
 			response.(*data.ResponseVmValue).Data.Data = &vm.VMOutputApi{
 				ReturnData: [][]byte{{42}},
 			}
@@ -117,7 +118,7 @@ func TestSCQueryProcessor_ExecuteQuery(t *testing.T) {
 		},
 	}, testPubKeyConverter)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{
 		ScAddress: dummyScAddress,
 		FuncName:  "function",
 		Arguments: [][]byte{[]byte("aa")},
@@ -141,12 +142,12 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnRandomErrorShouldErr(t *testing.T)
 				{Address: "address2", ShardId: 0},
 			}, nil
 		},
-		CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
+		CallPostRestEndPointCalled: func(_ context.Context, address string, path string, data interface{}, response interface{}) (int, error) {
 			return http.StatusInternalServerError, errExpected
 		},
 	}, testPubKeyConverter)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
@@ -165,13 +166,13 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnBadRequestWithExplicitErrorShouldEr
 				{Address: "address2", ShardId: 0},
 			}, nil
 		},
-		CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+		CallPostRestEndPointCalled: func(_ context.Context, address string, path string, dataValue interface{}, response interface{}) (int, error) {
 			response.(*data.ResponseVmValue).Error = errExpected.Error()
 			return http.StatusBadRequest, nil
 		},
 	}, testPubKeyConverter)
 
-	value, err := processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress})
+	value, err := processor.ExecuteQuery(context.Background(), &data.SCQuery{ScAddress: dummyScAddress})
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
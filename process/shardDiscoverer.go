@@ -0,0 +1,193 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ArgsShardDiscoverer holds the arguments needed for creating a new ShardDiscoverer
+type ArgsShardDiscoverer struct {
+	HttpClients            NodesHttpClientsHandler
+	RejectMismatchingNodes bool
+}
+
+// ShardDiscoverer asks the nodes for their actual shard ID and for the number of shards of the network, so the
+// proxy does not rely only on the hand-written ShardId of each node
+type ShardDiscoverer struct {
+	httpClients            NodesHttpClientsHandler
+	rejectMismatchingNodes bool
+}
+
+// NewShardDiscoverer creates a new instance of ShardDiscoverer
+func NewShardDiscoverer(args ArgsShardDiscoverer) (*ShardDiscoverer, error) {
+	if check.IfNil(args.HttpClients) {
+		return nil, ErrNilNodesHttpClients
+	}
+
+	return &ShardDiscoverer{
+		httpClients:            args.HttpClients,
+		rejectMismatchingNodes: args.RejectMismatchingNodes,
+	}, nil
+}
+
+// DiscoverShards queries all the given nodes and returns the number of shards reported by most of them, or 0 if
+// none answered, along with the nodes which should be used. The ShardId of each node which answers is set to the
+// reported shard. A node which reports another shard than the one explicitly configured, or another number of shards
+// than most of the nodes, is either dropped, if mismatching nodes are rejected, or kept with a warning. The nodes
+// which do not answer are kept with their configured shard, as they cannot be checked, or dropped if their shard is
+// not configured, as it is unknown
+func (sd *ShardDiscoverer) DiscoverShards(nodes []*data.NodeData) ([]*data.NodeData, uint32) {
+	metrics := sd.queryNodes(nodes)
+	numShards := computeMostReportedNumShards(metrics)
+
+	validNodes := make([]*data.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		nodeMetrics, answered := metrics[node.Address]
+		if !answered && node.ConfiguredShardId == nil {
+			log.Error("node rejected, its shard is neither configured nor discovered", "address", node.Address)
+			continue
+		}
+		if !answered {
+			log.Warn("cannot discover the shard of node, keeping the configured one",
+				"address", node.Address, "configured shard", node.ShardId)
+			validNodes = append(validNodes, node)
+			continue
+		}
+
+		err := checkDiscoveredNodeShard(node, nodeMetrics, numShards)
+		if err != nil && sd.rejectMismatchingNodes {
+			log.Error("node rejected", "address", node.Address, "error", err.Error())
+			continue
+		}
+		if err != nil {
+			log.Warn("node contradicts the config", "address", node.Address, "error", err.Error())
+		}
+
+		node.ShardId = nodeMetrics.shardID
+		validNodes = append(validNodes, node)
+	}
+
+	return validNodes, numShards
+}
+
+// DiscoverNodesShards runs the shard discovery on the observers and on the full history nodes together and returns
+// the nodes of each list which should be used, along with the number of shards reported by most of the nodes
+func (sd *ShardDiscoverer) DiscoverNodesShards(
+	observers []*data.NodeData,
+	fullHistoryNodes []*data.NodeData,
+) ([]*data.NodeData, []*data.NodeData, uint32) {
+	nodes := make([]*data.NodeData, 0, len(observers)+len(fullHistoryNodes))
+	nodes = append(nodes, observers...)
+	nodes = append(nodes, fullHistoryNodes...)
+	validNodes, numShards := sd.DiscoverShards(nodes)
+
+	isValidNode := make(map[*data.NodeData]struct{}, len(validNodes))
+	for _, node := range validNodes {
+		isValidNode[node] = struct{}{}
+	}
+
+	return filterValidNodes(observers, isValidNode), filterValidNodes(fullHistoryNodes, isValidNode), numShards
+}
+
+func filterValidNodes(nodes []*data.NodeData, isValidNode map[*data.NodeData]struct{}) []*data.NodeData {
+	validNodes := make([]*data.NodeData, 0, len(nodes))
+	for _, node := range nodes {
+		if _, ok := isValidNode[node]; ok {
+			validNodes = append(validNodes, node)
+		}
+	}
+
+	return validNodes
+}
+
+func (sd *ShardDiscoverer) queryNodes(nodes []*data.NodeData) map[string]nodeStatusMetrics {
+	addresses := make(map[string]struct{})
+	for _, node := range nodes {
+		addresses[node.Address] = struct{}{}
+	}
+
+	mutMetrics := sync.Mutex{}
+	metrics := make(map[string]nodeStatusMetrics)
+	wg := &sync.WaitGroup{}
+	wg.Add(len(addresses))
+	for address := range addresses {
+		go func(address string) {
+			defer wg.Done()
+
+			nodeMetrics, err := sd.queryNode(address)
+			if err != nil {
+				log.Debug("shard discovery request", "address", address, "error", err.Error())
+				return
+			}
+
+			mutMetrics.Lock()
+			metrics[address] = nodeMetrics
+			mutMetrics.Unlock()
+		}(address)
+	}
+	wg.Wait()
+
+	return metrics
+}
+
+func (sd *ShardDiscoverer) queryNode(address string) (nodeStatusMetrics, error) {
+	response := &data.GenericAPIResponse{}
+	_, err := sendGetRequest(context.Background(), address, NodeStatusPath, response, sd.httpClients.GetHttpClient(address).Do)
+	if err != nil {
+		return nodeStatusMetrics{}, err
+	}
+
+	metrics := parseNodeStatusMetrics(response)
+	if !metrics.hasShardID {
+		return nodeStatusMetrics{}, ErrShardIDNotReported
+	}
+
+	return metrics, nil
+}
+
+func computeMostReportedNumShards(metrics map[string]nodeStatusMetrics) uint32 {
+	counts := make(map[uint32]int)
+	for _, nodeMetrics := range metrics {
+		if nodeMetrics.hasNumShards {
+			counts[nodeMetrics.numShards]++
+		}
+	}
+
+	return mostReportedUint32(counts)
+}
+
+// checkDiscoveredNodeShard checks the metrics reported by a node against its explicitly configured shard, if any, and
+// against the number of shards reported by most of the nodes
+func checkDiscoveredNodeShard(node *data.NodeData, metrics nodeStatusMetrics, numShards uint32) error {
+	if node.ConfiguredShardId != nil && *node.ConfiguredShardId != metrics.shardID {
+		return fmt.Errorf("%w: configured shard %d, reported shard %d", ErrShardMismatch, *node.ConfiguredShardId, metrics.shardID)
+	}
+
+	return checkNumShards(metrics, numShards)
+}
+
+func checkNodeShard(node *data.NodeData, metrics nodeStatusMetrics, numShards uint32) error {
+	if metrics.hasShardID && metrics.shardID != node.ShardId {
+		return fmt.Errorf("%w: configured shard %d, reported shard %d", ErrShardMismatch, node.ShardId, metrics.shardID)
+	}
+
+	return checkNumShards(metrics, numShards)
+}
+
+func checkNumShards(metrics nodeStatusMetrics, numShards uint32) error {
+	if metrics.hasNumShards && numShards > 0 && metrics.numShards != numShards {
+		return fmt.Errorf("%w: the node reports %d shards, while most of the nodes report %d shards",
+			ErrShardMismatch, metrics.numShards, numShards)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *ShardDiscoverer) IsInterfaceNil() bool {
+	return sd == nil
+}
@@ -0,0 +1,186 @@
+package process_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func createNodeStatusServer(shardID uint32, numShards uint32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != process.NodeStatusPath {
+			rw.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = fmt.Fprintf(rw, `{"data":{"metrics":{"erd_shard_id":%d,"erd_num_shards_without_meta":%d,"erd_nonce":100}},"code":"successful"}`,
+			shardID, numShards)
+	}))
+}
+
+func configuredShard(shardID uint32) *uint32 {
+	return &shardID
+}
+
+func createShardDiscoverer(rejectMismatchingNodes bool) *process.ShardDiscoverer {
+	args := process.ArgsShardDiscoverer{
+		HttpClients:            createNodesHttpClients(time.Second),
+		RejectMismatchingNodes: rejectMismatchingNodes,
+	}
+	sd, _ := process.NewShardDiscoverer(args)
+
+	return sd
+}
+
+func TestNewShardDiscoverer_NilHttpClientsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sd, err := process.NewShardDiscoverer(process.ArgsShardDiscoverer{})
+
+	assert.True(t, check.IfNil(sd))
+	assert.Equal(t, process.ErrNilNodesHttpClients, err)
+}
+
+func TestNewShardDiscoverer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sd, err := process.NewShardDiscoverer(process.ArgsShardDiscoverer{HttpClients: createNodesHttpClients(time.Second)})
+
+	assert.False(t, check.IfNil(sd))
+	assert.Nil(t, err)
+}
+
+func TestShardDiscoverer_DiscoverShardsShouldReturnTheNumberOfShardsReportedByMostNodes(t *testing.T) {
+	t.Parallel()
+
+	server0 := createNodeStatusServer(0, 3)
+	defer server0.Close()
+	server1 := createNodeStatusServer(1, 3)
+	defer server1.Close()
+	server2 := createNodeStatusServer(2, 3)
+	defer server2.Close()
+
+	nodes := []*data.NodeData{
+		{Address: server0.URL, ShardId: 0},
+		{Address: server1.URL, ShardId: 1},
+		{Address: server2.URL, ShardId: 2},
+	}
+	validNodes, numShards := createShardDiscoverer(true).DiscoverShards(nodes)
+
+	assert.Equal(t, uint32(3), numShards)
+	assert.Equal(t, nodes, validNodes)
+}
+
+func TestShardDiscoverer_DiscoverShardsShouldRejectMismatchingNodes(t *testing.T) {
+	t.Parallel()
+
+	server0 := createNodeStatusServer(0, 2)
+	defer server0.Close()
+	server1 := createNodeStatusServer(1, 2)
+	defer server1.Close()
+	otherNetworkServer := createNodeStatusServer(1, 5)
+	defer otherNetworkServer.Close()
+
+	nodes := []*data.NodeData{
+		{Address: server0.URL, ConfiguredShardId: configuredShard(0)},
+		{Address: server1.URL, ConfiguredShardId: configuredShard(0)},
+		{Address: otherNetworkServer.URL, ConfiguredShardId: configuredShard(1)},
+	}
+	validNodes, numShards := createShardDiscoverer(true).DiscoverShards(nodes)
+
+	assert.Equal(t, uint32(2), numShards)
+	assert.Equal(t, []*data.NodeData{nodes[0]}, validNodes)
+}
+
+func TestShardDiscoverer_DiscoverShardsShouldKeepMismatchingNodesIfNotRejected(t *testing.T) {
+	t.Parallel()
+
+	server := createNodeStatusServer(1, 2)
+	defer server.Close()
+
+	nodes := []*data.NodeData{{Address: server.URL, ConfiguredShardId: configuredShard(0)}}
+	validNodes, numShards := createShardDiscoverer(false).DiscoverShards(nodes)
+
+	assert.Equal(t, uint32(2), numShards)
+	assert.Equal(t, nodes, validNodes)
+	assert.Equal(t, uint32(1), validNodes[0].ShardId)
+}
+
+func TestShardDiscoverer_DiscoverShardsShouldAssignTheReportedShards(t *testing.T) {
+	t.Parallel()
+
+	server0 := createNodeStatusServer(0, 2)
+	defer server0.Close()
+	server1 := createNodeStatusServer(1, 2)
+	defer server1.Close()
+
+	// the ShardId of a node without a configured shard is 0, as when the key is missing from the config
+	nodes := []*data.NodeData{
+		{Address: server0.URL},
+		{Address: server1.URL},
+		{Address: server1.URL, ShardId: 1, ConfiguredShardId: configuredShard(1)},
+	}
+	validNodes, numShards := createShardDiscoverer(true).DiscoverShards(nodes)
+
+	assert.Equal(t, uint32(2), numShards)
+	assert.Equal(t, nodes, validNodes)
+	assert.Equal(t, uint32(0), validNodes[0].ShardId)
+	assert.Equal(t, uint32(1), validNodes[1].ShardId)
+	assert.Equal(t, uint32(1), validNodes[2].ShardId)
+}
+
+func TestShardDiscoverer_DiscoverShardsShouldKeepTheNodesWhichDoNotAnswer(t *testing.T) {
+	t.Parallel()
+
+	server := createNodeStatusServer(0, 2)
+	unreachableAddress := server.URL
+	server.Close()
+
+	nodes := []*data.NodeData{{Address: unreachableAddress, ShardId: 1, ConfiguredShardId: configuredShard(1)}}
+	validNodes, numShards := createShardDiscoverer(true).DiscoverShards(nodes)
+
+	assert.Equal(t, uint32(0), numShards)
+	assert.Equal(t, nodes, validNodes)
+}
+
+func TestShardDiscoverer_DiscoverShardsShouldDropTheNodesWithUnknownShards(t *testing.T) {
+	t.Parallel()
+
+	server := createNodeStatusServer(0, 2)
+	unreachableAddress := server.URL
+	server.Close()
+
+	nodes := []*data.NodeData{{Address: unreachableAddress}}
+	validNodes, numShards := createShardDiscoverer(false).DiscoverShards(nodes)
+
+	assert.Equal(t, uint32(0), numShards)
+	assert.Empty(t, validNodes)
+}
+
+func TestShardDiscoverer_DiscoverNodesShardsShouldFilterEachList(t *testing.T) {
+	t.Parallel()
+
+	server := createNodeStatusServer(1, 2)
+	defer server.Close()
+	mismatchingServer := createNodeStatusServer(1, 2)
+	defer mismatchingServer.Close()
+
+	observers := []*data.NodeData{
+		{Address: server.URL, ConfiguredShardId: configuredShard(1)},
+		{Address: mismatchingServer.URL, ConfiguredShardId: configuredShard(0)},
+	}
+	fullHistoryNodes := []*data.NodeData{{Address: server.URL}}
+	validObservers, validFullHistoryNodes, numShards := createShardDiscoverer(true).DiscoverNodesShards(observers, fullHistoryNodes)
+
+	assert.Equal(t, uint32(2), numShards)
+	assert.Equal(t, observers[:1], validObservers)
+	assert.Equal(t, fullHistoryNodes, validFullHistoryNodes)
+	assert.Equal(t, uint32(1), validFullHistoryNodes[0].ShardId)
+}
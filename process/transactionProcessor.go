@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -36,13 +37,6 @@ const UnknownStatusTx = "unknown"
 
 const withResultsParam = "?withResults=true"
 
-type requestType int
-
-const (
-	requestTypeObservers        requestType = 0
-	requestTypeFullHistoryNodes requestType = 1
-)
-
 type erdTransaction struct {
 	Nonce     uint64 `json:"nonce"`
 	Value     string `json:"value"`
@@ -56,12 +50,15 @@ type erdTransaction struct {
 	Version   uint32 `json:"version"`
 }
 
-// TransactionProcessor is able to process transaction requests
+// TransactionProcessor is able to process transaction requests. The transactions read by hash are kept in the responses
+// cache once their status cannot change anymore
 type TransactionProcessor struct {
 	proc            Processor
 	pubKeyConverter core.PubkeyConverter
 	hasher          hashing.Hasher
 	marshalizer     marshal.Marshalizer
+	responsesCache  ResponsesCacheHandler
+	finalNonces     FinalNoncesHandler
 }
 
 // NewTransactionProcessor creates a new instance of TransactionProcessor
@@ -70,6 +67,8 @@ func NewTransactionProcessor(
 	pubKeyConverter core.PubkeyConverter,
 	hasher hashing.Hasher,
 	marshalizer marshal.Marshalizer,
+	responsesCache ResponsesCacheHandler,
+	finalNonces FinalNoncesHandler,
 ) (*TransactionProcessor, error) {
 	if check.IfNil(proc) {
 		return nil, ErrNilCoreProcessor
@@ -83,17 +82,25 @@ func NewTransactionProcessor(
 	if check.IfNil(marshalizer) {
 		return nil, ErrNilMarshalizer
 	}
+	if check.IfNil(responsesCache) {
+		return nil, ErrNilResponsesCache
+	}
+	if check.IfNil(finalNonces) {
+		return nil, ErrNilFinalNoncesHandler
+	}
 
 	return &TransactionProcessor{
 		proc:            proc,
 		pubKeyConverter: pubKeyConverter,
 		hasher:          hasher,
 		marshalizer:     marshalizer,
+		responsesCache:  responsesCache,
+		finalNonces:     finalNonces,
 	}, nil
 }
 
 // SendTransaction relays the post request by sending the request to the right observer and replies back the answer
-func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (tp *TransactionProcessor) SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return http.StatusBadRequest, "", err
@@ -109,7 +116,7 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 		return http.StatusInternalServerError, "", err
 	}
 
-	observers, err := tp.proc.GetObservers(shardID)
+	observers, err := tp.proc.GetNodesForRequest(ctx, RequestCategoryTxSend, shardID, "")
 	if err != nil {
 		return http.StatusInternalServerError, "", err
 	}
@@ -117,7 +124,7 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 	for _, observer := range observers {
 		txResponse := &data.ResponseTransaction{}
 
-		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, TransactionSendPath, tx, txResponse)
+		respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, TransactionSendPath, tx, txResponse)
 		if respCode == http.StatusOK && err == nil {
 			log.Info(fmt.Sprintf("Transaction sent successfully to observer %v from shard %v, received tx hash %s",
 				observer.Address,
@@ -141,7 +148,7 @@ func (tp *TransactionProcessor) SendTransaction(tx *data.Transaction) (int, stri
 }
 
 // SimulateTransaction relays the post request by sending the request to the right observer and replies back the answer
-func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction) (*data.GenericAPIResponse, error) {
+func (tp *TransactionProcessor) SimulateTransaction(ctx context.Context, tx *data.Transaction) (*data.GenericAPIResponse, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return nil, err
@@ -157,12 +164,12 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction) (*data
 		return nil, err
 	}
 
-	observers, err := tp.proc.GetObservers(senderShardID)
+	observers, err := tp.proc.GetNodesForRequest(ctx, RequestCategoryTxSend, senderShardID, "")
 	if err != nil {
 		return nil, err
 	}
 
-	response, err := tp.simulateTransaction(observers, tx)
+	response, err := tp.simulateTransaction(ctx, observers, tx)
 	if err != nil {
 		return nil, fmt.Errorf("%w while trying to simulate on sender shard (shard %d)", err, senderShardID)
 	}
@@ -185,12 +192,12 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction) (*data
 		}, nil
 	}
 
-	observersForReceiverShard, err := tp.proc.GetObservers(receiverShardID)
+	observersForReceiverShard, err := tp.proc.GetNodesForRequest(ctx, RequestCategoryTxSend, receiverShardID, "")
 	if err != nil {
 		return nil, err
 	}
 
-	responseFromReceiverShard, err := tp.simulateTransaction(observersForReceiverShard, tx)
+	responseFromReceiverShard, err := tp.simulateTransaction(ctx, observersForReceiverShard, tx)
 	if err != nil {
 		return nil, fmt.Errorf("%w while trying to simulate on receiver shard (shard %d)", err, receiverShardID)
 	}
@@ -208,11 +215,11 @@ func (tp *TransactionProcessor) SimulateTransaction(tx *data.Transaction) (*data
 	}, nil
 }
 
-func (tp *TransactionProcessor) simulateTransaction(observers []*data.NodeData, tx *data.Transaction) (*data.ResponseTransactionSimulation, error) {
+func (tp *TransactionProcessor) simulateTransaction(ctx context.Context, observers []*data.NodeData, tx *data.Transaction) (*data.ResponseTransactionSimulation, error) {
 	for _, observer := range observers {
 		txResponse := &data.ResponseTransactionSimulation{}
 
-		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, TransactionSimulatePath, tx, txResponse)
+		respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, TransactionSimulatePath, tx, txResponse)
 		if respCode == http.StatusOK && err == nil {
 			log.Info(fmt.Sprintf("Transaction simulation sent successfully to observer %v from shard %v, received tx hash %s",
 				observer.Address,
@@ -236,7 +243,7 @@ func (tp *TransactionProcessor) simulateTransaction(observers []*data.NodeData,
 }
 
 // SendMultipleTransactions relays the post request by sending the request to the first available observer and replies back the answer
-func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction) (
+func (tp *TransactionProcessor) SendMultipleTransactions(ctx context.Context, txs []*data.Transaction) (
 	data.MultipleTransactionsResponseData, error,
 ) {
 	//TODO: Analyze and improve the robustness of this function. Currently, an error within `GetObservers`
@@ -263,14 +270,14 @@ func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction
 	txsHashes := make(map[int]string)
 	txsByShardID := tp.groupTxsByShard(txsToSend)
 	for shardID, groupOfTxs := range txsByShardID {
-		observersInShard, err := tp.proc.GetObservers(shardID)
+		observersInShard, err := tp.proc.GetNodesForRequest(ctx, RequestCategoryTxSend, shardID, "")
 		if err != nil {
 			return data.MultipleTransactionsResponseData{}, ErrMissingObserver
 		}
 
 		for _, observer := range observersInShard {
 			txResponse := &data.ResponseMultipleTransactions{}
-			respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, MultipleTransactionsPath, groupOfTxs, txResponse)
+			respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, MultipleTransactionsPath, groupOfTxs, txResponse)
 			if respCode == http.StatusOK && err == nil {
 				log.Info("transactions sent",
 					"observer", observer.Address,
@@ -297,13 +304,13 @@ func (tp *TransactionProcessor) SendMultipleTransactions(txs []*data.Transaction
 }
 
 // TransactionCostRequest should return how many gas units a transaction will cost
-func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (string, error) {
+func (tp *TransactionProcessor) TransactionCostRequest(ctx context.Context, tx *data.Transaction) (string, error) {
 	err := tp.checkTransactionFields(tx)
 	if err != nil {
 		return "", err
 	}
 
-	observers, err := tp.proc.GetAllObservers()
+	observers, err := tp.proc.GetAllNodesForRequest(RequestCategoryTxSend)
 	if err != nil {
 		return "", err
 	}
@@ -314,7 +321,7 @@ func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (st
 		}
 
 		txCostResponse := &data.ResponseTxCost{}
-		respCode, err := tp.proc.CallPostRestEndPoint(observer.Address, TransactionCostPath, tx, txCostResponse)
+		respCode, err := tp.proc.CallPostRestEndPoint(ctx, observer.Address, TransactionCostPath, tx, txCostResponse)
 		if respCode == http.StatusOK && err == nil {
 			log.Info("calculate tx cost request was sent successfully",
 				"observer ", observer.Address,
@@ -338,31 +345,67 @@ func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (st
 }
 
 // GetTransaction should return a transaction from observer
-func (tp *TransactionProcessor) GetTransaction(txHash string, withResults bool) (*data.FullTransaction, error) {
-	tx, err := tp.getTxFromObservers(txHash, requestTypeFullHistoryNodes, withResults)
+func (tp *TransactionProcessor) GetTransaction(ctx context.Context, txHash string, withResults bool) (*data.FullTransaction, error) {
+	cacheKey := fmt.Sprintf("transaction/hash/%s/%t", txHash, withResults)
+	cachedTx := &data.FullTransaction{}
+	if tp.responsesCache.Get(cacheKey, cachedTx) {
+		return cachedTx, nil
+	}
+
+	tx, err := tp.getTxFromObservers(ctx, txHash, RequestCategoryTxGet, withResults)
 	if err != nil {
 		return nil, err
 	}
 
 	tx.HyperblockNonce = tx.NotarizedAtDestinationInMetaNonce
 	tx.HyperblockHash = tx.NotarizedAtDestinationInMetaHash
+	if tp.isTransactionFinal(tx) {
+		tp.responsesCache.Put(cacheKey, tx)
+	}
+
 	return tx, nil
 }
 
 //GetTransactionByHashAndSenderAddress returns a transaction
 func (tp *TransactionProcessor) GetTransactionByHashAndSenderAddress(
+	ctx context.Context,
 	txHash string,
 	sndAddr string,
 	withEvents bool,
 ) (*data.FullTransaction, int, error) {
-	tx, err := tp.getTxWithSenderAddr(txHash, sndAddr, withEvents)
+	cacheKey := fmt.Sprintf("transaction/hash/%s/sender/%s/%t", txHash, sndAddr, withEvents)
+	cachedTx := &data.FullTransaction{}
+	if tp.responsesCache.Get(cacheKey, cachedTx) {
+		return cachedTx, http.StatusOK, nil
+	}
+
+	tx, err := tp.getTxWithSenderAddr(ctx, txHash, sndAddr, withEvents)
 	if err != nil {
 		return nil, http.StatusNotFound, err
 	}
 
+	if tp.isTransactionFinal(tx) {
+		tp.responsesCache.Put(cacheKey, tx)
+	}
+
 	return tx, http.StatusOK, nil
 }
 
+// isTransactionFinal returns true if the transaction was executed, or found invalid, and was notarized at destination
+// in a final metachain block, so its status cannot change anymore
+func (tp *TransactionProcessor) isTransactionFinal(tx *data.FullTransaction) bool {
+	isStatusFinal := tx.Status == transaction.TxStatusSuccess ||
+		tx.Status == transaction.TxStatusFail ||
+		tx.Status == transaction.TxStatusInvalid
+	if !isStatusFinal || tx.NotarizedAtDestinationInMetaNonce == 0 {
+		return false
+	}
+
+	highestFinalNonce, ok := tp.finalNonces.GetHighestFinalNonce(core.MetachainShardId)
+
+	return ok && tx.NotarizedAtDestinationInMetaNonce <= highestFinalNonce
+}
+
 func (tp *TransactionProcessor) getShardByAddress(address string) (uint32, error) {
 	var shardID uint32
 	if metachainIDStr := fmt.Sprintf("%d", core.MetachainShardId); address != metachainIDStr {
@@ -383,9 +426,9 @@ func (tp *TransactionProcessor) getShardByAddress(address string) (uint32, error
 }
 
 // GetTransactionStatus returns the status of a transaction
-func (tp *TransactionProcessor) GetTransactionStatus(txHash string, sender string) (string, error) {
+func (tp *TransactionProcessor) GetTransactionStatus(ctx context.Context, txHash string, sender string) (string, error) {
 	if sender != "" {
-		tx, err := tp.getTxWithSenderAddr(txHash, sender, false)
+		tx, err := tp.getTxWithSenderAddr(ctx, txHash, sender, false)
 		if err != nil {
 			return UnknownStatusTx, err
 		}
@@ -394,7 +437,7 @@ func (tp *TransactionProcessor) GetTransactionStatus(txHash string, sender strin
 	}
 
 	// get status of transaction from random observers
-	tx, err := tp.getTxFromObservers(txHash, requestTypeObservers, false)
+	tx, err := tp.getTxFromObservers(ctx, txHash, RequestCategoryTxStatus, false)
 	if err != nil {
 		return UnknownStatusTx, errors.ErrTransactionNotFound
 	}
@@ -402,10 +445,10 @@ func (tp *TransactionProcessor) GetTransactionStatus(txHash string, sender strin
 	return string(tx.Status), nil
 }
 
-func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType requestType, withResults bool) (*data.FullTransaction, error) {
+func (tp *TransactionProcessor) getTxFromObservers(ctx context.Context, txHash string, category string, withResults bool) (*data.FullTransaction, error) {
 	observersShardIDs := tp.proc.GetShardIDs()
 	for _, observerShardID := range observersShardIDs {
-		nodesInShard, err := tp.getNodesInShard(observerShardID, reqType)
+		nodesInShard, err := tp.proc.GetNodesForRequest(ctx, category, observerShardID, "")
 		if err != nil {
 			return nil, err
 		}
@@ -414,7 +457,7 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		var withHttpError bool
 		var ok bool
 		for _, observerInShard := range nodesInShard {
-			getTxResponse, ok, withHttpError = tp.getTxFromObserver(observerInShard, txHash, withResults)
+			getTxResponse, ok, withHttpError = tp.getTxFromObserver(ctx, observerInShard, txHash, withResults)
 			if !withHttpError {
 				break
 			}
@@ -447,11 +490,11 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 		if observerIsInDestShard {
 			// need to get transaction from source shard and merge scResults
 			// if withEvents is true
-			return tp.alterTxWithScResultsFromSourceIfNeeded(txHash, &getTxResponse.Data.Transaction, withResults), nil
+			return tp.alterTxWithScResultsFromSourceIfNeeded(ctx, txHash, &getTxResponse.Data.Transaction, withResults), nil
 		}
 
 		// get transaction from observer that is in destination shard
-		txFromDstShard, ok := tp.getTxFromDestShard(txHash, rcvShardID, withResults)
+		txFromDstShard, ok := tp.getTxFromDestShard(ctx, txHash, rcvShardID, withResults)
 		if ok {
 			alteredTxFromDest := mergeScResultsFromSourceAndDestIfNeeded(&getTxResponse.Data.Transaction, txFromDstShard, withResults)
 			return alteredTxFromDest, nil
@@ -465,18 +508,18 @@ func (tp *TransactionProcessor) getTxFromObservers(txHash string, reqType reques
 	return nil, errors.ErrTransactionNotFound
 }
 
-func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash string, tx *data.FullTransaction, withResults bool) *data.FullTransaction {
+func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(ctx context.Context, txHash string, tx *data.FullTransaction, withResults bool) *data.FullTransaction {
 	if !withResults || len(tx.ScResults) == 0 {
 		return tx
 	}
 
-	observers, err := tp.getNodesInShard(tx.SourceShard, requestTypeFullHistoryNodes)
+	observers, err := tp.proc.GetNodesForRequest(ctx, RequestCategoryTxGet, tx.SourceShard, "")
 	if err != nil {
 		return tx
 	}
 
 	for _, observer := range observers {
-		getTxResponse, ok, _ := tp.getTxFromObserver(observer, txHash, withResults)
+		getTxResponse, ok, _ := tp.getTxFromObserver(ctx, observer, txHash, withResults)
 		if !ok {
 			continue
 		}
@@ -488,19 +531,19 @@ func (tp *TransactionProcessor) alterTxWithScResultsFromSourceIfNeeded(txHash st
 	return tx
 }
 
-func (tp *TransactionProcessor) getTxWithSenderAddr(txHash, sender string, withEvents bool) (*data.FullTransaction, error) {
+func (tp *TransactionProcessor) getTxWithSenderAddr(ctx context.Context, txHash, sender string, withEvents bool) (*data.FullTransaction, error) {
 	sndShardID, err := tp.getShardByAddress(sender)
 	if err != nil {
 		return nil, errors.ErrInvalidSenderAddress
 	}
 
-	observers, err := tp.getNodesInShard(sndShardID, requestTypeFullHistoryNodes)
+	observers, err := tp.proc.GetNodesForRequest(ctx, RequestCategoryTxGet, sndShardID, "")
 	if err != nil {
 		return nil, err
 	}
 
 	for _, observer := range observers {
-		getTxResponse, ok, _ := tp.getTxFromObserver(observer, txHash, withEvents)
+		getTxResponse, ok, _ := tp.getTxFromObserver(ctx, observer, txHash, withEvents)
 		if !ok {
 			continue
 		}
@@ -517,7 +560,7 @@ func (tp *TransactionProcessor) getTxWithSenderAddr(txHash, sender string, withE
 			return &getTxResponse.Data.Transaction, nil
 		}
 
-		txFromDstShard, ok := tp.getTxFromDestShard(txHash, rcvShardID, withEvents)
+		txFromDstShard, ok := tp.getTxFromDestShard(ctx, txHash, rcvShardID, withEvents)
 		if ok {
 			alteredTxFromDest := mergeScResultsFromSourceAndDestIfNeeded(&getTxResponse.Data.Transaction, txFromDstShard, withEvents)
 			return alteredTxFromDest, nil
@@ -561,6 +604,7 @@ func getScResultsUnion(scResults []*transaction.ApiSmartContractResult) []*trans
 }
 
 func (tp *TransactionProcessor) getTxFromObserver(
+	ctx context.Context,
 	observer *data.NodeData,
 	txHash string,
 	withResults bool,
@@ -571,7 +615,7 @@ func (tp *TransactionProcessor) getTxFromObserver(
 		apiPath += withResultsParam
 	}
 
-	respCode, err := tp.proc.CallGetRestEndPoint(observer.Address, apiPath, getTxResponse)
+	respCode, err := tp.proc.CallGetRestEndPoint(ctx, observer.Address, apiPath, getTxResponse)
 	if err != nil {
 		log.Trace("cannot get transaction", "address", observer.Address, "error", err)
 
@@ -585,9 +629,9 @@ func (tp *TransactionProcessor) getTxFromObserver(
 	return getTxResponse, true, false
 }
 
-func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uint32, withEvents bool) (*data.FullTransaction, bool) {
+func (tp *TransactionProcessor) getTxFromDestShard(ctx context.Context, txHash string, dstShardID uint32, withEvents bool) (*data.FullTransaction, bool) {
 	// cross shard transaction
-	destinationShardObservers, err := tp.proc.GetObservers(dstShardID)
+	destinationShardObservers, err := tp.proc.GetNodesForRequest(ctx, RequestCategoryTxDestination, dstShardID, "")
 	if err != nil {
 		return nil, false
 	}
@@ -599,7 +643,7 @@ func (tp *TransactionProcessor) getTxFromDestShard(txHash string, dstShardID uin
 
 	for _, dstObserver := range destinationShardObservers {
 		getTxResponseDst := &data.GetTransactionResponse{}
-		respCode, err := tp.proc.CallGetRestEndPoint(dstObserver.Address, apiPath, getTxResponseDst)
+		respCode, err := tp.proc.CallGetRestEndPoint(ctx, dstObserver.Address, apiPath, getTxResponseDst)
 		if err != nil {
 			log.Trace("cannot get transaction", "address", dstObserver.Address, "error", err)
 			continue
@@ -719,16 +763,3 @@ func (tp *TransactionProcessor) ComputeTransactionHash(tx *data.Transaction) (st
 
 	return hex.EncodeToString(txHash), nil
 }
-
-func (tp *TransactionProcessor) getNodesInShard(shardID uint32, reqType requestType) ([]*data.NodeData, error) {
-	if reqType == requestTypeFullHistoryNodes {
-		fullHistoryNodes, err := tp.proc.GetFullHistoryNodes(shardID)
-		if err == nil && len(fullHistoryNodes) > 0 {
-			return fullHistoryNodes, nil
-		}
-	}
-
-	observers, err := tp.proc.GetObservers(shardID)
-
-	return observers, err
-}
@@ -2,6 +2,7 @@ package process_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"errors"
 	"math/big"
@@ -15,6 +16,7 @@ import (
 	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/disabled"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,7 +28,7 @@ var marshalizer, _ = marshalFactory.NewMarshalizer("gogo protobuf")
 func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer)
+	tp, err := process.NewTransactionProcessor(nil, &mock.PubKeyConverterMock{}, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilCoreProcessor, err)
@@ -35,7 +37,7 @@ func TestNewTransactionProcessor_NilCoreProcessorShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, nil, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilPubKeyConverter, err)
@@ -44,7 +46,7 @@ func TestNewTransactionProcessor_NilPubKeyConverterShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, nil, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilHasher, err)
@@ -53,16 +55,34 @@ func TestNewTransactionProcessor_NilHasherShouldErr(t *testing.T) {
 func TestNewTransactionProcessor_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, nil, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 
 	require.Nil(t, tp)
 	require.Equal(t, process.ErrNilMarshalizer, err)
 }
 
+func TestNewTransactionProcessor_NilResponsesCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, nil, &mock.NodesHealthHandlerStub{})
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilResponsesCache, err)
+}
+
+func TestNewTransactionProcessor_NilFinalNoncesHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, &disabled.ResponsesCache{}, nil)
+
+	require.Nil(t, tp)
+	require.Equal(t, process.ErrNilFinalNoncesHandler, err)
+}
+
 func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 	t.Parallel()
 
-	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer)
+	tp, err := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 
 	require.NotNil(t, tp)
 	require.Nil(t, err)
@@ -73,8 +93,8 @@ func TestNewTransactionProcessor_OkValuesShouldWork(t *testing.T) {
 func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender: "invalid hex number",
 	})
 
@@ -87,8 +107,8 @@ func TestTransactionProcessor_SendTransactionInvalidHexAdressShouldErr(t *testin
 func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{})
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{})
 
 	require.Empty(t, txHash)
 	require.NotNil(t, err)
@@ -99,8 +119,8 @@ func TestTransactionProcessor_SendTransactionNoChainIDShouldErr(t *testing.T) {
 func TestTransactionProcessor_SendTransactionNoVersionShouldErr(t *testing.T) {
 	t.Parallel()
 
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, &mock.PubKeyConverterMock{}, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		ChainID: "chainID",
 	})
 
@@ -123,8 +143,10 @@ func TestTransactionProcessor_SendTransactionComputeShardIdFailsShouldErr(t *tes
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		ChainID: "chain",
 		Version: 1,
 	})
@@ -150,9 +172,11 @@ func TestTransactionProcessor_SendTransactionGetObserversFailsShouldErr(t *testi
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 	address := "DEADBEEF"
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -178,16 +202,18 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnAllObserversShouldErr
 					{Address: "address2", ShardId: 0},
 				}, nil
 			},
-			CallPostRestEndPointCalled: func(address string, path string, data interface{}, response interface{}) (int, error) {
+			CallPostRestEndPointCalled: func(_ context.Context, address string, path string, data interface{}, response interface{}) (int, error) {
 				return http.StatusInternalServerError, errExpected
 			},
 		},
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 	address := "DEADBEEF"
-	rc, txHash, err := tp.SendTransaction(&data.Transaction{
+	rc, txHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -214,7 +240,7 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 					{Address: "address2", ShardId: 0},
 				}, nil
 			},
-			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+			CallPostRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}, response interface{}) (int, error) {
 				txResponse := response.(*data.ResponseTransaction)
 				txResponse.Data.TxHash = txHash
 				return http.StatusOK, nil
@@ -223,9 +249,11 @@ func TestTransactionProcessor_SendTransactionSendingFailsOnFirstObserverShouldSt
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 	address := "DEADBEEF"
-	rc, resultedTxHash, err := tp.SendTransaction(&data.Transaction{
+	rc, resultedTxHash, err := tp.SendTransaction(context.Background(), &data.Transaction{
 		Sender:  address,
 		ChainID: "chain",
 		Version: 1,
@@ -255,7 +283,7 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
 					{Address: "observer1", ShardId: 0},
 				}, nil
 			},
-			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+			CallPostRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}, response interface{}) (int, error) {
 				receivedTxs, ok := value.([]*data.Transaction)
 				require.True(t, ok)
 				resp := response.(*data.ResponseMultipleTransactions)
@@ -271,9 +299,11 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWork(t *testing.T) {
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	response, err := tp.SendMultipleTransactions(txsToSend)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
 	require.Nil(t, err)
 	require.Equal(t, len(response.TxsHashes), len(txsToSend))
 	require.Equal(t, uint64(len(txsToSend)), response.NumOfTxs)
@@ -318,7 +348,7 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 					{Address: addrObs1, ShardId: 0},
 				}, nil
 			},
-			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+			CallPostRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}, response interface{}) (int, error) {
 				atomic.AddUint32(&numOfTimesPostEndpointWasCalled, 1)
 				resp := response.(*data.ResponseMultipleTransactions)
 				resp.Data.NumOfTxs = uint64(2)
@@ -341,9 +371,11 @@ func TestTransactionProcessor_SendMultipleTransactionsShouldWorkAndSendTxsByShar
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	response, err := tp.SendMultipleTransactions(txsToSend)
+	response, err := tp.SendMultipleTransactions(context.Background(), txsToSend)
 	require.Nil(t, err)
 	require.Equal(t, uint64(len(txsToSend)), response.NumOfTxs)
 	require.Equal(t, uint32(2), atomic.LoadUint32(&numOfTimesPostEndpointWasCalled))
@@ -372,7 +404,7 @@ func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 					{Address: "observer1", ShardId: 0},
 				}, nil
 			},
-			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+			CallPostRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}, response interface{}) (int, error) {
 				resp := response.(*data.ResponseTransactionSimulation)
 				resp.Data.Result.FailReason = expectedFailReason
 				response = resp
@@ -382,9 +414,11 @@ func TestTransactionProcessor_SimulateTransactionShouldWork(t *testing.T) {
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	response, err := tp.SimulateTransaction(txsToSimulate)
+	response, err := tp.SimulateTransaction(context.Background(), txsToSimulate)
 	require.Nil(t, err)
 
 	respData := response.Data.(data.TransactionSimulationResponseData)
@@ -416,7 +450,7 @@ func TestTransactionProcessor_SimulateTransactionCrossShardOkOnSenderFailOnRecei
 				}
 				return []*data.NodeData{{Address: obsSh1, ShardId: 1}}, nil
 			},
-			CallPostRestEndPointCalled: func(address string, path string, value interface{}, response interface{}) (int, error) {
+			CallPostRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}, response interface{}) (int, error) {
 				if address == obsSh0 {
 					resp := response.(*data.ResponseTransactionSimulation)
 					resp.Data.Result.Status = transaction.TxStatus(expectedStatusSh0)
@@ -434,9 +468,11 @@ func TestTransactionProcessor_SimulateTransactionCrossShardOkOnSenderFailOnRecei
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	response, err := tp.SimulateTransaction(txsToSimulate)
+	response, err := tp.SimulateTransaction(context.Background(), txsToSimulate)
 	require.Nil(t, err)
 
 	respData := response.Data.(data.TransactionSimulationResponseDataCrossShard)
@@ -485,7 +521,7 @@ func TestTransactionProcessor_GetTransactionStatusIntraShardTransaction(t *testi
 				}
 				return nil, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (i int, err error) {
 				if address == addrObs0 {
 					responseGetTx := value.(*data.GetTransactionResponse)
 
@@ -501,9 +537,11 @@ func TestTransactionProcessor_GetTransactionStatusIntraShardTransaction(t *testi
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -539,7 +577,7 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransaction(t *testi
 					{Address: addrObs1, ShardId: 1},
 				}, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (i int, err error) {
 				responseGetTx := value.(*data.GetTransactionResponse)
 
 				responseGetTx.Data.Transaction = data.FullTransaction{
@@ -553,13 +591,59 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransaction(t *testi
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
 
+func TestTransactionProcessor_GetTransactionStatusShouldUseTheTxStatusAndTxDestinationRoutes(t *testing.T) {
+	t.Parallel()
+
+	sndrShard0 := hex.EncodeToString([]byte("bbbbbb"))
+	sndrShard1 := hex.EncodeToString([]byte("cccccc"))
+
+	requestedCategories := make([]string, 0)
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				if hex.EncodeToString(addressBuff) == sndrShard1 {
+					return uint32(1), nil
+				}
+				return 0, nil
+			},
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetNodesForRequestCalled: func(_ context.Context, category string, shardId uint32, _ string) ([]*data.NodeData, error) {
+				requestedCategories = append(requestedCategories, category)
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (i int, err error) {
+				responseGetTx := value.(*data.GetTransactionResponse)
+				responseGetTx.Data.Transaction = data.FullTransaction{
+					Receiver: sndrShard1,
+					Sender:   sndrShard0,
+					Status:   transaction.TxStatusSuccess,
+				}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
+	)
+
+	_, err := tp.GetTransactionStatus(context.Background(), "hash", "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{process.RequestCategoryTxStatus, process.RequestCategoryTxDestination}, requestedCategories)
+}
+
 func TestTransactionProcessor_GetTransactionStatusCrossShardTransactionDestinationNotAnswer(t *testing.T) {
 	t.Parallel()
 
@@ -600,7 +684,7 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransactionDestinati
 				}
 				return nil, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (i int, err error) {
 				if addrObs1 == address {
 					return http.StatusBadRequest, nil
 				}
@@ -618,9 +702,11 @@ func TestTransactionProcessor_GetTransactionStatusCrossShardTransactionDestinati
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "")
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -663,7 +749,7 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressCrossShard(t
 					{Address: addrObs3, ShardId: 1},
 				}, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (i int, err error) {
 				if addrObs1 == address {
 					return 0, errors.New("local error")
 				}
@@ -684,9 +770,11 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressCrossShard(t
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), sndrShard0)
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -704,9 +792,11 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderInvaidSender(t *test
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), "blablabla")
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), "blablabla")
 	assert.Error(t, err)
 	assert.Equal(t, process.UnknownStatusTx, txStatus)
 }
@@ -736,7 +826,7 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressIntraShard(t
 					{Address: addrObs2, ShardId: 0},
 				}, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (i int, err error) {
 				if address == addrObs0 {
 					return http.StatusBadRequest, nil
 				}
@@ -757,9 +847,11 @@ func TestTransactionProcessor_GetTransactionStatusWithSenderAddressIntraShard(t
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	txStatus, err := tp.GetTransactionStatus(string(hash0), sndrShard0)
+	txStatus, err := tp.GetTransactionStatus(context.Background(), string(hash0), sndrShard0)
 	assert.NoError(t, err)
 	assert.Equal(t, txResponseStatus, txStatus)
 }
@@ -782,7 +874,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidTransactionValue(t *testi
 	marshalizer := marshalizer
 	hasher := hasher
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidTransactionValueField, err)
@@ -806,7 +898,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidReceiverAddress(t *testin
 	marshalizer := marshalizer
 	hasher := hasher
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -830,7 +922,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSenderAddress(t *testing.
 	marshalizer := marshalizer
 	hasher := hasher
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidAddress, err)
@@ -854,7 +946,7 @@ func TestTransactionProcessor_ComputeTransactionInvalidSignaturesBytes(t *testin
 	marshalizer := marshalizer
 	hasher := hasher
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 
 	_, err := tp.ComputeTransactionHash(tx)
 	assert.Equal(t, process.ErrInvalidSignatureBytes, err)
@@ -878,7 +970,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork1(t *testing.T) {
 	marshalizer := marshalizer
 	hasher := hasher
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 
 	txHashHex := "891694ae6307ee9f17f861816187a6729268397f8fabc055d5b334f552cd3cfb"
 	txHash, err := tp.ComputeTransactionHash(tx)
@@ -907,7 +999,7 @@ func TestTransactionProcessor_ComputeTransactionShouldWork2(t *testing.T) {
 	marshalizer := marshalizer
 	hasher := hasher
 	pubKeyConv := &mock.PubKeyConverterMock{}
-	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer)
+	tp, _ := process.NewTransactionProcessor(&mock.ProcessorStub{}, pubKeyConv, hasher, marshalizer, &disabled.ResponsesCache{}, &mock.NodesHealthHandlerStub{})
 
 	txHash, err := tp.ComputeTransactionHash(&data.Transaction{
 		Nonce:     protoTx.Nonce,
@@ -965,7 +1057,7 @@ func TestTransactionProcessor_GetTransactionShouldWork(t *testing.T) {
 				}
 				return nil, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (i int, err error) {
 				if address == addrObs0 {
 					responseGetTx := value.(*data.GetTransactionResponse)
 
@@ -981,9 +1073,11 @@ func TestTransactionProcessor_GetTransactionShouldWork(t *testing.T) {
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	tx, err := tp.GetTransaction(string(hash0), false)
+	tx, err := tp.GetTransaction(context.Background(), string(hash0), false)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNonce, tx.Nonce)
 }
@@ -1013,7 +1107,7 @@ func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttp
 				}
 				return nil, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (i int, err error) {
 				if address == addrObs0 {
 					return 0, errors.New("rest api error")
 				}
@@ -1028,9 +1122,11 @@ func TestTransactionProcessor_GetTransactionShouldCallOtherObserverInShardIfHttp
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	_, _ = tp.GetTransaction(string(hash0), false)
+	_, _ = tp.GetTransaction(context.Background(), string(hash0), false)
 	assert.True(t, secondObserverWasCalled)
 }
 
@@ -1060,7 +1156,7 @@ func TestTransactionProcessor_GetTransactionShouldNotCallOtherObserverInShardIfN
 				}
 				return nil, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (i int, err error) {
 				if address == addrObs1 {
 					require.Fail(t, "second observer should have not been called")
 				}
@@ -1071,9 +1167,11 @@ func TestTransactionProcessor_GetTransactionShouldNotCallOtherObserverInShardIfN
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	_, _ = tp.GetTransaction(string(hash0), false)
+	_, _ = tp.GetTransaction(context.Background(), string(hash0), false)
 }
 
 func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterSource(t *testing.T) {
@@ -1130,7 +1228,7 @@ func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterS
 
 				return nil, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (i int, err error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (i int, err error) {
 				if address == addrObs1 {
 					responseGetTx := value.(*data.GetTransactionResponse)
 
@@ -1165,10 +1263,69 @@ func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterS
 		&mock.PubKeyConverterMock{},
 		hasher,
 		marshalizer,
+		&disabled.ResponsesCache{},
+		&mock.NodesHealthHandlerStub{},
 	)
 
-	tx, err := tp.GetTransaction(string(hash0), true)
+	tx, err := tp.GetTransaction(context.Background(), string(hash0), true)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedNonce, tx.Nonce)
 	assert.Equal(t, 3, len(tx.ScResults))
 }
+
+func TestTransactionProcessor_GetTransactionShouldCacheOnlyTheFinalTransactions(t *testing.T) {
+	t.Parallel()
+
+	address := hex.EncodeToString([]byte("aaaaaa"))
+	transactions := map[string]data.FullTransaction{
+		"final":       {Status: transaction.TxStatusSuccess, NotarizedAtDestinationInMetaNonce: 10},
+		"pending":     {Status: transaction.TxStatusPending, NotarizedAtDestinationInMetaNonce: 10},
+		"not-final":   {Status: transaction.TxStatusFail, NotarizedAtDestinationInMetaNonce: 11},
+		"unnotarized": {Status: transaction.TxStatusInvalid},
+	}
+	numGetTxCalled := 0
+	proc := &mock.ProcessorStub{
+		ComputeShardIdCalled: func(_ []byte) (uint32, error) {
+			return 0, nil
+		},
+		GetShardIDsCalled: func() []uint32 {
+			return []uint32{0}
+		},
+		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			return []*data.NodeData{{ShardId: shardId, Address: "addr"}}, nil
+		},
+		CallGetRestEndPointCalled: func(_ context.Context, _ string, path string, value interface{}) (int, error) {
+			numGetTxCalled++
+			tx := transactions[path[len(process.TransactionPath):]]
+			tx.Sender = address
+			tx.Receiver = address
+			value.(*data.GetTransactionResponse).Data.Transaction = tx
+			return http.StatusOK, nil
+		},
+	}
+	finalNonces := &mock.NodesHealthHandlerStub{
+		GetHighestFinalNonceCalled: func(shardID uint32) (uint64, bool) {
+			return 10, shardID == core.MetachainShardId
+		},
+	}
+	responsesCache, _ := process.NewImmutableResponsesCache(10)
+	tp, _ := process.NewTransactionProcessor(proc, &mock.PubKeyConverterMock{}, hasher, marshalizer, responsesCache, finalNonces)
+
+	firstResponse, err := tp.GetTransaction(context.Background(), "final", false)
+	require.Nil(t, err)
+	secondResponse, err := tp.GetTransaction(context.Background(), "final", false)
+	require.Nil(t, err)
+	require.Equal(t, firstResponse, secondResponse)
+	require.Equal(t, uint64(10), secondResponse.HyperblockNonce)
+	require.Equal(t, 1, numGetTxCalled)
+
+	for _, txHash := range []string{"pending", "not-final", "unnotarized"} {
+		_, _ = tp.GetTransaction(context.Background(), txHash, false)
+		_, _ = tp.GetTransaction(context.Background(), txHash, false)
+	}
+	require.Equal(t, 7, numGetTxCalled)
+
+	_, _, _ = tp.GetTransactionByHashAndSenderAddress(context.Background(), "final", address, false)
+	_, _, _ = tp.GetTransactionByHashAndSenderAddress(context.Background(), "final", address, false)
+	require.Equal(t, 8, numGetTxCalled)
+}
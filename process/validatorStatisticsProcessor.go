@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -11,10 +12,15 @@ import (
 // ValidatorStatisticsPath represents the path where an observer exposes his validator statistics data
 const ValidatorStatisticsPath = "/validator/statistics"
 
+// validatorStatisticsCacheUpdateEndpoint is the endpoint name of the requests sent for updating the validator
+// statistics cache
+const validatorStatisticsCacheUpdateEndpoint = "validator-statistics-cache-update"
+
 // ValidatorStatisticsProcessor is able to process validator statistics data requests
 type ValidatorStatisticsProcessor struct {
 	proc                  Processor
 	cacher                ValidatorStatisticsCacheHandler
+	leaderElection        LeaderElectionHandler
 	cacheValidityDuration time.Duration
 }
 
@@ -22,6 +28,7 @@ type ValidatorStatisticsProcessor struct {
 func NewValidatorStatisticsProcessor(
 	proc Processor,
 	cacher ValidatorStatisticsCacheHandler,
+	leaderElection LeaderElectionHandler,
 	cacheValidityDuration time.Duration,
 ) (*ValidatorStatisticsProcessor, error) {
 	if check.IfNil(proc) {
@@ -30,12 +37,16 @@ func NewValidatorStatisticsProcessor(
 	if check.IfNil(cacher) {
 		return nil, ErrNilValidatorStatisticsCacher
 	}
+	if check.IfNil(leaderElection) {
+		return nil, ErrNilLeaderElection
+	}
 	if cacheValidityDuration <= 0 {
 		return nil, ErrInvalidCacheValidityDuration
 	}
 	hbp := &ValidatorStatisticsProcessor{
 		proc:                  proc,
 		cacher:                cacher,
+		leaderElection:        leaderElection,
 		cacheValidityDuration: cacheValidityDuration,
 	}
 
@@ -43,19 +54,19 @@ func NewValidatorStatisticsProcessor(
 }
 
 // GetValidatorStatistics will simply forward the validator statistics data from an observer
-func (hbp *ValidatorStatisticsProcessor) GetValidatorStatistics() (*data.ValidatorStatisticsResponse, error) {
+func (hbp *ValidatorStatisticsProcessor) GetValidatorStatistics(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
 	valStatsToReturn, err := hbp.cacher.LoadValStats()
 	if err == nil {
-		return &data.ValidatorStatisticsResponse{Statistics: valStatsToReturn}, nil
+		return valStatsToReturn, nil
 	}
 
 	log.Info("validator statistics: cannot get from cache. Will fetch from API", "error", err.Error())
 
-	return hbp.getValidatorStatisticsFromApi()
+	return hbp.getValidatorStatisticsFromApi(ctx)
 }
 
-func (hbp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.ValidatorStatisticsResponse, error) {
-	observers, errFetchObs := hbp.proc.GetObservers(core.MetachainShardId)
+func (hbp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi(ctx context.Context) (*data.ValidatorStatisticsResponse, error) {
+	observers, errFetchObs := hbp.proc.GetNodesForRequest(ctx, RequestCategoryNetwork, core.MetachainShardId, "")
 	if errFetchObs != nil {
 		return nil, errFetchObs
 	}
@@ -63,7 +74,7 @@ func (hbp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.
 	var valStatsResponse data.ValidatorStatisticsApiResponse
 	var err error
 	for _, observer := range observers {
-		_, err = hbp.proc.CallGetRestEndPoint(observer.Address, ValidatorStatisticsPath, &valStatsResponse)
+		_, err = hbp.proc.CallGetRestEndPoint(ctx, observer.Address, ValidatorStatisticsPath, &valStatsResponse)
 		if err == nil {
 			log.Info("validator statistics fetched from API", "observer", observer.Address)
 			return &valStatsResponse.Data, nil
@@ -73,11 +84,18 @@ func (hbp *ValidatorStatisticsProcessor) getValidatorStatisticsFromApi() (*data.
 	return nil, ErrValidatorStatisticsNotAvailable
 }
 
-// StartCacheUpdate will start the updating of the cache from the API at a given period
+// StartCacheUpdate will start the updating of the cache from the API at a given period. When the cache is shared by
+// several proxies, only the elected leader updates it
 func (hbp *ValidatorStatisticsProcessor) StartCacheUpdate() {
 	go func() {
 		for {
-			valStats, err := hbp.getValidatorStatisticsFromApi()
+			if !hbp.leaderElection.IsLeader() {
+				time.Sleep(hbp.cacheValidityDuration)
+				continue
+			}
+
+			ctx := data.WithEndpointName(context.Background(), validatorStatisticsCacheUpdateEndpoint)
+			valStats, err := hbp.getValidatorStatisticsFromApi(ctx)
 			if err != nil {
 				log.Warn("validator statistics: get from API", "error", err.Error())
 			}
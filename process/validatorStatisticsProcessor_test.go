@@ -1,6 +1,7 @@
 package process_test
 
 import (
+	"context"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/disabled"
 	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
 	"github.com/stretchr/testify/assert"
 )
@@ -15,7 +17,7 @@ import (
 func TestNewValidatorStatisticsProcessor_NilProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(nil, &mock.ValStatsCacherMock{}, time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(nil, &mock.ValStatsCacherMock{}, &disabled.LeaderElection{}, time.Second)
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilCoreProcessor, err)
@@ -24,16 +26,25 @@ func TestNewValidatorStatisticsProcessor_NilProcessorShouldErr(t *testing.T) {
 func TestNewValidatorStatisticsProcessor_NilCacherShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, nil, time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, nil, &disabled.LeaderElection{}, time.Second)
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrNilValidatorStatisticsCacher, err)
 }
 
+func TestNewValidatorStatisticsProcessor_NilLeaderElectionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, nil, time.Second)
+
+	assert.Nil(t, hp)
+	assert.Equal(t, process.ErrNilLeaderElection, err)
+}
+
 func TestNewValidatorStatisticsProcessor_InvalidCacheValidityDurationShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, -time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, &disabled.LeaderElection{}, -time.Second)
 
 	assert.Nil(t, hp)
 	assert.Equal(t, process.ErrInvalidCacheValidityDuration, err)
@@ -42,7 +53,7 @@ func TestNewValidatorStatisticsProcessor_InvalidCacheValidityDurationShouldErr(t
 func TestNewValidatorStatisticsProcessor_WithOkProcessorShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hbp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second)
+	hbp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, &disabled.LeaderElection{}, time.Second)
 
 	assert.NotNil(t, hbp)
 	assert.Nil(t, err)
@@ -51,10 +62,10 @@ func TestNewValidatorStatisticsProcessor_WithOkProcessorShouldErr(t *testing.T)
 func TestValidatorStatisticsProcessor_GetValidatorStatisticsDataWrongValuesShouldErr(t *testing.T) {
 	t.Parallel()
 
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, time.Second)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, &mock.ValStatsCacherMock{}, &disabled.LeaderElection{}, time.Second)
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 
 	assert.Nil(t, res)
 	assert.Error(t, err)
@@ -72,17 +83,18 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsDataOkValuesShouldPa
 			})
 			return obs, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			return 0, nil
 		},
 	},
 		&mock.ValStatsCacherMock{},
+		&disabled.LeaderElection{},
 		time.Second,
 	)
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 	assert.NotNil(t, res)
 	assert.Nil(t, err)
 }
@@ -99,17 +111,18 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsNoMetaObserverShould
 			})
 			return obs, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			return 0, nil
 		},
 	},
 		&mock.ValStatsCacherMock{},
+		&disabled.LeaderElection{},
 		time.Second,
 	)
 
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 	assert.Nil(t, res)
 	assert.Error(t, err)
 }
@@ -125,17 +138,18 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 			GetObserversCalled: func(_ uint32) ([]*data.NodeData, error) {
 				return []*data.NodeData{{Address: "obs1", ShardId: core.MetachainShardId}}, nil
 			},
-			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+			CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 				httpWasCalled = true
 				return 0, nil
 			},
 		},
 		cacher,
+		&disabled.LeaderElection{},
 		time.Second,
 	)
 	assert.Nil(t, err)
 
-	_, err = hp.GetValidatorStatistics()
+	_, err = hp.GetValidatorStatistics(context.Background())
 	assert.Nil(t, err)
 	assert.True(t, httpWasCalled)
 }
@@ -147,10 +161,10 @@ func TestValidatorStatisticsProcessor_GetValidatorStatisticsShouldReturnDataFrom
 		"key0": {TempRating: 50.7},
 	}
 	cacher := &mock.ValStatsCacherMock{Data: valStatsMap}
-	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, cacher, time.Millisecond)
+	hp, err := process.NewValidatorStatisticsProcessor(&mock.ProcessorStub{}, cacher, &disabled.LeaderElection{}, time.Millisecond)
 	assert.Nil(t, err)
 
-	res, err := hp.GetValidatorStatistics()
+	res, err := hp.GetValidatorStatistics(context.Background())
 
 	assert.Nil(t, err)
 	assert.Equal(t, res.Statistics, valStatsMap)
@@ -165,12 +179,13 @@ func TestValidatorStatisticsProcessor_CacheShouldUpdate(t *testing.T) {
 		GetObserversCalled: func(_ uint32) ([]*data.NodeData, error) {
 			return []*data.NodeData{{Address: "obs1", ShardId: core.MetachainShardId}}, nil
 		},
-		CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+		CallGetRestEndPointCalled: func(_ context.Context, address string, path string, value interface{}) (int, error) {
 			atomic.AddInt32(&numOfTimesHttpWasCalled, 1)
 			return 0, nil
 		},
 	},
 		cacher,
+		&disabled.LeaderElection{},
 		25*time.Millisecond)
 
 	assert.Nil(t, err)
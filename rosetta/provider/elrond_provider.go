@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"time"
@@ -78,7 +79,7 @@ func (ep *ElrondProvider) initializeElrondProvider() error {
 
 // GetNetworkConfig will return the network config
 func (ep *ElrondProvider) GetNetworkConfig() (*NetworkConfig, error) {
-	networkConfigResponse, err := ep.client.GetNetworkConfigMetrics()
+	networkConfigResponse, err := ep.client.GetNetworkConfigMetrics(context.Background())
 	if err != nil {
 		log.Warn("cannot get network metrics", "error", err.Error())
 
@@ -116,12 +117,12 @@ func (ep *ElrondProvider) GetNetworkConfig() (*NetworkConfig, error) {
 
 // GetLatestBlockData will return latest block data
 func (ep *ElrondProvider) GetLatestBlockData() (*BlockData, error) {
-	latestBlockNonce, err := ep.client.GetLatestFullySynchronizedHyperblockNonce()
+	latestBlockNonce, err := ep.client.GetLatestFullySynchronizedHyperblockNonce(context.Background())
 	if err != nil {
 		return nil, err
 	}
 
-	blockResponse, err := ep.client.GetBlockByNonce(MetachainID, latestBlockNonce, false)
+	blockResponse, err := ep.client.GetBlockByNonce(context.Background(), MetachainID, latestBlockNonce, false)
 	if err != nil {
 		log.Warn("cannot get block", "nonce", latestBlockNonce,
 			"error", err.Error())
@@ -146,7 +147,7 @@ func (ep *ElrondProvider) GetLatestBlockData() (*BlockData, error) {
 
 // GetBlockByNonce will return a block by nonce
 func (ep *ElrondProvider) GetBlockByNonce(nonce int64) (*data.Hyperblock, error) {
-	blockResponse, err := ep.client.GetHyperBlockByNonce(uint64(nonce))
+	blockResponse, err := ep.client.GetHyperBlockByNonce(context.Background(), uint64(nonce))
 	if err != nil {
 		log.Warn("cannot get hyper block", "nonce", nonce,
 			"error", err.Error())
@@ -166,7 +167,7 @@ func (ep *ElrondProvider) GetBlockByNonce(nonce int64) (*data.Hyperblock, error)
 
 // GetBlockByHash will return a hyper block by hash
 func (ep *ElrondProvider) GetBlockByHash(hash string) (*data.Hyperblock, error) {
-	blockResponse, err := ep.client.GetHyperBlockByHash(hash)
+	blockResponse, err := ep.client.GetHyperBlockByHash(context.Background(), hash)
 	if err != nil {
 		log.Warn("cannot get hyper block", "hash", hash,
 			"error", err.Error())
@@ -186,7 +187,7 @@ func (ep *ElrondProvider) GetBlockByHash(hash string) (*data.Hyperblock, error)
 
 // GetAccount will return an account by address
 func (ep *ElrondProvider) GetAccount(address string) (*data.Account, error) {
-	return ep.client.GetAccount(address)
+	return ep.client.GetAccount(context.Background(), address)
 }
 
 // ComputeTransactionHash will compute hash of provided transaction
@@ -216,7 +217,7 @@ func (ep *ElrondProvider) DecodeAddress(address string) ([]byte, error) {
 
 // SendTx will send a transaction
 func (ep *ElrondProvider) SendTx(tx *data.Transaction) (string, error) {
-	_, hash, err := ep.client.SendTransaction(tx)
+	_, hash, err := ep.client.SendTransaction(context.Background(), tx)
 	if err != nil {
 		return "", err
 	}
@@ -233,7 +234,7 @@ func (ep *ElrondProvider) CalculateBlockTimestampUnix(round uint64) int64 {
 
 // GetTransactionByHashFromPool will return a transaction only if is in pool
 func (ep *ElrondProvider) GetTransactionByHashFromPool(txHash string) (*data.FullTransaction, bool) {
-	tx, _, err := ep.client.GetTransactionByHashAndSenderAddress(txHash, "", false)
+	tx, _, err := ep.client.GetTransactionByHashAndSenderAddress(context.Background(), txHash, "", false)
 	if err != nil {
 		log.Debug("elrond provider: cannot get transaction by hash", "error", err.Error())
 		return nil, false
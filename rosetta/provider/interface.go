@@ -1,24 +1,26 @@
 package provider
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
 
 // ElrondProxyClient defines what a real elrond proxy client should do
 type ElrondProxyClient interface {
-	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
-	GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
-	GetAccount(address string) (*data.Account, error)
+	GetNetworkConfigMetrics(ctx context.Context) (*data.GenericAPIResponse, error)
+	GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
+	GetAccount(ctx context.Context, address string) (*data.Account, error)
 
-	GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error)
-	GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByNonce(ctx context.Context, nonce uint64) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByHash(ctx context.Context, hash string) (*data.HyperblockApiResponse, error)
 
-	SendTransaction(tx *data.Transaction) (int, string, error)
+	SendTransaction(ctx context.Context, tx *data.Transaction) (int, string, error)
 	ComputeTransactionHash(tx *data.Transaction) (string, error)
-	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withResults bool) (*data.FullTransaction, int, error)
+	GetTransactionByHashAndSenderAddress(ctx context.Context, txHash string, sndAddr string, withResults bool) (*data.FullTransaction, int, error)
 
-	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
+	GetLatestFullySynchronizedHyperblockNonce(ctx context.Context) (uint64, error)
 	GetAddressConverter() (core.PubkeyConverter, error)
 }
 
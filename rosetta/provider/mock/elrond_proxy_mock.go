@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
@@ -21,7 +23,7 @@ type ElrondProxyClientMock struct {
 }
 
 // GetNetworkConfigMetrics -
-func (epcm *ElrondProxyClientMock) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+func (epcm *ElrondProxyClientMock) GetNetworkConfigMetrics(_ context.Context) (*data.GenericAPIResponse, error) {
 	if epcm.GetNetworkConfigMetricsCalled != nil {
 		return epcm.GetNetworkConfigMetricsCalled()
 	}
@@ -34,7 +36,7 @@ func (epcm *ElrondProxyClientMock) GetNetworkStatusMetrics(_ uint32) (*data.Gene
 }
 
 // GetBlockByNonce -
-func (epcm *ElrondProxyClientMock) GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
+func (epcm *ElrondProxyClientMock) GetBlockByNonce(_ context.Context, shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
 	if epcm.GetBlockByNonceCalled != nil {
 		return epcm.GetBlockByNonceCalled(shardID, nonce, withTxs)
 	}
@@ -42,7 +44,7 @@ func (epcm *ElrondProxyClientMock) GetBlockByNonce(shardID uint32, nonce uint64,
 }
 
 // GetAccount -
-func (epcm *ElrondProxyClientMock) GetAccount(address string) (*data.Account, error) {
+func (epcm *ElrondProxyClientMock) GetAccount(_ context.Context, address string) (*data.Account, error) {
 	if epcm.GetAccountCalled != nil {
 		return epcm.GetAccountCalled(address)
 	}
@@ -50,7 +52,7 @@ func (epcm *ElrondProxyClientMock) GetAccount(address string) (*data.Account, er
 }
 
 // GetHyperBlockByNonce -
-func (epcm *ElrondProxyClientMock) GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error) {
+func (epcm *ElrondProxyClientMock) GetHyperBlockByNonce(_ context.Context, nonce uint64) (*data.HyperblockApiResponse, error) {
 	if epcm.GetHyperBlockByNonceCalled != nil {
 		return epcm.GetHyperBlockByNonceCalled(nonce)
 	}
@@ -58,7 +60,7 @@ func (epcm *ElrondProxyClientMock) GetHyperBlockByNonce(nonce uint64) (*data.Hyp
 }
 
 // GetHyperBlockByHash -
-func (epcm *ElrondProxyClientMock) GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error) {
+func (epcm *ElrondProxyClientMock) GetHyperBlockByHash(_ context.Context, hash string) (*data.HyperblockApiResponse, error) {
 	if epcm.GetHyperBlockByHashCalled != nil {
 		return epcm.GetHyperBlockByHashCalled(hash)
 	}
@@ -66,7 +68,7 @@ func (epcm *ElrondProxyClientMock) GetHyperBlockByHash(hash string) (*data.Hyper
 }
 
 // SendTransaction -
-func (epcm *ElrondProxyClientMock) SendTransaction(tx *data.Transaction) (int, string, error) {
+func (epcm *ElrondProxyClientMock) SendTransaction(_ context.Context, tx *data.Transaction) (int, string, error) {
 	if epcm.SendTransactionCalled != nil {
 		return epcm.SendTransactionCalled(tx)
 	}
@@ -90,7 +92,7 @@ func (epcm *ElrondProxyClientMock) GetAddressConverter() (core.PubkeyConverter,
 }
 
 // GetLatestBlockNonce -
-func (epcm *ElrondProxyClientMock) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+func (epcm *ElrondProxyClientMock) GetLatestFullySynchronizedHyperblockNonce(_ context.Context) (uint64, error) {
 	if epcm.GetLatestFullySynchronizedHyperblockNonceCalled != nil {
 		return epcm.GetLatestFullySynchronizedHyperblockNonceCalled()
 	}
@@ -99,6 +101,7 @@ func (epcm *ElrondProxyClientMock) GetLatestFullySynchronizedHyperblockNonce() (
 
 // GetTransactionByHashAndSenderAddress -
 func (epcm *ElrondProxyClientMock) GetTransactionByHashAndSenderAddress(
+	_ context.Context,
 	hash string,
 	sndAddr string,
 	_ bool,